@@ -0,0 +1,89 @@
+package main
+
+import (
+	"log/slog"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"fixit/engine/ent"
+	"fixit/engine/storage"
+	"fixit/web/app"
+)
+
+var blobsCmd = &cobra.Command{
+	Use:   "blobs",
+	Short: "Manage uploaded file storage",
+}
+
+var blobsMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Copy uploaded files between blob store backends",
+	Long: "Copy the contents of every uploaded file from one blob store backend to another, " +
+		"e.g. --from postgres --to s3. Both backends are configured from the usual environment " +
+		"variables. Set BLOB_STORE to the new backend once it's done.",
+	RunE: runBlobsMigrate,
+}
+
+var (
+	blobsFrom         string
+	blobsTo           string
+	blobsDeleteSource bool
+)
+
+func init() {
+	blobsMigrateCmd.Flags().StringVar(&blobsFrom, "from", "", "Backend to copy from: postgres, filesystem or s3")
+	blobsMigrateCmd.Flags().StringVar(&blobsTo, "to", "", "Backend to copy to: postgres, filesystem or s3")
+	blobsMigrateCmd.Flags().BoolVar(&blobsDeleteSource, "delete-source", false, "Delete each blob from the source once copied")
+	_ = blobsMigrateCmd.MarkFlagRequired("from")
+	_ = blobsMigrateCmd.MarkFlagRequired("to")
+
+	blobsCmd.AddCommand(blobsMigrateCmd)
+	rootCmd.AddCommand(blobsCmd)
+}
+
+func runBlobsMigrate(cmd *cobra.Command, args []string) error {
+	if blobsFrom == blobsTo {
+		return errors.New("--from and --to must be different backends")
+	}
+
+	cfg := app.Config{}
+	if err := env.Parse(&cfg); err != nil {
+		return errors.Wrap(err, "failed to parse env")
+	}
+
+	client, err := ent.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer client.Close()
+
+	open := func(backend string) (storage.BlobStore, error) {
+		storageCfg := cfg.Storage
+		storageCfg.Backend = backend
+		return storage.Open(storageCfg, client)
+	}
+	from, err := open(blobsFrom)
+	if err != nil {
+		return err
+	}
+	to, err := open(blobsTo)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	objects, err := storage.FileObjects(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	slog.Info("migrating blobs", "from", blobsFrom, "to", blobsTo, "files", len(objects))
+	result, err := storage.Migrate(ctx, from, to, objects, blobsDeleteSource)
+	if err != nil {
+		return err
+	}
+	slog.Info("migrated blobs", "copied", result.Copied, "missing", result.Missing)
+	return nil
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"fixit/engine/community"
+	"fixit/engine/ent"
+	entCommunity "fixit/engine/ent/community"
+	"fixit/engine/ent/membership"
+	"fixit/engine/ent/post"
+	"fixit/engine/geo"
+	enginePost "fixit/engine/post"
+	"fixit/engine/storage"
+)
+
+var communityCmd = &cobra.Command{
+	Use:   "community",
+	Short: "Manage communities",
+}
+
+var communityCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a community",
+	Long:  "Create a community, whose name is used in its URL, e.g. /c/swindon",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCommunityCreate,
+}
+
+var communityListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List communities",
+	Args:  cobra.NoArgs,
+	RunE:  runCommunityList,
+}
+
+var communityDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a community",
+	Long: "Delete a community. Communities with posts are only deleted with --with-posts, " +
+		"which deletes the posts too.",
+	Args: cobra.ExactArgs(1),
+	RunE: runCommunityDelete,
+}
+
+var communityRoleCmd = &cobra.Command{
+	Use:   "role <name> <user> <role>",
+	Short: "Give a user a role in a community",
+	Long: "Give a user, found by username or email, a role in a community: owner, moderator or member. " +
+		"They join the community if they aren't a member.",
+	Args: cobra.ExactArgs(3),
+	RunE: runCommunityRole,
+}
+
+var (
+	communityOwner     string
+	communityTitle     string
+	communityLocation  string
+	communityLat       float64
+	communityLng       float64
+	communityWithPosts bool
+)
+
+func init() {
+	communityCreateCmd.Flags().StringVar(&communityTitle, "title", "", "Title shown on the community's pages")
+	communityCreateCmd.Flags().StringVar(&communityLocation, "location", "", "Where the community is, e.g. \"Swindon, UK\"")
+	communityCreateCmd.Flags().StringVar(&communityOwner, "owner", "", "Username or email of the community's owner")
+	communityCreateCmd.Flags().Float64Var(&communityLat, "lat", 0, "Latitude of the community's centre")
+	communityCreateCmd.Flags().Float64Var(&communityLng, "lng", 0, "Longitude of the community's centre")
+	communityCreateCmd.MarkFlagsRequiredTogether("lat", "lng")
+	_ = communityCreateCmd.MarkFlagRequired("title")
+
+	communityDeleteCmd.Flags().BoolVar(&communityWithPosts, "with-posts", false, "Delete the community's posts as well")
+
+	communityCmd.AddCommand(communityCreateCmd, communityListCmd, communityDeleteCmd, communityRoleCmd)
+	rootCmd.AddCommand(communityCmd)
+}
+
+func runCommunityCreate(cmd *cobra.Command, args []string) error {
+	client, cfg, err := openClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var owner *ent.User
+	if communityOwner != "" {
+		users, err := userRepository(client, cfg)
+		if err != nil {
+			return err
+		}
+		owner, err = users.Find(cmd.Context(), communityOwner)
+		if err != nil {
+			return err
+		}
+	}
+
+	fields := community.CommunityCreateFields{
+		Name:     args[0],
+		Title:    communityTitle,
+		Location: communityLocation,
+	}
+	if cmd.Flags().Changed("lat") {
+		fields.Point = &geo.Point{Lat: communityLat, Lng: communityLng}
+	}
+
+	comm, err := community.NewRepository(client).Create(cmd.Context(), fields, owner)
+	if err != nil {
+		return err
+	}
+	slog.Info("created community", "id", comm.ID, "name", comm.Name)
+	return nil
+}
+
+func runCommunityList(cmd *cobra.Command, args []string) error {
+	client, _, err := openClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	repo := community.NewRepository(client)
+	comms, err := repo.List(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tTITLE\tLOCATION\tCREATED\tOWNERS")
+	for _, c := range comms {
+		// Communities from before roles show "-" until given an owner
+		owners, err := communityOwners(cmd.Context(), repo, c.ID)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", c.Name, c.Title, c.Location, c.CreatedAt.Format("2006-01-02"), owners)
+	}
+	return w.Flush()
+}
+
+// communityOwners is the usernames of the community's owners, or "-"
+func communityOwners(ctx context.Context, repo *community.Repository, communityID uuid.UUID) (string, error) {
+	members, err := repo.Members(ctx, communityID)
+	if err != nil {
+		return "", err
+	}
+	var owners []string
+	for _, m := range members {
+		if m.Role == membership.RoleOwner {
+			owners = append(owners, m.Edges.User.Username)
+		}
+	}
+	if len(owners) == 0 {
+		return "-", nil
+	}
+	return strings.Join(owners, ","), nil
+}
+
+func runCommunityDelete(cmd *cobra.Command, args []string) error {
+	client, cfg, err := openClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := cmd.Context()
+	repo := community.NewRepository(client)
+
+	if communityWithPosts {
+		comm, err := repo.GetBySlug(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		blobs, err := storage.Open(cfg.Storage, client)
+		if err != nil {
+			return err
+		}
+		// Deleting a post deletes its replies, so start from the top
+		ids, err := client.Post.Query().
+			Where(post.HasCommunityWith(entCommunity.ID(comm.ID)), post.ReplyToIsNil()).
+			IDs(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		postRepo := enginePost.New(client, blobs)
+		for _, id := range ids {
+			if err := postRepo.Delete(ctx, id); err != nil {
+				return err
+			}
+		}
+		slog.Info("deleted posts", "community", comm.Name, "threads", len(ids))
+	}
+
+	if err := repo.Delete(ctx, args[0]); err != nil {
+		return err
+	}
+	slog.Info("deleted community", "name", args[0])
+	return nil
+}
+
+func runCommunityRole(cmd *cobra.Command, args []string) error {
+	client, cfg, err := openClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	role := membership.Role(args[2])
+	if err := membership.RoleValidator(role); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	repo := community.NewRepository(client)
+	comm, err := repo.GetBySlug(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	users, err := userRepository(client, cfg)
+	if err != nil {
+		return err
+	}
+	u, err := users.Find(ctx, args[1])
+	if err != nil {
+		return err
+	}
+
+	if err := repo.Assign(ctx, comm.ID, u, role); err != nil {
+		return err
+	}
+	slog.Info("set community role", "community", comm.Name, "user", u.Username, "role", role)
+	return nil
+}
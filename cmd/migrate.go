@@ -0,0 +1,158 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"fixit/engine/migration"
+	"fixit/web/app"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Manage the database schema",
+	Long:  "Apply and revert the versioned SQL migrations in engine/ent/migrate/migrations",
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up [n]",
+	Short: "Apply pending migrations",
+	Long:  "Apply the next n pending migrations, or all of them when n is left out",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runMigrateUp,
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down [n]",
+	Short: "Revert applied migrations",
+	Long:  "Revert the n most recently applied migrations, one when n is left out",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runMigrateDown,
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "List migrations and whether they're applied",
+	Args:  cobra.NoArgs,
+	RunE:  runMigrateStatus,
+}
+
+var (
+	migrateBaseline string
+)
+
+func init() {
+	migrateUpCmd.Flags().StringVar(&migrateBaseline, "baseline", "",
+		"Mark migrations up to this version as applied without running them, for databases created by auto-migration")
+
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd)
+	rootCmd.AddCommand(migrateCmd)
+}
+
+func openMigrator() (*migration.Migrator, func(), error) {
+	cfg := app.Config{}
+	if err := env.Parse(&cfg); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse env")
+	}
+
+	db, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	migrator, err := migration.New(db)
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	return migrator, func() { db.Close() }, nil
+}
+
+// countArg parses the optional [n] argument
+func countArg(args []string, fallback int) (int, error) {
+	if len(args) == 0 {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 1 {
+		return 0, errors.Errorf("n must be a positive number, got %q", args[0])
+	}
+	return n, nil
+}
+
+func runMigrateUp(cmd *cobra.Command, args []string) error {
+	n, err := countArg(args, 0)
+	if err != nil {
+		return err
+	}
+	migrator, closeDB, err := openMigrator()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	if migrateBaseline != "" {
+		if err := migrator.Baseline(cmd.Context(), migrateBaseline); err != nil {
+			return err
+		}
+		slog.Info("marked migrations as applied", "up_to", migrateBaseline)
+	}
+
+	applied, err := migrator.Up(cmd.Context(), n)
+	for _, m := range applied {
+		slog.Info("applied migration", "version", m.Version, "description", m.Description)
+	}
+	if err != nil {
+		return err
+	}
+	slog.Info("database schema is up to date", "applied", len(applied))
+	return nil
+}
+
+func runMigrateDown(cmd *cobra.Command, args []string) error {
+	n, err := countArg(args, 1)
+	if err != nil {
+		return err
+	}
+	migrator, closeDB, err := openMigrator()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	reverted, err := migrator.Down(cmd.Context(), n)
+	for _, m := range reverted {
+		slog.Info("reverted migration", "version", m.Version, "description", m.Description)
+	}
+	return err
+}
+
+func runMigrateStatus(cmd *cobra.Command, args []string) error {
+	migrator, closeDB, err := openMigrator()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	status, err := migrator.Status(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tDESCRIPTION\tAPPLIED")
+	for _, m := range status {
+		applied := "pending"
+		if m.AppliedAt != nil {
+			applied = m.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", m.Version, m.Description, applied)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"log/slog"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"fixit/engine/post"
+	"fixit/engine/storage"
+)
+
+var postCmd = &cobra.Command{
+	Use:   "post",
+	Short: "Manage posts",
+}
+
+var postDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a post",
+	Long:  "Delete a post for good, along with its replies, votes, status history and photos",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPostDelete,
+}
+
+func init() {
+	postCmd.AddCommand(postDeleteCmd)
+	rootCmd.AddCommand(postCmd)
+}
+
+func runPostDelete(cmd *cobra.Command, args []string) error {
+	id, err := uuid.FromString(args[0])
+	if err != nil {
+		return errors.Errorf("not a post ID: %q", args[0])
+	}
+
+	client, cfg, err := openClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	blobs, err := storage.Open(cfg.Storage, client)
+	if err != nil {
+		return err
+	}
+	if err := post.New(client, blobs).Delete(cmd.Context(), id); err != nil {
+		return err
+	}
+	slog.Info("deleted post", "id", id)
+	return nil
+}
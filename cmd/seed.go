@@ -0,0 +1,58 @@
+package main
+
+import (
+	"log/slog"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"fixit/engine/community"
+	"fixit/engine/ent"
+	"fixit/web/app"
+)
+
+var seedCmd = &cobra.Command{
+	Use:   "seed",
+	Short: "Add example data for development",
+	Long: "Create the swindon community with a few users and issues. " +
+		"It does nothing if the community already exists.",
+	Args: cobra.NoArgs,
+	RunE: runSeed,
+}
+
+func init() {
+	rootCmd.AddCommand(seedCmd)
+}
+
+// openClient connects to the database, which must already be migrated
+func openClient() (*ent.Client, app.Config, error) {
+	cfg := app.Config{}
+	if err := env.Parse(&cfg); err != nil {
+		return nil, cfg, errors.Wrap(err, "failed to parse env")
+	}
+
+	client, err := ent.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		return nil, cfg, errors.WithStack(err)
+	}
+	return client, cfg, nil
+}
+
+func runSeed(cmd *cobra.Command, args []string) error {
+	client, cfg, err := openClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	users, err := userRepository(client, cfg)
+	if err != nil {
+		return err
+	}
+	if err := community.NewRepository(client).Seed(cmd.Context(), users); err != nil {
+		return err
+	}
+	slog.Info("seeded database")
+	return nil
+}
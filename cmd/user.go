@@ -0,0 +1,229 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"fixit/engine/auth"
+	"fixit/engine/ent"
+	"fixit/engine/user"
+	"fixit/web/app"
+)
+
+var userCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Manage user accounts",
+}
+
+var userCreateCmd = &cobra.Command{
+	Use:   "create <username> <email>",
+	Short: "Create a user",
+	Long:  "Create a user who can log in straight away. The password is read from standard input unless --password is given.",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runUserCreate,
+}
+
+var userListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List users",
+	Args:  cobra.NoArgs,
+	RunE:  runUserList,
+}
+
+var userResetPasswordCmd = &cobra.Command{
+	Use:   "reset-password <username or email>",
+	Short: "Set a user's password",
+	Long:  "Set a user's password. The password is read from standard input unless --password is given.",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runUserResetPassword,
+}
+
+var userBanCmd = &cobra.Command{
+	Use:   "ban <username or email>",
+	Short: "Stop a user logging in",
+	Long:  "Stop a user logging in and end their sessions, or with --lift let them back in",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runUserBan,
+}
+
+var userRehashPasswordsCmd = &cobra.Command{
+	Use:   "rehash-passwords",
+	Short: "Hash passwords stored as plaintext",
+	Long: "Find users whose password was stored without hashing, as seeded and test users used to be, " +
+		"and hash it so they can log in. Hashes made with an old BCRYPT_COST are updated as users log in instead.",
+	Args: cobra.NoArgs,
+	RunE: runUserRehashPasswords,
+}
+
+var (
+	userPassword string
+	userLiftBan  bool
+)
+
+func init() {
+	for _, c := range []*cobra.Command{userCreateCmd, userResetPasswordCmd} {
+		c.Flags().StringVar(&userPassword, "password", "", "Password to set, visible in shell history and process lists")
+	}
+	userBanCmd.Flags().BoolVar(&userLiftBan, "lift", false, "Lift the ban instead")
+
+	userCmd.AddCommand(userCreateCmd, userListCmd, userResetPasswordCmd, userBanCmd, userRehashPasswordsCmd)
+	rootCmd.AddCommand(userCmd)
+}
+
+// userRepository hashes passwords with the configured cost
+func userRepository(client *ent.Client, cfg app.Config) (*user.Repository, error) {
+	passwords, err := auth.NewPasswords(cfg.Auth.BcryptCost)
+	if err != nil {
+		return nil, err
+	}
+	return user.New(client, passwords), nil
+}
+
+// readPassword returns --password, or the first line of standard input
+func readPassword(cmd *cobra.Command) (string, error) {
+	if userPassword != "" {
+		return userPassword, nil
+	}
+
+	fmt.Fprint(cmd.ErrOrStderr(), "Password: ")
+	line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", errors.WithStack(err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func runUserCreate(cmd *cobra.Command, args []string) error {
+	password, err := readPassword(cmd)
+	if err != nil {
+		return err
+	}
+	client, cfg, err := openClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	users, err := userRepository(client, cfg)
+	if err != nil {
+		return err
+	}
+	u, err := users.Create(cmd.Context(), user.CreateFields{
+		Username: args[0],
+		Email:    args[1],
+		Password: password,
+	})
+	if err != nil {
+		return err
+	}
+	slog.Info("created user", "id", u.ID, "username", u.Username)
+	return nil
+}
+
+func runUserList(cmd *cobra.Command, args []string) error {
+	client, cfg, err := openClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	repo, err := userRepository(client, cfg)
+	if err != nil {
+		return err
+	}
+	users, err := repo.List(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "USERNAME\tEMAIL\tCREATED\tBANNED")
+	for _, u := range users {
+		banned := ""
+		if u.BannedAt != nil {
+			banned = u.BannedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", u.Username, u.Email, u.CreatedAt.Format("2006-01-02"), banned)
+	}
+	return w.Flush()
+}
+
+func runUserResetPassword(cmd *cobra.Command, args []string) error {
+	password, err := readPassword(cmd)
+	if err != nil {
+		return err
+	}
+	client, cfg, err := openClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	users, err := userRepository(client, cfg)
+	if err != nil {
+		return err
+	}
+	u, err := users.ResetPassword(cmd.Context(), args[0], password)
+	if err != nil {
+		return err
+	}
+	slog.Info("reset password", "username", u.Username)
+	return nil
+}
+
+func runUserBan(cmd *cobra.Command, args []string) error {
+	client, cfg, err := openClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	repo, err := userRepository(client, cfg)
+	if err != nil {
+		return err
+	}
+	if userLiftBan {
+		u, err := repo.Unban(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+		slog.Info("lifted ban", "username", u.Username)
+		return nil
+	}
+
+	u, err := repo.Ban(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+	slog.Info("banned user", "username", u.Username, "since", u.BannedAt)
+	return nil
+}
+
+func runUserRehashPasswords(cmd *cobra.Command, args []string) error {
+	client, cfg, err := openClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	repo, err := userRepository(client, cfg)
+	if err != nil {
+		return err
+	}
+	rehashed, err := repo.RehashPlaintext(cmd.Context())
+	for _, u := range rehashed {
+		slog.Info("hashed plaintext password", "username", u.Username)
+	}
+	if err != nil {
+		return err
+	}
+	slog.Info("no plaintext passwords left", "rehashed", len(rehashed))
+	return nil
+}
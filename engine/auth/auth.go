@@ -2,53 +2,139 @@ package auth
 
 import (
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/aarondl/authboss/v3"
 	_ "github.com/aarondl/authboss/v3/auth"
+	_ "github.com/aarondl/authboss/v3/confirm"
+	_ "github.com/aarondl/authboss/v3/lock"
+	_ "github.com/aarondl/authboss/v3/logout"
+	_ "github.com/aarondl/authboss/v3/recover"
 	"github.com/aarondl/authboss/v3/defaults"
 	_ "github.com/aarondl/authboss/v3/register"
+	_ "github.com/aarondl/authboss/v3/remember"
 	"github.com/gorilla/mux"
 
 	"fixit/engine/ent"
+	"fixit/engine/mail"
 	webauth "fixit/web/auth"
 )
 
+// DefaultSessionKey is SESSION_KEY's default, only fit for development
+const DefaultSessionKey = "your-32-byte-secret-key-here!!"
+
 type Config struct {
-	SessionKey  string `env:"SESSION_KEY" envDefault:"your-32-byte-secret-key-here!!"`
-	SendGridKey string `env:"SENDGRID_API_KEY"`
-	FromEmail   string `env:"FROM_EMAIL" envDefault:"noreply@fixit.local"`
-	FromName    string `env:"FROM_NAME" envDefault:"FixIt"`
-	RootURL     string `env:"ROOT_URL" envDefault:"http://localhost:8080"`
+	// SessionKey signs cookies. Its default must match DefaultSessionKey.
+	SessionKey string `env:"SESSION_KEY" envDefault:"your-32-byte-secret-key-here!!"`
+	FromEmail  string `env:"FROM_EMAIL" envDefault:"noreply@fixit.local"`
+	FromName   string `env:"FROM_NAME" envDefault:"FixIt"`
+	RootURL    string `env:"ROOT_URL" envDefault:"http://localhost:8080"`
+	Mail       mail.Config
+	// BcryptCost applies to new hashes, existing ones are rehashed as users
+	// log in
+	BcryptCost int `env:"BCRYPT_COST" envDefault:"10"`
+	// RecoverTokenDuration is how long a password reset link works for
+	RecoverTokenDuration time.Duration `env:"RECOVER_TOKEN_DURATION" envDefault:"1h"`
+	// SessionStore is where sessions are kept, SessionStoreCookie or
+	// SessionStorePostgres
+	SessionStore  string        `env:"SESSION_STORE" envDefault:"cookie"`
+	SessionMaxAge time.Duration `env:"SESSION_MAX_AGE" envDefault:"24h"`
+	// RememberDuration is how long "remember me" keeps a browser logged in
+	// without being used
+	RememberDuration time.Duration `env:"REMEMBER_DURATION" envDefault:"720h"`
+	// LockAfter failed logins within LockWindow lock the account for
+	// LockDuration
+	LockAfter    int           `env:"LOCK_AFTER" envDefault:"5"`
+	LockWindow   time.Duration `env:"LOCK_WINDOW" envDefault:"15m"`
+	LockDuration time.Duration `env:"LOCK_DURATION" envDefault:"1h"`
+	// OIDC are the OpenID Connect providers users can log in through
+	OIDC []OIDCProvider `envPrefix:"OIDC_"`
+	// Cookies aren't from the environment, the app sets them to match the
+	// web server's
+	Cookies CookieSettings
 }
 
 func Setup(client *ent.Client, cfg Config) (*authboss.Authboss, error) {
 	ab := authboss.New()
 
+	passwords, err := NewPasswords(cfg.BcryptCost)
+	if err != nil {
+		return nil, err
+	}
+	mailer, err := mail.Open(cfg.Mail)
+	if err != nil {
+		return nil, err
+	}
+
 	// Configure storage first
-	ab.Config.Storage.Server = NewStorer(client)
-	sessionStorer := NewSessionStorer([]byte(cfg.SessionKey))
-	ab.Config.Storage.SessionState = sessionStorer
-	ab.Config.Storage.CookieState = sessionStorer
+	storer := NewStorer(client)
+	storer.rememberFor = cfg.RememberDuration
+	ab.Config.Storage.Server = storer
+	store, err := OpenSessionStore(cfg, client)
+	if err != nil {
+		return nil, err
+	}
+	ab.Config.Storage.SessionState = NewSessionStorer(store, cfg.Cookies)
+	ab.Config.Storage.CookieState = NewCookieStorer([]byte(cfg.SessionKey), storer.rememberDuration(), cfg.Cookies)
 
 	// Configure paths
 	ab.Config.Paths.Mount = "/auth"
 	ab.Config.Paths.AuthLoginOK = "/"
 	ab.Config.Paths.RegisterOK = "/"
 	ab.Config.Paths.RootURL = cfg.RootURL
+	// Unconfirmed users are sent back to login, which shows why
+	ab.Config.Paths.ConfirmOK = "/auth/login"
+	ab.Config.Paths.ConfirmNotOK = "/auth/login"
+	// After asking for a reset link, and after resetting, users log in
+	ab.Config.Paths.RecoverOK = "/auth/login"
+	// Logging out is a form post, and login shows that it worked
+	ab.Config.Modules.LogoutMethod = "POST"
+	ab.Config.Paths.LogoutOK = "/auth/login"
+	ab.Config.Paths.LockNotOK = lockedPath
+
+	ab.Config.Mail.From = cfg.FromEmail
+	ab.Config.Mail.FromName = cfg.FromName
+	ab.Config.Modules.MailNoGoroutine = true
+	// Zero keeps authboss' default of a day
+	if cfg.RecoverTokenDuration > 0 {
+		ab.Config.Modules.RecoverTokenDuration = cfg.RecoverTokenDuration
+	}
+	// As are authboss' lock defaults, of 3 failures in 5 minutes locking for
+	// 12 hours
+	if cfg.LockAfter > 0 {
+		ab.Config.Modules.LockAfter = cfg.LockAfter
+	}
+	if cfg.LockWindow > 0 {
+		ab.Config.Modules.LockWindow = cfg.LockWindow
+	}
+	if cfg.LockDuration > 0 {
+		ab.Config.Modules.LockDuration = cfg.LockDuration
+	}
 
 	// Use our custom renderer
-	ab.Config.Core.ViewRenderer = webauth.NewRenderer()
+	renderer := webauth.NewRenderer()
+	ab.Config.Core.ViewRenderer = renderer
 
 	// Set up defaults after configuring our custom components
 	defaults.SetCore(&ab.Config, false, false)
-
-	if cfg.SendGridKey != "" {
-		ab.Config.Core.Mailer = NewMailer(cfg.SendGridKey, cfg.FromName, cfg.FromEmail)
-	}
+	ab.Config.Core.Hasher = passwords
+	ab.Config.Core.MailRenderer = webauth.NewMailRenderer()
+	ab.Config.Core.Mailer = mailer
 
 	if err := ab.Init(); err != nil {
 		return nil, err
 	}
+	ab.Events.After(authboss.EventAuth, rehashOnLogin(storer, passwords))
+	ab.Events.After(authboss.EventAuth, stampSession)
+	ab.Events.Before(authboss.EventLogout, forgetRememberToken(storer))
+	ab.Events.After(authboss.EventRecoverEnd, endSessionsOnRecover(NewSessions(client)))
+	ab.Events.After(authboss.EventRecoverEnd, unlockOnRecover(client))
+	ab.Config.Core.Router.Get(strings.TrimPrefix(lockedPath, ab.Config.Paths.Mount), lockedPage(ab))
+	renderer.Providers, err = setupOIDC(ab, client, passwords, cfg.OIDC)
+	if err != nil {
+		return nil, err
+	}
 
 	return ab, nil
 }
@@ -58,6 +144,29 @@ func Middleware(ab *authboss.Authboss) []mux.MiddlewareFunc {
 		func(next http.Handler) http.Handler {
 			return ab.LoadClientStateMiddleware(next)
 		},
+		sessionVersionMiddleware(ab),
+		rememberMiddleware(ab),
+		flashMiddleware(ab),
+	}
+}
+
+// flashMiddleware passes the messages authboss leaves in the session when
+// redirecting, like "please confirm your account", to the next auth page
+func flashMiddleware(ab *authboss.Authboss) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, ab.Config.Paths.Mount+"/") {
+				data := authboss.HTMLData{}
+				if msg := authboss.FlashSuccess(w, r); msg != "" {
+					data[authboss.FlashSuccessKey] = msg
+				}
+				if msg := authboss.FlashError(w, r); msg != "" {
+					data[authboss.FlashErrorKey] = msg
+				}
+				authboss.MergeDataInRequest(&r, data)
+			}
+			next.ServeHTTP(w, r)
+		})
 	}
 }
 
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/gorilla/securecookie"
+	"github.com/pkg/errors"
+)
+
+// cookieNames are the cookies authboss keeps state in
+var cookieNames = []string{authboss.CookieRemember}
+
+// CookieSettings are how the web server secures and names the site's
+// cookies, which auth's cookies follow
+type CookieSettings struct {
+	// Secure is true when the site is served over https
+	Secure bool
+	// Prefix starts the name of each cookie
+	Prefix string
+}
+
+func (s CookieSettings) name(name string) string {
+	return s.Prefix + name
+}
+
+// CookieStorer keeps authboss cookie state, the remember me token, in signed
+// cookies of its own that outlive the session
+type CookieStorer struct {
+	codec   *securecookie.SecureCookie
+	maxAge  time.Duration
+	cookies CookieSettings
+}
+
+var _ authboss.ClientStateReadWriter = (*CookieStorer)(nil)
+
+func NewCookieStorer(key []byte, maxAge time.Duration, cookies CookieSettings) *CookieStorer {
+	codec := securecookie.New(key, nil)
+	codec.MaxAge(int(maxAge.Seconds()))
+	return &CookieStorer{
+		codec:   codec,
+		maxAge:  maxAge,
+		cookies: cookies,
+	}
+}
+
+// ReadState ignores cookies that don't decode, as after the key changes
+func (c *CookieStorer) ReadState(r *http.Request) (authboss.ClientState, error) {
+	state := cookieState{}
+	for _, name := range cookieNames {
+		cookie, err := r.Cookie(c.cookies.name(name))
+		if err != nil {
+			continue
+		}
+		var value string
+		if err := c.codec.Decode(cookie.Name, cookie.Value, &value); err == nil {
+			state[name] = value
+		}
+	}
+	return state, nil
+}
+
+func (c *CookieStorer) WriteState(w http.ResponseWriter, _ authboss.ClientState, ev []authboss.ClientStateEvent) error {
+	for _, event := range ev {
+		cookie := &http.Cookie{
+			Name:     c.cookies.name(event.Key),
+			Path:     "/",
+			HttpOnly: true,
+			Secure:   c.cookies.Secure,
+			SameSite: http.SameSiteLaxMode,
+		}
+		switch event.Kind {
+		case authboss.ClientStateEventPut:
+			encoded, err := c.codec.Encode(cookie.Name, event.Value)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			cookie.Value = encoded
+			cookie.MaxAge = int(c.maxAge.Seconds())
+			cookie.Expires = time.Now().Add(c.maxAge)
+		case authboss.ClientStateEventDel:
+			cookie.MaxAge = -1
+		default:
+			continue
+		}
+		http.SetCookie(w, cookie)
+	}
+	return nil
+}
+
+type cookieState map[string]string
+
+func (c cookieState) Get(key string) (string, bool) {
+	value, ok := c[key]
+	return value, ok
+}
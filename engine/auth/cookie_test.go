@@ -0,0 +1,80 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/auth"
+)
+
+func TestCookieStorer(t *testing.T) {
+	storer := auth.NewCookieStorer([]byte("test-32-byte-secret-key-here!!!"), time.Hour, auth.CookieSettings{})
+
+	rec := httptest.NewRecorder()
+	err := storer.WriteState(rec, nil, []authboss.ClientStateEvent{
+		{Kind: authboss.ClientStateEventPut, Key: authboss.CookieRemember, Value: "token"},
+	})
+	require.NoError(t, err)
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, 3600, cookies[0].MaxAge)
+	assert.True(t, cookies[0].HttpOnly)
+	assert.NotContains(t, cookies[0].Value, "token", "the value is encoded")
+
+	// Test: The cookie reads back
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(cookies[0])
+	state, err := storer.ReadState(req)
+	require.NoError(t, err)
+	value, ok := state.Get(authboss.CookieRemember)
+	assert.True(t, ok)
+	assert.Equal(t, "token", value)
+
+	// Test: Tampered cookies are ignored
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(&http.Cookie{Name: authboss.CookieRemember, Value: cookies[0].Value + "x"})
+	state, err = storer.ReadState(req)
+	require.NoError(t, err)
+	_, ok = state.Get(authboss.CookieRemember)
+	assert.False(t, ok)
+
+	// Test: Deleting expires the cookie
+	rec = httptest.NewRecorder()
+	err = storer.WriteState(rec, nil, []authboss.ClientStateEvent{
+		{Kind: authboss.ClientStateEventDel, Key: authboss.CookieRemember},
+	})
+	require.NoError(t, err)
+	cookies = rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Negative(t, cookies[0].MaxAge)
+}
+
+func TestCookieStorerSecure(t *testing.T) {
+	storer := auth.NewCookieStorer([]byte("test-32-byte-secret-key-here!!!"), time.Hour, auth.CookieSettings{Secure: true, Prefix: "__Host-"})
+
+	rec := httptest.NewRecorder()
+	err := storer.WriteState(rec, nil, []authboss.ClientStateEvent{
+		{Kind: authboss.ClientStateEventPut, Key: authboss.CookieRemember, Value: "token"},
+	})
+	require.NoError(t, err)
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, "__Host-"+authboss.CookieRemember, cookies[0].Name)
+	assert.True(t, cookies[0].Secure)
+	assert.Equal(t, "/", cookies[0].Path)
+
+	// Test: The prefixed cookie reads back under authboss's name
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(cookies[0])
+	state, err := storer.ReadState(req)
+	require.NoError(t, err)
+	value, ok := state.Get(authboss.CookieRemember)
+	assert.True(t, ok)
+	assert.Equal(t, "token", value)
+}
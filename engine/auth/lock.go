@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+)
+
+// lockedPath explains to users who've been locked out what happened
+const lockedPath = "/auth/locked"
+
+// lockedPage is where the lock module sends locked out users, with when to
+// try again and the way out through a password reset
+func lockedPage(ab *authboss.Authboss) http.Handler {
+	return ab.Config.Core.ErrorHandler.Wrap(func(w http.ResponseWriter, r *http.Request) error {
+		data := authboss.HTMLData{
+			"lock_duration": durationText(ab.Config.Modules.LockDuration),
+		}
+		return ab.Config.Core.Responder.Respond(w, r, http.StatusOK, "locked", data)
+	})
+}
+
+// unlockOnRecover lets a locked out user straight back in once they've reset
+// their password, since whoever was guessing it no longer matters
+func unlockOnRecover(client *ent.Client) authboss.EventHandler {
+	return func(w http.ResponseWriter, r *http.Request, handled bool) (bool, error) {
+		user, ok := r.Context().Value(authboss.CTXKeyUser).(User)
+		if !ok {
+			return false, nil
+		}
+		err := client.User.UpdateOneID(user.ID).
+			SetAttemptCount(0).
+			ClearLockedUntil().
+			Exec(r.Context())
+		return false, errors.WithStack(err)
+	}
+}
+
+// durationText is a duration in whole hours or minutes, as people say it
+func durationText(d time.Duration) string {
+	plural := func(n int, unit string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 %s", unit)
+		}
+		return fmt.Sprintf("%d %ss", n, unit)
+	}
+	switch {
+	case d >= time.Hour && d%time.Hour == 0:
+		return plural(int(d/time.Hour), "hour")
+	case d >= time.Minute && d%time.Minute == 0:
+		return plural(int(d/time.Minute), "minute")
+	default:
+		return d.String()
+	}
+}
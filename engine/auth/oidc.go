@@ -0,0 +1,359 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gofrs/uuid/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+
+	"fixit/engine/ent"
+	"fixit/engine/ent/identity"
+	user2 "fixit/engine/ent/user"
+	webauth "fixit/web/auth"
+)
+
+// Session keys holding a login in progress, checked when the provider sends
+// the user back
+const (
+	oidcStateKey    = "oidc_state"
+	oidcNonceKey    = "oidc_nonce"
+	oidcVerifierKey = "oidc_verifier"
+)
+
+var (
+	ErrInvalidOIDCProvider = errors.New("OpenID Connect providers need a unique name, an issuer and a client ID")
+	ErrNoVerifiedEmail     = errors.New("the provider didn't share a verified email address")
+)
+
+// OIDCProvider is an OpenID Connect provider users can log in through,
+// configured as OIDC_0_NAME, OIDC_0_ISSUER and so on
+type OIDCProvider struct {
+	// Name is used in URLs and stored with linked identities, so shouldn't
+	// change
+	Name string `env:"NAME"`
+	// Title is shown on the login button, the name when empty
+	Title        string `env:"TITLE"`
+	Issuer       string `env:"ISSUER"`
+	ClientID     string `env:"CLIENT_ID"`
+	ClientSecret string `env:"CLIENT_SECRET"`
+}
+
+// oidcClaims are the claims read from ID tokens
+type oidcClaims struct {
+	Subject           string `json:"sub"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+	Name              string `json:"name"`
+}
+
+// oidcLogin logs users in through one provider
+type oidcLogin struct {
+	ab          *authboss.Authboss
+	client      *ent.Client
+	passwords   *Passwords
+	config      OIDCProvider
+	redirectURL string
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+// setupOIDC adds the routes for logging in through each provider, and
+// returns them for the login page
+func setupOIDC(ab *authboss.Authboss, client *ent.Client, passwords *Passwords, providers []OIDCProvider) ([]webauth.Provider, error) {
+	var links []webauth.Provider
+	seen := map[string]bool{}
+	for _, p := range providers {
+		if p.Name == "" || p.Issuer == "" || p.ClientID == "" || seen[p.Name] {
+			return nil, errors.Wrapf(ErrInvalidOIDCProvider, "%q", p.Name)
+		}
+		seen[p.Name] = true
+		if p.Title == "" {
+			p.Title = p.Name
+		}
+
+		login := &oidcLogin{
+			ab:          ab,
+			client:      client,
+			passwords:   passwords,
+			config:      p,
+			redirectURL: ab.Config.Paths.RootURL + ab.Config.Paths.Mount + "/oidc/" + p.Name + "/callback",
+		}
+		wrap := ab.Config.Core.ErrorHandler.Wrap
+		ab.Config.Core.Router.Get("/oidc/"+p.Name, wrap(login.start))
+		ab.Config.Core.Router.Get("/oidc/"+p.Name+"/callback", wrap(login.callback))
+		links = append(links, webauth.Provider{Name: p.Name, Title: p.Title})
+	}
+	return links, nil
+}
+
+// discover fetches the provider's endpoints and keys the first time they're
+// needed, so the server starts while a provider is down
+func (o *oidcLogin) discover(ctx context.Context) (*oidc.Provider, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.provider != nil {
+		return o.provider, nil
+	}
+	// The provider keeps the context for fetching keys later
+	provider, err := oidc.NewProvider(context.WithoutCancel(ctx), o.config.Issuer)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	o.provider = provider
+	return provider, nil
+}
+
+func (o *oidcLogin) oauth2Config(provider *oidc.Provider) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     o.config.ClientID,
+		ClientSecret: o.config.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  o.redirectURL,
+		Scopes:       []string{oidc.ScopeOpenID, "email", "profile"},
+	}
+}
+
+// start sends the user to the provider to log in
+func (o *oidcLogin) start(w http.ResponseWriter, r *http.Request) error {
+	provider, err := o.discover(r.Context())
+	if err != nil {
+		slog.Error("failed to discover OpenID Connect provider", "provider", o.config.Name, "error", err)
+		return o.fail(w, r)
+	}
+
+	state, nonce := randomToken(), randomToken()
+	verifier := oauth2.GenerateVerifier()
+	authboss.PutSession(w, oidcStateKey, state)
+	authboss.PutSession(w, oidcNonceKey, nonce)
+	authboss.PutSession(w, oidcVerifierKey, verifier)
+
+	url := o.oauth2Config(provider).AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier))
+	http.Redirect(w, r, url, http.StatusFound)
+	return nil
+}
+
+// callback logs in the user the provider sent back, linking their identity
+// the first time
+func (o *oidcLogin) callback(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	state, _ := authboss.GetSession(r, oidcStateKey)
+	nonce, _ := authboss.GetSession(r, oidcNonceKey)
+	verifier, _ := authboss.GetSession(r, oidcVerifierKey)
+	authboss.DelSession(w, oidcStateKey)
+	authboss.DelSession(w, oidcNonceKey)
+	authboss.DelSession(w, oidcVerifierKey)
+
+	query := r.URL.Query()
+	if state == "" || query.Get("state") != state || query.Get("error") != "" {
+		return o.fail(w, r)
+	}
+
+	provider, err := o.discover(ctx)
+	if err != nil {
+		slog.Error("failed to discover OpenID Connect provider", "provider", o.config.Name, "error", err)
+		return o.fail(w, r)
+	}
+	claims, err := o.exchange(ctx, provider, query.Get("code"), verifier, nonce)
+	if err != nil {
+		slog.Error("failed to log in with OpenID Connect", "provider", o.config.Name, "error", err)
+		return o.fail(w, r)
+	}
+
+	u, err := linkIdentity(ctx, o.client, o.passwords, o.config.Name, claims)
+	if errors.Is(err, ErrNoVerifiedEmail) {
+		return o.redirectToLogin(w, r, o.config.Title+" didn't share a verified email address with us, which we need to log you in.")
+	}
+	if err != nil {
+		return err
+	}
+	// Loading through the storer turns away banned users
+	user, err := o.ab.Config.Storage.Server.Load(ctx, u.Email)
+	if errors.Is(err, authboss.ErrUserNotFound) {
+		return o.fail(w, r)
+	}
+	if err != nil {
+		return err
+	}
+	return o.logIn(w, r, user)
+}
+
+// exchange swaps the code for an ID token and returns its claims once it's
+// checked
+func (o *oidcLogin) exchange(ctx context.Context, provider *oidc.Provider, code, verifier, nonce string) (oidcClaims, error) {
+	var claims oidcClaims
+	token, err := o.oauth2Config(provider).Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return claims, errors.WithStack(err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return claims, errors.New("token response has no id_token")
+	}
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: o.config.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return claims, errors.WithStack(err)
+	}
+	if idToken.Nonce != nonce {
+		return claims, errors.New("ID token nonce doesn't match")
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return claims, errors.WithStack(err)
+	}
+	return claims, nil
+}
+
+// logIn goes through the same checks and events as a password login, so
+// locked and unconfirmed users are turned away and the session is stamped
+func (o *oidcLogin) logIn(w http.ResponseWriter, r *http.Request, user authboss.User) error {
+	r = r.WithContext(context.WithValue(r.Context(), authboss.CTXKeyUser, user))
+	handled, err := o.ab.Events.FireBefore(authboss.EventAuth, w, r)
+	if err != nil || handled {
+		return err
+	}
+
+	authboss.PutSession(w, authboss.SessionKey, user.GetPID())
+	authboss.DelSession(w, authboss.SessionHalfAuthKey)
+
+	handled, err = o.ab.Events.FireAfter(authboss.EventAuth, w, r)
+	if err != nil || handled {
+		return err
+	}
+	return o.ab.Config.Core.Redirector.Redirect(w, r, authboss.RedirectOptions{
+		Code:         http.StatusTemporaryRedirect,
+		RedirectPath: o.ab.Config.Paths.AuthLoginOK,
+	})
+}
+
+func (o *oidcLogin) fail(w http.ResponseWriter, r *http.Request) error {
+	return o.redirectToLogin(w, r, "We couldn't log you in with "+o.config.Title+", please try again.")
+}
+
+func (o *oidcLogin) redirectToLogin(w http.ResponseWriter, r *http.Request, message string) error {
+	return o.ab.Config.Core.Redirector.Redirect(w, r, authboss.RedirectOptions{
+		Code:         http.StatusTemporaryRedirect,
+		RedirectPath: o.ab.Config.Paths.Mount + "/login",
+		Failure:      message,
+	})
+}
+
+// linkIdentity returns the user a provider's identity belongs to. The first
+// time an identity is seen it's linked to the user with its email address,
+// or a new user, but only when the provider has verified the address.
+func linkIdentity(ctx context.Context, client *ent.Client, passwords *Passwords, provider string, claims oidcClaims) (*ent.User, error) {
+	linked, err := client.Identity.Query().
+		Where(identity.ProviderEQ(provider), identity.SubjectEQ(claims.Subject)).
+		QueryUser().
+		Only(ctx)
+	if err == nil {
+		return linked, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, errors.WithStack(err)
+	}
+	if claims.Subject == "" || claims.Email == "" || !claims.EmailVerified {
+		return nil, errors.WithStack(ErrNoVerifiedEmail)
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	u, err := userByEmail(ctx, tx.Client(), claims.Email)
+	switch {
+	case err != nil:
+		return nil, errors.WithStack(err)
+	case u == nil:
+		username, err := uniqueUsername(ctx, tx.Client(), claims.PreferredUsername, claims.Name, claims.Email)
+		if err != nil {
+			return nil, err
+		}
+		// Users can set a password later by resetting it
+		password, err := passwords.GenerateHash(randomToken())
+		if err != nil {
+			return nil, err
+		}
+		u, err = tx.User.Create().
+			SetUsername(username).
+			SetEmail(claims.Email).
+			SetPassword(password).
+			SetConfirmed(true).
+			Save(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	case !u.Confirmed:
+		// The provider has confirmed the address for us. Whoever signed up
+		// with it didn't, so may not be its owner: their password and any
+		// sessions go, and the owner can set a new password by resetting it.
+		password, err := passwords.GenerateHash(randomToken())
+		if err != nil {
+			return nil, err
+		}
+		u, err = u.Update().
+			SetPassword(password).
+			SetConfirmed(true).
+			ClearConfirmSelector().
+			ClearConfirmVerifier().
+			ClearRecoverSelector().
+			ClearRecoverVerifier().
+			ClearRecoverExpiry().
+			Save(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if _, err := revokeSessions(ctx, tx, u.ID, uuid.Nil); err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Identity.Create().
+		SetUserID(u.ID).
+		SetProvider(provider).
+		SetSubject(claims.Subject).
+		SetEmail(claims.Email).
+		Exec(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return u, errors.WithStack(tx.Commit())
+}
+
+// userByEmail finds the user with the email, ignoring case as providers
+// don't keep to how it was typed at sign up. An exact match wins when
+// accounts differ only by case. It's nil when there's no such user.
+func userByEmail(ctx context.Context, client *ent.Client, email string) (*ent.User, error) {
+	users, err := client.User.Query().Where(user2.EmailEqualFold(email)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	if len(users) == 0 {
+		return nil, nil
+	}
+	return users[0], nil
+}
+
+// randomToken is 32 random bytes, URL safe
+func randomToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidCost       = errors.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	ErrPlaintextPassword = errors.New("password must be hashed before it's stored")
+)
+
+// Passwords hashes and checks user passwords. Every way of setting a
+// password goes through it, authboss included as its Hasher, so changing
+// the cost applies everywhere.
+type Passwords struct {
+	cost int
+}
+
+// NewPasswords hashes with the given bcrypt cost, or bcrypt's default when
+// it's zero
+func NewPasswords(cost int) (*Passwords, error) {
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return nil, errors.WithStack(ErrInvalidCost)
+	}
+	return &Passwords{cost: cost}, nil
+}
+
+func (p *Passwords) GenerateHash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), p.cost)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return string(hash), nil
+}
+
+func (p *Passwords) CompareHashAndPassword(hash, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+}
+
+// NeedsRehash is whether a hash was made with a different cost, or isn't a
+// hash at all
+func (p *Passwords) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != p.cost
+}
+
+// IsHashed is whether a stored password is a bcrypt hash rather than
+// plaintext
+func IsHashed(password string) bool {
+	_, err := bcrypt.Cost([]byte(password))
+	return err == nil
+}
+
+// rehashOnLogin updates the hash of a user who's just logged in when it was
+// made with a different cost, while their password is to hand. Failing to
+// doesn't stop the login.
+func rehashOnLogin(storer authboss.ServerStorer, passwords *Passwords) authboss.EventHandler {
+	return func(w http.ResponseWriter, r *http.Request, handled bool) (bool, error) {
+		user, ok := r.Context().Value(authboss.CTXKeyUser).(User)
+		if !ok || !passwords.NeedsRehash(user.Password) {
+			return false, nil
+		}
+		creds, ok := r.Context().Value(authboss.CTXKeyValues).(authboss.UserValuer)
+		if !ok {
+			return false, nil
+		}
+
+		hash, err := passwords.GenerateHash(creds.GetPassword())
+		if err == nil {
+			user.PutPassword(hash)
+			err = storer.Save(r.Context(), user)
+		}
+		if err != nil {
+			slog.Error("failed to rehash password", "user", user.ID, "error", err)
+		}
+		return false, nil
+	}
+}
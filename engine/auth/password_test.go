@@ -0,0 +1,42 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"fixit/engine/auth"
+)
+
+func TestPasswords(t *testing.T) {
+	passwords, err := auth.NewPasswords(bcrypt.MinCost)
+	require.NoError(t, err)
+
+	// Test: Hashes check against the password they were made from
+	hash, err := passwords.GenerateHash("password123")
+	require.NoError(t, err)
+	assert.True(t, auth.IsHashed(hash))
+	assert.NoError(t, passwords.CompareHashAndPassword(hash, "password123"))
+	assert.Error(t, passwords.CompareHashAndPassword(hash, "password124"))
+
+	// Test: Plaintext isn't mistaken for a hash
+	assert.False(t, auth.IsHashed("password123"))
+	assert.Error(t, passwords.CompareHashAndPassword("password123", "password123"))
+
+	// Test: Hashes need redoing when the cost changes or they're plaintext
+	assert.False(t, passwords.NeedsRehash(hash))
+	assert.True(t, passwords.NeedsRehash("password123"))
+	stronger, err := auth.NewPasswords(bcrypt.MinCost + 1)
+	require.NoError(t, err)
+	assert.True(t, stronger.NeedsRehash(hash))
+}
+
+func TestNewPasswords(t *testing.T) {
+	_, err := auth.NewPasswords(0)
+	assert.NoError(t, err)
+
+	_, err = auth.NewPasswords(bcrypt.MaxCost + 1)
+	assert.ErrorIs(t, err, auth.ErrInvalidCost)
+}
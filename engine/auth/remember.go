@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/aarondl/authboss/v3/remember"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	"fixit/engine/ent/remembertoken"
+	user2 "fixit/engine/ent/user"
+)
+
+// defaultRememberFor is how long remember me tokens last when the config
+// doesn't say
+const defaultRememberFor = 30 * 24 * time.Hour
+
+var _ authboss.RememberingServerStorer = (*Storer)(nil)
+
+func (s *Storer) rememberDuration() time.Duration {
+	if s.rememberFor <= 0 {
+		return defaultRememberFor
+	}
+	return s.rememberFor
+}
+
+// AddRememberToken stores the hash of a new remember me token, clearing out
+// the user's expired ones while it's there
+func (s *Storer) AddRememberToken(ctx context.Context, pid, token string) error {
+	userID, err := s.client.User.Query().Where(user2.EmailEQ(pid)).OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		return authboss.ErrUserNotFound
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = s.client.RememberToken.Delete().Where(
+		remembertoken.UserIDEQ(userID),
+		remembertoken.CreatedAtLT(time.Now().Add(-s.rememberDuration())),
+	).Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = s.client.RememberToken.Create().
+		SetUserID(userID).
+		SetToken(token).
+		Exec(ctx)
+	return errors.WithStack(err)
+}
+
+func (s *Storer) DelRememberTokens(ctx context.Context, pid string) error {
+	_, err := s.client.RememberToken.Delete().Where(
+		remembertoken.HasUserWith(user2.EmailEQ(pid)),
+	).Exec(ctx)
+	return errors.WithStack(err)
+}
+
+// UseRememberToken deletes a token so it can't be used again. Expired tokens,
+// and those of banned or locked out users, are treated as unknown.
+func (s *Storer) UseRememberToken(ctx context.Context, pid, token string) error {
+	now := time.Now()
+	n, err := s.client.RememberToken.Delete().Where(
+		remembertoken.TokenEQ(token),
+		remembertoken.CreatedAtGT(now.Add(-s.rememberDuration())),
+		remembertoken.HasUserWith(
+			user2.EmailEQ(pid),
+			user2.BannedAtIsNil(),
+			user2.Or(user2.LockedUntilIsNil(), user2.LockedUntilLT(now)),
+		),
+	).Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if n == 0 {
+		return authboss.ErrTokenNotFound
+	}
+	return nil
+}
+
+// rememberTokenHash is the hash stored for the token in a remember me
+// cookie, as the remember module makes it
+func rememberTokenHash(cookie string) (string, bool) {
+	raw, err := base64.URLEncoding.DecodeString(cookie)
+	if err != nil {
+		return "", false
+	}
+	sum := sha512.Sum512(raw)
+	return base64.StdEncoding.EncodeToString(sum[:]), true
+}
+
+// rememberMiddleware logs in users who aren't logged in but have a remember
+// me cookie, stamping the new session like a login does
+func rememberMiddleware(ab *authboss.Authboss) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if pid, _ := ab.CurrentUserID(r); pid == "" {
+				if err := rememberUser(ab, w, &r); err != nil {
+					slog.Error("failed to log in with remember me cookie", "error", err)
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func rememberUser(ab *authboss.Authboss, w http.ResponseWriter, r **http.Request) error {
+	if err := remember.Authenticate(ab, w, r); err != nil {
+		return err
+	}
+	if pid, _ := ab.CurrentUserID(*r); pid == "" {
+		return nil
+	}
+	user, err := ab.LoadCurrentUser(r)
+	if err != nil {
+		return err
+	}
+	putSessionVersion(w, user.(User).SessionVersion)
+	return nil
+}
+
+// forgetRememberToken deletes the token in the remember me cookie of a user
+// logging out, so a copy of the cookie can't log them back in
+func forgetRememberToken(storer *Storer) authboss.EventHandler {
+	return func(w http.ResponseWriter, r *http.Request, handled bool) (bool, error) {
+		cookie, ok := authboss.GetCookie(r, authboss.CookieRemember)
+		if !ok {
+			return false, nil
+		}
+		hash, ok := rememberTokenHash(cookie)
+		if !ok {
+			return false, nil
+		}
+		_, err := storer.client.RememberToken.Delete().
+			Where(remembertoken.TokenEQ(hash)).
+			Exec(r.Context())
+		return false, errors.WithStack(err)
+	}
+}
@@ -2,34 +2,74 @@ package auth
 
 import (
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/aarondl/authboss/v3"
 	"github.com/gorilla/sessions"
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
 )
 
-type SessionStorer struct {
-	store *sessions.CookieStore
+const (
+	SessionStoreCookie   = "cookie"
+	SessionStorePostgres = "postgres"
+
+	sessionCookieName = "authboss-session"
+)
+
+var ErrUnknownSessionStore = errors.New("unknown session store")
+
+// OpenSessionStore returns the session store chosen by the config. Cookie
+// sessions keep everything in the browser; Postgres sessions can be listed
+// and ended one by one.
+func OpenSessionStore(cfg Config, client *ent.Client) (sessions.Store, error) {
+	switch cfg.SessionStore {
+	case "", SessionStoreCookie:
+		store := sessions.NewCookieStore([]byte(cfg.SessionKey))
+		store.Options = sessionOptions(cfg.SessionMaxAge, cfg.Cookies.Secure)
+		store.MaxAge(store.Options.MaxAge)
+		return store, nil
+	case SessionStorePostgres:
+		return NewPostgresStore(client, []byte(cfg.SessionKey), cfg.SessionMaxAge, cfg.Cookies.Secure), nil
+	default:
+		return nil, errors.Wrapf(ErrUnknownSessionStore, "%q", cfg.SessionStore)
+	}
 }
 
-func NewSessionStorer(key []byte) *SessionStorer {
-	store := sessions.NewCookieStore(key)
-	// Configure session options
-	store.Options = &sessions.Options{
+func sessionOptions(maxAge time.Duration, secure bool) *sessions.Options {
+	if maxAge <= 0 {
+		maxAge = 24 * time.Hour
+	}
+	return &sessions.Options{
 		Path:     "/",
-		MaxAge:   86400, // 24 hours
+		MaxAge:   int(maxAge.Seconds()),
 		HttpOnly: true,
-		Secure:   false, // Set to true in production with HTTPS
+		Secure:   secure,
 		SameSite: http.SameSiteLaxMode,
 	}
+}
+
+type SessionStorer struct {
+	store sessions.Store
+	name  string
+}
+
+// NewSessionStorer keeps authboss' session in the store, in a cookie named
+// like the site's others
+func NewSessionStorer(store sessions.Store, cookies CookieSettings) *SessionStorer {
 	return &SessionStorer{
 		store: store,
+		name:  cookies.name(sessionCookieName),
 	}
 }
 
 var _ authboss.ClientStateReadWriter = (*SessionStorer)(nil)
 
 func (s *SessionStorer) ReadState(r *http.Request) (authboss.ClientState, error) {
-	session, err := s.store.Get(r, "authboss-session")
+	session, err := s.store.Get(r, s.name)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +92,14 @@ func (s *SessionStorer) WriteState(w http.ResponseWriter, state authboss.ClientS
 			sessionState.Put(event.Key, event.Value)
 		case authboss.ClientStateEventDel:
 			sessionState.Del(event.Key)
+		case authboss.ClientStateEventDelAll:
+			// Key lists what to keep, separated by commas
+			keep := strings.Split(event.Key, ",")
+			for _, key := range sessionState.getAllKeys() {
+				if !slices.Contains(keep, key) {
+					sessionState.Del(key)
+				}
+			}
 		}
 	}
 	
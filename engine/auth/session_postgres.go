@@ -0,0 +1,212 @@
+package auth
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/gofrs/uuid/v5"
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	entSession "fixit/engine/ent/session"
+	user2 "fixit/engine/ent/user"
+)
+
+// lastSeenInterval is how stale a session's last_seen_at can get before a
+// request updates it, so every request doesn't write
+const lastSeenInterval = 5 * time.Minute
+
+// PostgresStore keeps sessions in the session table, with only their signed
+// ID in the cookie. Deleting a row ends the session.
+type PostgresStore struct {
+	client  *ent.Client
+	codec   *securecookie.SecureCookie
+	options *sessions.Options
+}
+
+var _ sessions.Store = (*PostgresStore)(nil)
+
+func NewPostgresStore(client *ent.Client, key []byte, maxAge time.Duration, secure bool) *PostgresStore {
+	options := sessionOptions(maxAge, secure)
+	codec := securecookie.New(key, nil)
+	codec.MaxAge(options.MaxAge)
+	return &PostgresStore{
+		client:  client,
+		codec:   codec,
+		options: options,
+	}
+}
+
+// Get returns the session cached for the request, loading it the first time
+func (p *PostgresStore) Get(r *http.Request, name string) (*sessions.Session, error) {
+	return sessions.GetRegistry(r).Get(p, name)
+}
+
+// New loads the session named by the cookie, or starts a new one when there
+// isn't a cookie or its session has ended
+func (p *PostgresStore) New(r *http.Request, name string) (*sessions.Session, error) {
+	session := sessions.NewSession(p, name)
+	options := *p.options
+	session.Options = &options
+	session.IsNew = true
+
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return session, nil
+	}
+	var id uuid.UUID
+	if err := p.codec.Decode(name, cookie.Value, &id); err != nil {
+		return session, nil
+	}
+
+	ctx := r.Context()
+	now := time.Now()
+	row, err := p.client.Session.Query().
+		Where(entSession.ID(id), entSession.ExpiresAtGT(now)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return session, nil
+	}
+	if err != nil {
+		return session, errors.WithStack(err)
+	}
+
+	session.ID = row.ID.String()
+	session.IsNew = false
+	for k, v := range row.Values {
+		session.Values[k] = v
+	}
+
+	if now.Sub(row.LastSeenAt) > lastSeenInterval {
+		err := p.client.Session.UpdateOneID(row.ID).SetLastSeenAt(now).Exec(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return session, errors.WithStack(err)
+		}
+	}
+	return session, nil
+}
+
+// Save writes the session's values and refreshes its expiry. Sessions that
+// were ended while the request ran stay ended.
+func (p *PostgresStore) Save(r *http.Request, w http.ResponseWriter, session *sessions.Session) error {
+	ctx := r.Context()
+	id := uuid.FromStringOrNil(session.ID)
+
+	if session.Options.MaxAge < 0 {
+		if id != uuid.Nil {
+			if _, err := p.client.Session.Delete().Where(entSession.ID(id)).Exec(ctx); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+		http.SetCookie(w, sessions.NewCookie(session.Name(), "", session.Options))
+		return nil
+	}
+
+	values := map[string]string{}
+	for k, v := range session.Values {
+		key, ok := k.(string)
+		str, ok2 := v.(string)
+		if ok && ok2 {
+			values[key] = str
+		}
+	}
+
+	var userID *uuid.UUID
+	if pid := values[authboss.SessionKey]; pid != "" {
+		found, err := p.client.User.Query().Where(user2.EmailEQ(pid)).OnlyID(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return errors.WithStack(err)
+		}
+		if err == nil {
+			userID = &found
+		}
+	}
+
+	if !session.IsNew && id != uuid.Nil && userID != nil {
+		rotated, err := p.rotateOnLogin(r, id, *userID)
+		if err != nil {
+			return err
+		}
+		if rotated {
+			session.IsNew = true
+		}
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(time.Duration(session.Options.MaxAge) * time.Second)
+	if session.IsNew || id == uuid.Nil {
+		// Starting a session is a good time to clear out ended ones
+		if _, err := p.client.Session.Delete().Where(entSession.ExpiresAtLT(now)).Exec(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+
+		created, err := p.client.Session.Create().
+			SetValues(values).
+			SetNillableUserID(userID).
+			SetUserAgent(r.UserAgent()).
+			SetIP(clientIP(r)).
+			SetExpiresAt(expiresAt).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		session.ID = created.ID.String()
+		session.IsNew = false
+	} else {
+		update := p.client.Session.Update().
+			Where(entSession.ID(id)).
+			SetValues(values).
+			SetLastSeenAt(now).
+			SetExpiresAt(expiresAt)
+		if userID != nil {
+			update.SetUserID(*userID)
+		} else {
+			update.ClearUserID()
+		}
+		if _, err := update.Save(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	encoded, err := p.codec.Encode(session.Name(), uuid.FromStringOrNil(session.ID))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	http.SetCookie(w, sessions.NewCookie(session.Name(), encoded, session.Options))
+	return nil
+}
+
+// rotateOnLogin deletes the session when it's being saved for a user it
+// didn't belong to, so the caller starts a new one. An ID someone planted
+// before the login is then worth nothing to them.
+func (p *PostgresStore) rotateOnLogin(r *http.Request, id, userID uuid.UUID) (bool, error) {
+	ctx := r.Context()
+	row, err := p.client.Session.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	if row.UserID != nil && *row.UserID == userID {
+		return false, nil
+	}
+	if err := p.client.Session.DeleteOneID(id).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		return false, errors.WithStack(err)
+	}
+	return true, nil
+}
+
+// clientIP is the address the request came from, without the port. Behind
+// a proxy the server has already put the client's address in RemoteAddr.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,56 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/auth"
+)
+
+func TestOpenSessionStore(t *testing.T) {
+	_, err := auth.OpenSessionStore(auth.Config{SessionStore: "memcached"}, nil)
+	assert.ErrorIs(t, err, auth.ErrUnknownSessionStore)
+}
+
+func TestSessionStorer(t *testing.T) {
+	store, err := auth.OpenSessionStore(auth.Config{SessionKey: "test-32-byte-secret-key-here!!!"}, nil)
+	require.NoError(t, err)
+	storer := auth.NewSessionStorer(store, auth.CookieSettings{})
+
+	// write applies events to a fresh request carrying the cookies so far
+	var cookies []*http.Cookie
+	write := func(events ...authboss.ClientStateEvent) authboss.ClientState {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+		state, err := storer.ReadState(req)
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		require.NoError(t, storer.WriteState(rec, state, events))
+		cookies = rec.Result().Cookies()
+		return state
+	}
+
+	write(
+		authboss.ClientStateEvent{Kind: authboss.ClientStateEventPut, Key: authboss.SessionKey, Value: "user@example.com"},
+		authboss.ClientStateEvent{Kind: authboss.ClientStateEventPut, Key: "keep", Value: "this"},
+	)
+	require.Len(t, cookies, 1)
+	assert.True(t, cookies[0].HttpOnly)
+	assert.Equal(t, 24*60*60, cookies[0].MaxAge)
+
+	// Test: Deleting everything keeps the whitelisted keys
+	write(authboss.ClientStateEvent{Kind: authboss.ClientStateEventDelAll, Key: "keep,other"})
+	state := write()
+	_, ok := state.Get(authboss.SessionKey)
+	assert.False(t, ok)
+	value, ok := state.Get("keep")
+	assert.True(t, ok)
+	assert.Equal(t, "this", value)
+}
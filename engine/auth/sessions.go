@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/gofrs/uuid/v5"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	"fixit/engine/ent/remembertoken"
+	entSession "fixit/engine/ent/session"
+)
+
+// sessionVersionKey holds the user's session version at login. Bumping the
+// version ends every session stamped with an older one, wherever it's kept.
+const sessionVersionKey = "session_version"
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// Sessions lists and ends users' sessions
+type Sessions struct {
+	client *ent.Client
+}
+
+func NewSessions(client *ent.Client) *Sessions {
+	return &Sessions{client: client}
+}
+
+// List returns a user's sessions that haven't expired, most recently used
+// first. Only the Postgres session store keeps them.
+func (s *Sessions) List(ctx context.Context, userID uuid.UUID) ([]*ent.Session, error) {
+	sessions, err := s.client.Session.Query().
+		Where(entSession.UserIDEQ(userID), entSession.ExpiresAtGT(time.Now())).
+		Order(ent.Desc(entSession.FieldLastSeenAt)).
+		All(ctx)
+	return sessions, errors.WithStack(err)
+}
+
+// End ends one of a user's sessions
+func (s *Sessions) End(ctx context.Context, userID, sessionID uuid.UUID) error {
+	n, err := s.client.Session.Delete().
+		Where(entSession.ID(sessionID), entSession.UserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if n == 0 {
+		return errors.WithStack(ErrSessionNotFound)
+	}
+	return nil
+}
+
+// EndAll ends every session a user has and forgets their remember me
+// tokens, as when their password changes
+func (s *Sessions) EndAll(ctx context.Context, userID uuid.UUID) error {
+	_, err := s.endSessions(ctx, userID, uuid.Nil)
+	return err
+}
+
+// EndOthers ends every session but the one making the request, which is
+// stamped with the new version so it carries on
+func (s *Sessions) EndOthers(w http.ResponseWriter, r *http.Request, userID uuid.UUID) error {
+	version, err := s.endSessions(r.Context(), userID, CurrentSessionID(r))
+	if err != nil {
+		return err
+	}
+	putSessionVersion(w, version)
+	return nil
+}
+
+// endSessions bumps the user's session version and deletes their remember
+// me tokens and stored sessions, other than keep. It returns the new version.
+func (s *Sessions) endSessions(ctx context.Context, userID, keep uuid.UUID) (int, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	version, err := revokeSessions(ctx, tx, userID, keep)
+	if err != nil {
+		return 0, err
+	}
+	return version, errors.WithStack(tx.Commit())
+}
+
+// revokeSessions does the work of endSessions in a transaction the caller
+// commits
+func revokeSessions(ctx context.Context, tx *ent.Tx, userID, keep uuid.UUID) (int, error) {
+	u, err := tx.User.UpdateOneID(userID).AddSessionVersion(1).Save(ctx)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	_, err = tx.RememberToken.Delete().Where(remembertoken.UserIDEQ(userID)).Exec(ctx)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	_, err = tx.Session.Delete().
+		Where(entSession.UserIDEQ(userID), entSession.IDNEQ(keep)).
+		Exec(ctx)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return u.SessionVersion, nil
+}
+
+// CurrentSessionID is the ID of the request's session, or uuid.Nil when
+// sessions are kept in cookies
+func CurrentSessionID(r *http.Request) uuid.UUID {
+	state, ok := r.Context().Value(authboss.CTXKeySessionState).(*SessionState)
+	if !ok {
+		return uuid.Nil
+	}
+	return uuid.FromStringOrNil(state.session.ID)
+}
+
+func putSessionVersion(w http.ResponseWriter, version int) {
+	authboss.PutSession(w, sessionVersionKey, strconv.Itoa(version))
+}
+
+// stampSession records the user's session version in the session they've
+// just logged in to
+func stampSession(w http.ResponseWriter, r *http.Request, handled bool) (bool, error) {
+	user, ok := r.Context().Value(authboss.CTXKeyUser).(User)
+	if ok {
+		putSessionVersion(w, user.SessionVersion)
+	}
+	return false, nil
+}
+
+// endSessionsOnRecover logs a user out everywhere once they've reset their
+// password, in case someone else had it
+func endSessionsOnRecover(sessions *Sessions) authboss.EventHandler {
+	return func(w http.ResponseWriter, r *http.Request, handled bool) (bool, error) {
+		user, ok := r.Context().Value(authboss.CTXKeyUser).(User)
+		if !ok {
+			return false, nil
+		}
+		return false, sessions.EndAll(r.Context(), user.ID)
+	}
+}
+
+// sessionVersionMiddleware logs out sessions stamped with an old session
+// version, or none at all, before anything sees the user as logged in
+func sessionVersionMiddleware(ab *authboss.Authboss) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			pid, ok := authboss.GetSession(r, authboss.SessionKey)
+			if !ok || pid == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			user, err := ab.Config.Storage.Server.Load(r.Context(), pid)
+			if err != nil {
+				if !errors.Is(err, authboss.ErrUserNotFound) {
+					slog.Error("failed to load user to check their session", "error", err)
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			version, _ := authboss.GetSession(r, sessionVersionKey)
+			if version != strconv.Itoa(user.(User).SessionVersion) {
+				endSession(w, r)
+			} else {
+				// Saves loading the user again for the handler
+				r = r.WithContext(context.WithValue(r.Context(), authboss.CTXKeyUser, user))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// endSession logs the request's session out, both for the rest of the
+// request and in what's saved afterwards
+func endSession(w http.ResponseWriter, r *http.Request) {
+	if state, ok := r.Context().Value(authboss.CTXKeySessionState).(*SessionState); ok {
+		state.Del(authboss.SessionKey)
+		state.Del(authboss.SessionHalfAuthKey)
+		state.Del(sessionVersionKey)
+	}
+	authboss.DelKnownSession(w)
+	authboss.DelSession(w, sessionVersionKey)
+}
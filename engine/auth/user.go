@@ -2,8 +2,7 @@ package auth
 
 import (
 	"context"
-	"log/slog"
-	"strings"
+	"time"
 
 	"github.com/aarondl/authboss/v3"
 	"github.com/gofrs/uuid/v5"
@@ -50,17 +49,90 @@ func (u User) PutUsername(username string) {
 }
 
 func (u User) GetConfirmed() bool {
-	return true
+	return u.Confirmed
 }
 
 func (u User) PutConfirmed(confirmed bool) {
+	u.Confirmed = confirmed
 }
 
-func (u User) GetLocked() bool {
-	return false
+func (u User) GetConfirmSelector() string {
+	return u.ConfirmSelector
 }
 
-func (u User) PutLocked(locked bool) {
+func (u User) PutConfirmSelector(selector string) {
+	u.ConfirmSelector = selector
+}
+
+func (u User) GetConfirmVerifier() string {
+	return u.ConfirmVerifier
+}
+
+func (u User) PutConfirmVerifier(verifier string) {
+	u.ConfirmVerifier = verifier
+}
+
+func (u User) GetRecoverSelector() string {
+	return u.RecoverSelector
+}
+
+func (u User) PutRecoverSelector(selector string) {
+	u.RecoverSelector = selector
+}
+
+func (u User) GetRecoverVerifier() string {
+	return u.RecoverVerifier
+}
+
+func (u User) PutRecoverVerifier(verifier string) {
+	u.RecoverVerifier = verifier
+}
+
+// GetRecoverExpiry is the zero time, long expired, when no reset was asked for
+func (u User) GetRecoverExpiry() time.Time {
+	if u.RecoverExpiry == nil {
+		return time.Time{}
+	}
+	return *u.RecoverExpiry
+}
+
+func (u User) PutRecoverExpiry(expiry time.Time) {
+	u.RecoverExpiry = &expiry
+}
+
+var _ authboss.LockableUser = User{}
+
+func (u User) GetAttemptCount() int {
+	return u.AttemptCount
+}
+
+func (u User) PutAttemptCount(attempts int) {
+	u.AttemptCount = attempts
+}
+
+// GetLastAttempt is the zero time when the user has never tried to log in
+func (u User) GetLastAttempt() time.Time {
+	if u.LastAttemptAt == nil {
+		return time.Time{}
+	}
+	return *u.LastAttemptAt
+}
+
+func (u User) PutLastAttempt(last time.Time) {
+	u.LastAttemptAt = &last
+}
+
+// GetLocked is when the user can log in again, the zero time if they've
+// never been locked out
+func (u User) GetLocked() time.Time {
+	if u.LockedUntil == nil {
+		return time.Time{}
+	}
+	return *u.LockedUntil
+}
+
+func (u User) PutLocked(locked time.Time) {
+	u.LockedUntil = &locked
 }
 
 func (u User) GetArbitrary() map[string]string {
@@ -77,6 +149,8 @@ func (u User) PutArbitrary(arbitrary map[string]string) {
 
 type Storer struct {
 	client *ent.Client
+	// rememberFor is how long remember me tokens last
+	rememberFor time.Duration
 }
 
 func NewStorer(client *ent.Client) *Storer {
@@ -87,44 +161,71 @@ func (s *Storer) Load(ctx context.Context, key string) (authboss.User, error) {
 	user, err := s.client.User.Query().Where(
 		user2.EmailEQ(key),
 	).Only(ctx)
-	slog.Info("load result", "user", user, "err", err)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, authboss.ErrUserNotFound
 		}
 		return nil, err
 	}
+	// Banned users are treated as unknown, so they can't log in and any
+	// session they have stops working
+	if user.BannedAt != nil {
+		return nil, authboss.ErrUserNotFound
+	}
 	return User{user}, nil
 }
 
 func (s *Storer) Save(ctx context.Context, user authboss.User) error {
 	u := user.(User)
+	if !IsHashed(u.Password) {
+		return errors.WithStack(ErrPlaintextPassword)
+	}
 
 	if u.ID == uuid.Nil {
-		// Generate username from email if not provided
 		username := u.Username
 		if username == "" {
-			// Extract username part from email before @
-			emailParts := strings.Split(u.Email, "@")
-			if len(emailParts) > 0 {
-				username = emailParts[0]
-			} else {
-				username = "user" + u.Email[:5] // Fallback
+			var err error
+			username, err = uniqueUsername(ctx, s.client, u.Email)
+			if err != nil {
+				return err
 			}
 		}
 
-		_, err := s.client.User.Create().
+		created, err := s.client.User.Create().
 			SetUsername(username).
 			SetEmail(u.Email).
 			SetPassword(u.Password).
+			SetConfirmed(u.Confirmed).
+			SetConfirmSelector(u.ConfirmSelector).
+			SetConfirmVerifier(u.ConfirmVerifier).
+			SetRecoverSelector(u.RecoverSelector).
+			SetRecoverVerifier(u.RecoverVerifier).
+			SetNillableRecoverExpiry(u.RecoverExpiry).
+			SetAttemptCount(u.AttemptCount).
+			SetNillableLastAttemptAt(u.LastAttemptAt).
+			SetNillableLockedUntil(u.LockedUntil).
 			Save(ctx)
-		return errors.WithStack(err)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		// confirm saves the user again straight after, which must update it
+		*u.User = *created
+		return nil
 	}
 
 	_, err := s.client.User.UpdateOneID(u.ID).
 		SetUsername(u.Username).
 		SetEmail(u.Email).
 		SetPassword(u.Password).
+		SetConfirmed(u.Confirmed).
+		SetConfirmSelector(u.ConfirmSelector).
+		SetConfirmVerifier(u.ConfirmVerifier).
+		SetRecoverSelector(u.RecoverSelector).
+		SetRecoverVerifier(u.RecoverVerifier).
+		SetNillableRecoverExpiry(u.RecoverExpiry).
+		SetAttemptCount(u.AttemptCount).
+		SetNillableLastAttemptAt(u.LastAttemptAt).
+		SetNillableLockedUntil(u.LockedUntil).
 		Save(ctx)
 	return errors.WithStack(err)
 }
@@ -137,6 +238,39 @@ func (s *Storer) Create(ctx context.Context, user authboss.User) error {
 	return s.Save(ctx, user)
 }
 
-func (s *Storer) LoadByConfirmSelector(ctx context.Context, selector string) (authboss.User, error) {
-	return s.Load(ctx, selector)
+var _ authboss.ConfirmingServerStorer = (*Storer)(nil)
+
+func (s *Storer) LoadByConfirmSelector(ctx context.Context, selector string) (authboss.ConfirmableUser, error) {
+	if selector == "" {
+		return nil, authboss.ErrUserNotFound
+	}
+	user, err := s.client.User.Query().Where(
+		user2.ConfirmSelectorEQ(selector),
+	).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, authboss.ErrUserNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+	return User{user}, nil
+}
+
+var _ authboss.RecoveringServerStorer = (*Storer)(nil)
+
+func (s *Storer) LoadByRecoverSelector(ctx context.Context, selector string) (authboss.RecoverableUser, error) {
+	if selector == "" {
+		return nil, authboss.ErrUserNotFound
+	}
+	user, err := s.client.User.Query().Where(
+		user2.RecoverSelectorEQ(selector),
+		user2.BannedAtIsNil(),
+	).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, authboss.ErrUserNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+	return User{user}, nil
 }
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	user2 "fixit/engine/ent/user"
+)
+
+const (
+	minUsernameLength = 3
+	// maxDerivedUsername leaves room under the 64 character limit for a
+	// number to make it unique
+	maxDerivedUsername = 32
+)
+
+// UsernameFrom turns a name, nickname or email address into a username of
+// lowercase letters, digits, dashes and underscores. It's empty when too
+// little of the name is left to use.
+func UsernameFrom(name string) string {
+	if at := strings.LastIndex(name, "@"); at >= 0 {
+		name = name[:at]
+	}
+
+	var b strings.Builder
+	separator := false
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if separator && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			separator = false
+			b.WriteRune(r)
+		case r == '-' && !separator && b.Len() > 0:
+			b.WriteByte('-')
+		default:
+			separator = true
+		}
+		if b.Len() >= maxDerivedUsername {
+			break
+		}
+	}
+
+	username := strings.Trim(b.String(), "-")
+	if len(username) > maxDerivedUsername {
+		username = strings.Trim(username[:maxDerivedUsername], "-_")
+	}
+	if len(username) < minUsernameLength {
+		return ""
+	}
+	return username
+}
+
+// uniqueUsername derives a username from the first name that gives one,
+// numbering it when it's taken
+func uniqueUsername(ctx context.Context, client *ent.Client, names ...string) (string, error) {
+	base := "user"
+	for _, name := range names {
+		if username := UsernameFrom(name); username != "" {
+			base = username
+			break
+		}
+	}
+
+	taken, err := client.User.Query().
+		Where(user2.UsernameHasPrefix(base)).
+		Select(user2.FieldUsername).
+		Strings(ctx)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	takenSet := make(map[string]bool, len(taken))
+	for _, t := range taken {
+		takenSet[t] = true
+	}
+
+	username := base
+	for n := 2; takenSet[username]; n++ {
+		username = base + strconv.Itoa(n)
+	}
+	return username, nil
+}
@@ -0,0 +1,32 @@
+package auth_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fixit/engine/auth"
+)
+
+func TestUsernameFrom(t *testing.T) {
+	tests := map[string]string{
+		"alice@example.com":       "alice",
+		"Alice.Smith@example.com": "alice_smith",
+		"alice+fixit@example.com": "alice_fixit",
+		"Alice Smith":             "alice_smith",
+		"  jean-luc ":             "jean-luc",
+		"-dash-":                  "dash",
+		"José Núñez":              "jos_n_ez",
+		"al@example.com":          "",
+		"李小龙":                     "",
+		"":                        "",
+	}
+	for name, want := range tests {
+		assert.Equal(t, want, auth.UsernameFrom(name), name)
+	}
+
+	// Test: Long names are cut short
+	long := auth.UsernameFrom(strings.Repeat("a", 100) + "@example.com")
+	assert.Len(t, long, 32)
+}
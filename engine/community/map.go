@@ -0,0 +1,62 @@
+package community
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	"fixit/engine/ent/community"
+	"fixit/engine/ent/post"
+	"fixit/engine/geo"
+)
+
+// MaxMapIssues caps how many issues are put on a community's map
+const MaxMapIssues = 1000
+
+// IssueMap returns a community's issues that have a location and aren't
+// closed, newest first, as GeoJSON
+func (r *Repository) IssueMap(ctx context.Context, communitySlug string) (*geo.FeatureCollection, error) {
+	comm, err := r.client.Community.Query().
+		Where(community.NameEQ(communitySlug)).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	issues, err := r.client.Post.Query().
+		Where(
+			post.HasCommunityWith(community.ID(comm.ID)),
+			post.ReplyToIsNil(),
+			post.RoleEQ(post.RoleIssue),
+			post.StatusNEQ(post.StatusClosed),
+			post.DeletedAtIsNil(),
+			post.GeographyNotNil(),
+			post.GeographyNEQ(""),
+		).
+		Order(ent.Desc(post.FieldCreatedAt), ent.Desc(post.FieldID)).
+		Limit(MaxMapIssues).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	fc := geo.NewFeatureCollection()
+	for _, issue := range issues {
+		p, err := geo.ParseWKT(issue.Geography)
+		if err != nil {
+			slog.Warn("skipping issue with invalid geography", "post", issue.ID, "geography", issue.Geography)
+			continue
+		}
+		fc.Add(issue.ID.String(), p, map[string]any{
+			"title":      issue.Title,
+			"status":     issue.Status,
+			"address":    issue.Address,
+			"url":        "/p/" + issue.ID.String(),
+			"created_at": issue.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return fc, nil
+}
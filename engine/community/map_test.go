@@ -0,0 +1,47 @@
+package community_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/community"
+	"fixit/engine/ent"
+	entPost "fixit/engine/ent/post"
+	"fixit/engine/factory"
+)
+
+func TestRepository_IssueMap(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := community.NewRepository(client)
+
+	user := factory.User(t, client, "map-user-*")
+	comm := factory.Community(t, client, "map-community-*")
+
+	located := createIssue(t, client, user, comm, "Fly-tipping behind the shops")
+	located, err := located.Update().SetGeography("POINT(-1.7797 51.5558)").SetAddress("Regent Street").Save(ctx)
+	require.NoError(t, err)
+
+	closed := createIssue(t, client, user, comm, "Graffiti on the underpass")
+	_, err = closed.Update().SetGeography("POINT(-1.78 51.56)").SetStatus(entPost.StatusClosed).Save(ctx)
+	require.NoError(t, err)
+
+	createIssue(t, client, user, comm, "Issue with no location")
+
+	fc, err := repo.IssueMap(ctx, comm.Name)
+	require.NoError(t, err)
+	require.Len(t, fc.Features, 1)
+
+	feature := fc.Features[0]
+	assert.Equal(t, located.ID.String(), feature.ID)
+	assert.Equal(t, [2]float64{-1.7797, 51.5558}, feature.Geometry.Coordinates)
+	assert.Equal(t, "Fly-tipping behind the shops", feature.Properties["title"])
+	assert.Equal(t, "Regent Street", feature.Properties["address"])
+	assert.Equal(t, "/p/"+located.ID.String(), feature.Properties["url"])
+
+	_, err = repo.IssueMap(ctx, "no-such-community")
+	assert.True(t, ent.IsNotFound(err))
+}
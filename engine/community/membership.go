@@ -0,0 +1,263 @@
+package community
+
+import (
+	"context"
+	"slices"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gofrs/uuid/v5"
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	"fixit/engine/ent/community"
+	"fixit/engine/ent/membership"
+	"fixit/engine/ent/user"
+	"fixit/engine/internal/entx"
+)
+
+var (
+	ErrNotMember = errors.New("not a member of this community")
+	ErrForbidden = errors.New("your role in this community doesn't allow this")
+	ErrLastOwner = errors.New("a community needs at least one owner")
+)
+
+// Permission is something a role in a community allows
+type Permission string
+
+const (
+	// PermissionModerate is editing and deleting anyone's posts, accepting
+	// solutions and closing or reopening issues
+	PermissionModerate Permission = "moderate"
+	// PermissionManage is changing the community's settings and its
+	// members' roles
+	PermissionManage Permission = "manage"
+)
+
+// rolePermissions are what each role allows. Members can post, as anyone
+// logged in can, but nothing more.
+var rolePermissions = map[membership.Role][]Permission{
+	membership.RoleOwner:     {PermissionModerate, PermissionManage},
+	membership.RoleModerator: {PermissionModerate},
+}
+
+// Roles are the roles a member can have, most able first
+var Roles = []membership.Role{membership.RoleOwner, membership.RoleModerator, membership.RoleMember}
+
+// Allows is whether the role has the permission. The empty role, for
+// someone who isn't a member, has none.
+func Allows(role membership.Role, permission Permission) bool {
+	return slices.Contains(rolePermissions[role], permission)
+}
+
+// SettingsFields are the parts of a community its owners can change. The
+// name can't change as it's in the community's URLs.
+type SettingsFields struct {
+	Title          string
+	Location       string
+	BannerImageURL string
+}
+
+// Role is the user's role in the community, or empty when they aren't a
+// member
+func (r *Repository) Role(ctx context.Context, communityID uuid.UUID, u *ent.User) (membership.Role, error) {
+	if u == nil {
+		return "", nil
+	}
+	m, err := r.client.Membership.Query().
+		Where(
+			membership.HasCommunityWith(community.ID(communityID)),
+			membership.HasUserWith(user.ID(u.ID)),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return m.Role, nil
+}
+
+// Can is whether the user's role in the community gives them the permission
+func (r *Repository) Can(ctx context.Context, communityID uuid.UUID, u *ent.User, permission Permission) (bool, error) {
+	role, err := r.Role(ctx, communityID, u)
+	if err != nil {
+		return false, err
+	}
+	return Allows(role, permission), nil
+}
+
+// uniqueMembershipIndex keeps each user to one membership per community
+const uniqueMembershipIndex = "membership_membership_community_membership_user"
+
+// Join makes the user a member of the community. Joining again keeps the
+// role they have.
+func (r *Repository) Join(ctx context.Context, communityID uuid.UUID, u *ent.User) error {
+	_, err := r.client.Membership.Create().
+		SetCommunityID(communityID).
+		SetUserID(u.ID).
+		Save(ctx)
+	if entx.IsUniqueViolation(err, uniqueMembershipIndex) {
+		return nil
+	}
+	return errors.WithStack(err)
+}
+
+// Leave takes the user out of the community, unless they're its last owner
+func (r *Repository) Leave(ctx context.Context, communityID uuid.UUID, u *ent.User) error {
+	return entx.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := lockOwners(ctx, tx, communityID); err != nil {
+			return err
+		}
+		m, err := memberOf(ctx, tx, communityID, u.ID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Membership.DeleteOne(m).Exec(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		if m.Role == membership.RoleOwner {
+			return hasOwner(ctx, tx, communityID)
+		}
+		return nil
+	})
+}
+
+// Members lists the community's members with their users, owners first
+// and then in the order they joined
+func (r *Repository) Members(ctx context.Context, communityID uuid.UUID) ([]*ent.Membership, error) {
+	members, err := r.client.Membership.Query().
+		Where(membership.HasCommunityWith(community.ID(communityID))).
+		WithUser().
+		Order(ent.Asc(membership.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	slices.SortStableFunc(members, func(a, b *ent.Membership) int {
+		return slices.Index(Roles, a.Role) - slices.Index(Roles, b.Role)
+	})
+	return members, nil
+}
+
+// SetRole changes a member's role, which only the community's owners can do
+func (r *Repository) SetRole(ctx context.Context, communityID uuid.UUID, by *ent.User, memberID uuid.UUID, role membership.Role) error {
+	allowed, err := r.Can(ctx, communityID, by, PermissionManage)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errors.WithStack(ErrForbidden)
+	}
+	return r.changeRole(ctx, communityID, memberID, role)
+}
+
+// Assign gives the user a role in the community, making them a member if
+// they aren't one. It's for admins, so doesn't check who's asking.
+func (r *Repository) Assign(ctx context.Context, communityID uuid.UUID, u *ent.User, role membership.Role) error {
+	if err := r.Join(ctx, communityID, u); err != nil {
+		return err
+	}
+	return r.changeRole(ctx, communityID, u.ID, role)
+}
+
+// UpdateSettings changes the community's settings, which only its owners
+// can do
+func (r *Repository) UpdateSettings(ctx context.Context, communityID uuid.UUID, by *ent.User, fields SettingsFields) (*ent.Community, error) {
+	allowed, err := r.Can(ctx, communityID, by, PermissionManage)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.WithStack(ErrForbidden)
+	}
+
+	update := r.client.Community.UpdateOneID(communityID).
+		SetTitle(fields.Title)
+	if fields.Location != "" {
+		update.SetLocation(fields.Location)
+	} else {
+		update.ClearLocation()
+	}
+	if fields.BannerImageURL != "" {
+		update.SetBannerImageURL(fields.BannerImageURL)
+	} else {
+		update.ClearBannerImageURL()
+	}
+
+	comm, err := update.Save(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return comm, nil
+}
+
+// changeRole sets a member's role, keeping at least one owner
+func (r *Repository) changeRole(ctx context.Context, communityID, userID uuid.UUID, role membership.Role) error {
+	if err := membership.RoleValidator(role); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return entx.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := lockOwners(ctx, tx, communityID); err != nil {
+			return err
+		}
+		m, err := memberOf(ctx, tx, communityID, userID)
+		if err != nil {
+			return err
+		}
+		if err := m.Update().SetRole(role).Exec(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		if m.Role == membership.RoleOwner && role != membership.RoleOwner {
+			return hasOwner(ctx, tx, communityID)
+		}
+		return nil
+	})
+}
+
+// memberOf loads the user's membership of the community
+func memberOf(ctx context.Context, tx *ent.Tx, communityID, userID uuid.UUID) (*ent.Membership, error) {
+	m, err := tx.Membership.Query().
+		Where(
+			membership.HasCommunityWith(community.ID(communityID)),
+			membership.HasUserWith(user.ID(userID)),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithStack(ErrNotMember)
+	}
+	return m, errors.WithStack(err)
+}
+
+// lockOwners locks the community's owner memberships until the transaction
+// ends. Changes that could take away an owner take it first, so two owners
+// demoting or leaving at once can't each count on the other staying.
+func lockOwners(ctx context.Context, tx *ent.Tx, communityID uuid.UUID) error {
+	_, err := tx.Membership.Query().
+		Where(
+			membership.HasCommunityWith(community.ID(communityID)),
+			membership.RoleEQ(membership.RoleOwner),
+		).
+		Modify(func(s *sql.Selector) { s.ForUpdate() }).
+		IDs(ctx)
+	return errors.WithStack(err)
+}
+
+// hasOwner checks a change that took away an owner has left the community
+// with another
+func hasOwner(ctx context.Context, tx *ent.Tx, communityID uuid.UUID) error {
+	owned, err := tx.Membership.Query().
+		Where(
+			membership.HasCommunityWith(community.ID(communityID)),
+			membership.RoleEQ(membership.RoleOwner),
+		).
+		Exist(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !owned {
+		return errors.WithStack(ErrLastOwner)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package community_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/community"
+	"fixit/engine/ent"
+	entCommunity "fixit/engine/ent/community"
+	"fixit/engine/ent/membership"
+	"fixit/engine/factory"
+)
+
+func TestAllows(t *testing.T) {
+	assert.True(t, community.Allows(membership.RoleOwner, community.PermissionManage))
+	assert.True(t, community.Allows(membership.RoleOwner, community.PermissionModerate))
+	assert.False(t, community.Allows(membership.RoleModerator, community.PermissionManage))
+	assert.True(t, community.Allows(membership.RoleModerator, community.PermissionModerate))
+	assert.False(t, community.Allows(membership.RoleMember, community.PermissionModerate))
+	assert.False(t, community.Allows("", community.PermissionModerate))
+}
+
+func TestRepository_Membership(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := community.NewRepository(client)
+
+	owner := factory.User(t, client, "member-owner-*")
+	member := factory.User(t, client, "member-member-*")
+	stranger := factory.User(t, client, "member-stranger-*")
+
+	comm, err := repo.Create(ctx, community.CommunityCreateFields{
+		Name:  factory.Placeholder("members-*"),
+		Title: "Community with members",
+	}, owner)
+	require.NoError(t, err)
+
+	role := func(u *ent.User) membership.Role {
+		role, err := repo.Role(ctx, comm.ID, u)
+		require.NoError(t, err)
+		return role
+	}
+
+	// Test: The creator owns the community
+	assert.Equal(t, membership.RoleOwner, role(owner))
+	assert.Empty(t, role(stranger))
+	assert.Empty(t, role(nil))
+
+	// Test: Joining twice makes one member
+	require.NoError(t, repo.Join(ctx, comm.ID, member))
+	require.NoError(t, repo.Join(ctx, comm.ID, member))
+	assert.Equal(t, membership.RoleMember, role(member))
+	members, err := repo.Members(ctx, comm.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, owner.ID, members[0].Edges.User.ID)
+
+	// Test: Joining a community that doesn't exist fails
+	err = repo.Join(ctx, uuid.Must(uuid.NewV7()), member)
+	require.Error(t, err)
+	assert.True(t, ent.IsConstraintError(errors.Cause(err)))
+
+	// Test: Only owners change roles
+	err = repo.SetRole(ctx, comm.ID, member, member.ID, membership.RoleOwner)
+	assert.ErrorIs(t, err, community.ErrForbidden)
+	err = repo.SetRole(ctx, comm.ID, owner, stranger.ID, membership.RoleModerator)
+	assert.ErrorIs(t, err, community.ErrNotMember)
+
+	require.NoError(t, repo.SetRole(ctx, comm.ID, owner, member.ID, membership.RoleModerator))
+	can, err := repo.Can(ctx, comm.ID, member, community.PermissionModerate)
+	require.NoError(t, err)
+	assert.True(t, can)
+	can, err = repo.Can(ctx, comm.ID, member, community.PermissionManage)
+	require.NoError(t, err)
+	assert.False(t, can)
+
+	// Test: Only owners change settings
+	_, err = repo.UpdateSettings(ctx, comm.ID, member, community.SettingsFields{Title: "Taken over"})
+	assert.ErrorIs(t, err, community.ErrForbidden)
+	updated, err := repo.UpdateSettings(ctx, comm.ID, owner, community.SettingsFields{
+		Title:    "Renamed community",
+		Location: "Swindon, UK",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed community", updated.Title)
+	assert.Equal(t, "Swindon, UK", updated.Location)
+
+	// Test: The last owner can't leave or stand down
+	assert.ErrorIs(t, repo.Leave(ctx, comm.ID, owner), community.ErrLastOwner)
+	err = repo.SetRole(ctx, comm.ID, owner, owner.ID, membership.RoleMember)
+	assert.ErrorIs(t, err, community.ErrLastOwner)
+	assert.Equal(t, membership.RoleOwner, role(owner))
+
+	// Test: Once there's another owner they can
+	require.NoError(t, repo.SetRole(ctx, comm.ID, owner, member.ID, membership.RoleOwner))
+	require.NoError(t, repo.Leave(ctx, comm.ID, owner))
+	assert.Empty(t, role(owner))
+	assert.ErrorIs(t, repo.Leave(ctx, comm.ID, owner), community.ErrNotMember)
+
+	// Test: Admins can assign roles to anyone
+	require.NoError(t, repo.Assign(ctx, comm.ID, stranger, membership.RoleModerator))
+	assert.Equal(t, membership.RoleModerator, role(stranger))
+
+	// Test: Deleting the community deletes its memberships
+	require.NoError(t, repo.Delete(ctx, comm.Name))
+	_, err = repo.GetBySlug(ctx, comm.Name)
+	assert.True(t, ent.IsNotFound(errors.Cause(err)))
+	count, err := client.Membership.Query().
+		Where(membership.HasCommunityWith(entCommunity.ID(comm.ID))).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
@@ -0,0 +1,94 @@
+package community
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	"fixit/engine/ent/community"
+	"fixit/engine/geo"
+)
+
+const (
+	DefaultRadiusKm = 25.0
+	MaxRadiusKm     = 500.0
+	// AreaRadiusKm is how far from its point a community's area reaches
+	AreaRadiusKm = 25.0
+)
+
+var ErrInvalidRadius = errors.New("radius must be a positive number of kilometres, at most 500")
+
+// FrontpageCommunity is a community as listed on the frontpage. DistanceKm
+// is only set when searching near a point.
+type FrontpageCommunity struct {
+	*ent.Community
+	DistanceKm float64
+}
+
+// Point returns where a community is, if it has a location set
+func Point(comm *ent.Community) (geo.Point, bool) {
+	if comm.Geography == "" {
+		return geo.Point{}, false
+	}
+	p, err := geo.ParseWKT(comm.Geography)
+	if err != nil {
+		return geo.Point{}, false
+	}
+	return p, true
+}
+
+// Area returns the area a community covers, if it has a location set
+func Area(comm *ent.Community) (geo.Area, bool) {
+	p, ok := Point(comm)
+	if !ok {
+		return geo.Area{}, false
+	}
+	return geo.Area{Center: p, RadiusKm: AreaRadiusKm}, true
+}
+
+// near returns the communities within radiusKm of a point, nearest first.
+// Geography is stored as WKT text rather than a PostGIS type, so distances
+// are worked out here rather than in SQL.
+func (r *Repository) near(ctx context.Context, query *ent.CommunityQuery, at geo.Point, radiusKm float64) ([]*FrontpageCommunity, error) {
+	if radiusKm == 0 {
+		radiusKm = DefaultRadiusKm
+	}
+	if !(radiusKm > 0 && radiusKm <= MaxRadiusKm) {
+		return nil, errors.WithStack(ErrInvalidRadius)
+	}
+
+	communities, err := query.
+		Where(community.GeographyNotNil(), community.GeographyNEQ("")).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var items []*FrontpageCommunity
+	for _, comm := range communities {
+		p, ok := Point(comm)
+		if !ok {
+			slog.Warn("skipping community with invalid geography", "community", comm.Name, "geography", comm.Geography)
+			continue
+		}
+		distance := at.DistanceKm(p)
+		if distance > radiusKm {
+			continue
+		}
+		items = append(items, &FrontpageCommunity{Community: comm, DistanceKm: distance})
+	}
+
+	slices.SortStableFunc(items, func(a, b *FrontpageCommunity) int {
+		switch {
+		case a.DistanceKm < b.DistanceKm:
+			return -1
+		case a.DistanceKm > b.DistanceKm:
+			return 1
+		}
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return items, nil
+}
@@ -0,0 +1,119 @@
+package community_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/community"
+	"fixit/engine/ent"
+	"fixit/engine/factory"
+	"fixit/engine/geo"
+)
+
+func TestRepository_ForFrontpageNear(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := community.NewRepository(client)
+
+	// Far from anywhere other tests put communities
+	origin := geo.Point{Lat: -48.8767, Lng: -123.3933}
+
+	nearest := createCommunityAt(t, repo, geo.Point{Lat: -48.9, Lng: -123.4})
+	further := createCommunityAt(t, repo, geo.Point{Lat: -49.0, Lng: -123.6})
+	distant := createCommunityAt(t, repo, geo.Point{Lat: -50.5, Lng: -123.4})
+	nowhere := factory.Community(t, client, "near-nowhere-*")
+
+	t.Run("Lists communities in the radius nearest first", func(t *testing.T) {
+		items, err := repo.ForFrontpage(ctx, community.Filter{Near: &origin, RadiusKm: 50})
+		require.NoError(t, err)
+
+		got := onlyIDs(items, nearest.ID, further.ID, distant.ID, nowhere.ID)
+		assert.Equal(t, []uuid.UUID{nearest.ID, further.ID}, got)
+
+		for _, item := range items {
+			assert.LessOrEqual(t, item.DistanceKm, 50.0)
+		}
+		assert.InDelta(t, 2.9, items[indexOf(items, nearest.ID)].DistanceKm, 0.5)
+	})
+
+	t.Run("Wider radius includes more communities", func(t *testing.T) {
+		items, err := repo.ForFrontpage(ctx, community.Filter{Near: &origin, RadiusKm: 500})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{nearest.ID, further.ID, distant.ID}, onlyIDs(items, nearest.ID, further.ID, distant.ID, nowhere.ID))
+	})
+
+	t.Run("Out of range radius is rejected", func(t *testing.T) {
+		_, err := repo.ForFrontpage(ctx, community.Filter{Near: &origin, RadiusKm: 10000})
+		assert.ErrorIs(t, err, community.ErrInvalidRadius)
+	})
+
+	t.Run("Without a point every community is listed", func(t *testing.T) {
+		items, err := repo.ForFrontpage(ctx, community.Filter{})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{nearest.ID, further.ID, distant.ID, nowhere.ID}, onlyIDs(items, nearest.ID, further.ID, distant.ID, nowhere.ID))
+	})
+}
+
+func TestRepository_CreateGeography(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := community.NewRepository(client)
+
+	comm := createCommunityAt(t, repo, geo.Point{Lat: 51.5558, Lng: -1.7797})
+	assert.Equal(t, "POINT(-1.7797 51.5558)", comm.Geography)
+
+	p, ok := community.Point(comm)
+	require.True(t, ok)
+	assert.Equal(t, geo.Point{Lat: 51.5558, Lng: -1.7797}, p)
+
+	_, err := repo.Create(ctx, community.CommunityCreateFields{
+		Name:  factory.Placeholder("bad-point-*"),
+		Title: "Bad point",
+		Point: &geo.Point{Lat: 123, Lng: 0},
+	}, nil)
+	assert.ErrorIs(t, err, geo.ErrInvalidCoordinates)
+
+	// Malformed WKT can't be stored even bypassing the repository
+	_, err = client.Community.Create().
+		SetName(factory.Placeholder("bad-wkt-*")).
+		SetTitle("Bad WKT").
+		SetGeography("POINT(51.5 hello)").
+		Save(ctx)
+	assert.ErrorIs(t, err, geo.ErrInvalidWKT)
+}
+
+func createCommunityAt(t *testing.T, repo *community.Repository, p geo.Point) *ent.Community {
+	comm, err := repo.Create(context.Background(), community.CommunityCreateFields{
+		Name:  factory.Placeholder("near-*"),
+		Title: "Community near here",
+		Point: &p,
+	}, nil)
+	require.NoError(t, err)
+	return comm
+}
+
+// onlyIDs returns the IDs of items among want, in the order listed
+func onlyIDs(items []*community.FrontpageCommunity, want ...uuid.UUID) []uuid.UUID {
+	var out []uuid.UUID
+	for _, item := range items {
+		for _, id := range want {
+			if item.ID == id {
+				out = append(out, id)
+			}
+		}
+	}
+	return out
+}
+
+func indexOf(items []*community.FrontpageCommunity, id uuid.UUID) int {
+	for i, item := range items {
+		if item.ID == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,201 @@
+package community
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gofrs/uuid/v5"
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/predicate"
+	"fixit/engine/ent/vote"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var (
+	ErrInvalidSort   = errors.New("unknown sort")
+	ErrInvalidCursor = errors.New("invalid page cursor")
+)
+
+// Sort is the order community posts are listed in
+type Sort string
+
+const (
+	SortNewest   Sort = "newest"
+	SortVotes    Sort = "votes"
+	SortActivity Sort = "activity"
+	SortUnsolved Sort = "unsolved"
+)
+
+// Sorts lists every sort in the order they're offered to users
+var Sorts = []Sort{SortNewest, SortVotes, SortActivity, SortUnsolved}
+
+// ParseSort reads a sort from user input, defaulting to newest when empty
+func ParseSort(s string) (Sort, error) {
+	if s == "" {
+		return SortNewest, nil
+	}
+	for _, sort := range Sorts {
+		if string(sort) == s {
+			return sort, nil
+		}
+	}
+	return "", errors.Wrapf(ErrInvalidSort, "%q", s)
+}
+
+// PostPage is one page of a community's posts, with cursors for the pages
+// either side. A cursor is empty when there is no page in that direction.
+type PostPage struct {
+	Items      []PostListItem
+	NextCursor string
+	PrevCursor string
+}
+
+// cursor is the keyset position of a post within a sort. Every sort orders
+// by (rank, at, id) descending: rank is the sort's primary key (votes, or
+// whether the post is unsolved) and at is the time it's ordered by. The
+// UUIDv7 id breaks ties, so positions are unique and stable.
+type cursor struct {
+	Sort Sort      `json:"s"`
+	Rank int64     `json:"r"`
+	At   time.Time `json:"t"`
+	ID   uuid.UUID `json:"id"`
+}
+
+func (c cursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string, sort Sort) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	// Positions are meaningless across sorts
+	if c.Sort != sort {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+const (
+	sortRankColumn = "sort_rank"
+	sortAtColumn   = "sort_at"
+)
+
+// sortRank is the primary ordering expression of a sort. Sorts ordered by
+// time alone use a constant, cast so Postgres doesn't read it as a column
+// position in ORDER BY.
+func sortRank(s *sql.Selector, sort Sort) string {
+	switch sort {
+	case SortVotes:
+		return fmt.Sprintf(
+			"COALESCE((SELECT SUM(v.%s) FROM %s AS v WHERE v.%s = %s), 0)::bigint",
+			vote.FieldValue, vote.Table, vote.PostColumn, s.C(post.FieldID),
+		)
+	case SortUnsolved:
+		// The opposite of Solved
+		return fmt.Sprintf("CASE WHEN %s IS NULL AND %s <> '%s' THEN 1 ELSE 0 END",
+			s.C(post.FieldAcceptedSolutionID), s.C(post.FieldStatus), post.StatusClosed)
+	default:
+		return "0::bigint"
+	}
+}
+
+// sortAt is the time a sort orders by. Activity is the latest post in the
+// thread: the issue itself, its replies, or verifications of its solutions.
+func sortAt(s *sql.Selector, sort Sort) string {
+	if sort != SortActivity {
+		return s.C(post.FieldCreatedAt)
+	}
+	return fmt.Sprintf(
+		"GREATEST(%[1]s, (SELECT MAX(r.%[2]s) FROM %[3]s AS r WHERE r.%[4]s = %[5]s OR r.%[4]s IN (SELECT c.%[6]s FROM %[3]s AS c WHERE c.%[4]s = %[5]s)))",
+		s.C(post.FieldCreatedAt), post.FieldCreatedAt, post.Table, post.FieldReplyTo, s.C(post.FieldID), post.FieldID,
+	)
+}
+
+// selectSortKey adds the sort's rank and time to the selected columns, so
+// cursors can be built from the returned posts
+func selectSortKey(sort Sort) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.AppendSelectExprAs(sql.Expr(sortRank(s, sort)), sortRankColumn).
+			AppendSelectExprAs(sql.Expr(sortAt(s, sort)), sortAtColumn)
+	}
+}
+
+// orderBySortKey orders by the sort's key, newest or highest first unless
+// ascending is set for walking backwards from a cursor
+func orderBySortKey(sort Sort, ascending bool) post.OrderOption {
+	direction := "DESC"
+	if ascending {
+		direction = "ASC"
+	}
+	return func(s *sql.Selector) {
+		s.OrderExpr(
+			sql.Expr(sortRank(s, sort)+" "+direction),
+			sql.Expr(sortAt(s, sort)+" "+direction),
+			sql.Expr(s.C(post.FieldID)+" "+direction),
+		)
+	}
+}
+
+// afterSortKey keeps posts that come after the cursor in the sort, or
+// before it when walking backwards
+func afterSortKey(sort Sort, c *cursor, backwards bool) predicate.Post {
+	op := "<"
+	if backwards {
+		op = ">"
+	}
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("("+sortRank(s, sort)+", "+sortAt(s, sort)+", "+s.C(post.FieldID)+") "+op+" (").
+				Args(c.Rank, c.At, c.ID).
+				WriteString(")")
+		}))
+	}
+}
+
+// cursorFor reads the sort key selected by selectSortKey back off a post
+func cursorFor(p *ent.Post, sort Sort) (cursor, error) {
+	rank, err := p.Value(sortRankColumn)
+	if err != nil {
+		return cursor{}, errors.WithStack(err)
+	}
+	at, err := p.Value(sortAtColumn)
+	if err != nil {
+		return cursor{}, errors.WithStack(err)
+	}
+
+	c := cursor{Sort: sort, ID: p.ID}
+	switch v := rank.(type) {
+	case int64:
+		c.Rank = v
+	default:
+		return cursor{}, errors.Errorf("unexpected sort rank %T", rank)
+	}
+	switch v := at.(type) {
+	case time.Time:
+		c.At = v
+	default:
+		return cursor{}, errors.Errorf("unexpected sort time %T", at)
+	}
+
+	return c, nil
+}
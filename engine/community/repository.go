@@ -2,25 +2,48 @@ package community
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/gofrs/uuid/v5"
 	"github.com/pkg/errors"
 
 	"fixit/engine/ent"
+	"fixit/engine/ent/attachment"
 	"fixit/engine/ent/community"
+	"fixit/engine/ent/membership"
 	"fixit/engine/ent/post"
+	"fixit/engine/ent/predicate"
+	"fixit/engine/geo"
+	"fixit/engine/internal/entx"
+	"fixit/engine/user"
+	"fixit/engine/vote"
 )
 
+// ErrNotEmpty is returned when deleting a community that still has posts
+var ErrNotEmpty = errors.New("community still has posts")
+
 type CommunityCreateFields struct {
-	Name           string `json:"name,omitempty"`
-	Title          string `json:"title,omitempty"`
-	Location       string `json:"location,omitempty"`
-	BannerImageURL string `json:"bannerImageURL,omitempty"`
-	Geography      string `json:"geography,omitempty"`
+	Name           string     `json:"name,omitempty"`
+	Title          string     `json:"title,omitempty"`
+	Location       string     `json:"location,omitempty"`
+	BannerImageURL string     `json:"bannerImageURL,omitempty"`
+	Point          *geo.Point `json:"point,omitempty"`
 }
 
 type Filter struct {
 	Location string
+	// Near limits frontpage communities to those within RadiusKm of a
+	// point, nearest first
+	Near     *geo.Point
+	RadiusKm float64
+	Sort     Sort
+	// After and Before take a cursor from a PostPage to fetch the next or
+	// previous page. At most one may be set.
+	After  string
+	Before string
+	Limit  int
 }
 
 type PostListItem struct {
@@ -29,20 +52,57 @@ type PostListItem struct {
 	Solved           bool
 	PendingSolutions int
 	CommentCount     int
+	Score            vote.Score
+}
+
+// Solved matches issues that are dealt with: their author or a moderator
+// accepted a solution, or they were closed
+func Solved() predicate.Post {
+	return post.Or(post.AcceptedSolutionIDNotNil(), post.StatusEQ(post.StatusClosed))
+}
+
+// IsSolved is whether the issue is one Solved matches
+func IsSolved(p *ent.Post) bool {
+	return p.AcceptedSolutionID != nil || p.Status == post.StatusClosed
 }
 
 type Repository struct {
 	client *ent.Client
+	votes  *vote.Repository
 }
 
 func NewRepository(client *ent.Client) *Repository {
 	return &Repository{
 		client: client,
+		votes:  vote.New(client),
+	}
+}
+
+// Create makes a community, with the owner given as its first member.
+// Communities made by admins can start without an owner.
+func (r *Repository) Create(ctx context.Context, fields CommunityCreateFields, owner *ent.User) (*ent.Community, error) {
+	var comm *ent.Community
+	err := entx.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		comm, err = createCommunity(ctx, tx.Client(), fields)
+		if err != nil || owner == nil {
+			return err
+		}
+		_, err = tx.Membership.Create().
+			SetCommunityID(comm.ID).
+			SetUserID(owner.ID).
+			SetRole(membership.RoleOwner).
+			Save(ctx)
+		return errors.WithStack(err)
+	})
+	if err != nil {
+		return nil, err
 	}
+	return comm, nil
 }
 
-func (r *Repository) Create(ctx context.Context, fields CommunityCreateFields) (*ent.Community, error) {
-	builder := r.client.Community.Create().
+func createCommunity(ctx context.Context, client *ent.Client, fields CommunityCreateFields) (*ent.Community, error) {
+	builder := client.Community.Create().
 		SetName(fields.Name).
 		SetTitle(fields.Title)
 
@@ -54,8 +114,12 @@ func (r *Repository) Create(ctx context.Context, fields CommunityCreateFields) (
 		builder.SetBannerImageURL(fields.BannerImageURL)
 	}
 
-	if fields.Geography != "" {
-		builder.SetGeography(fields.Geography)
+	if fields.Point != nil {
+		p, err := geo.NewPoint(fields.Point.Lat, fields.Point.Lng)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		builder.SetGeography(p.WKT())
 	}
 
 	community, err := builder.Save(ctx)
@@ -76,7 +140,68 @@ func (r *Repository) GetBySlug(ctx context.Context, slug string) (*ent.Community
 	return comm, nil
 }
 
-func (r *Repository) ListPosts(ctx context.Context, communitySlug string, filter *Filter) ([]PostListItem, error) {
+// List returns every community by name
+func (r *Repository) List(ctx context.Context) ([]*ent.Community, error) {
+	comms, err := r.client.Community.Query().
+		Order(ent.Asc(community.FieldName)).
+		All(ctx)
+	return comms, errors.WithStack(err)
+}
+
+// Delete removes a community and its memberships. It must have no posts
+// left.
+func (r *Repository) Delete(ctx context.Context, slug string) error {
+	comm, err := r.GetBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+
+	hasPosts, err := r.client.Post.Query().
+		Where(post.HasCommunityWith(community.IDEQ(comm.ID))).
+		Exist(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if hasPosts {
+		return errors.Wrapf(ErrNotEmpty, "%s", slug)
+	}
+
+	return entx.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		_, err := tx.Membership.Delete().
+			Where(membership.HasCommunityWith(community.IDEQ(comm.ID))).
+			Exec(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return errors.WithStack(tx.Community.DeleteOneID(comm.ID).Exec(ctx))
+	})
+}
+
+// ListPosts returns a page of a community's issues in the filter's sort
+func (r *Repository) ListPosts(ctx context.Context, communitySlug string, filter *Filter) (*PostPage, error) {
+	if filter == nil {
+		filter = &Filter{}
+	}
+
+	sort := filter.Sort
+	if sort == "" {
+		sort = SortNewest
+	}
+	if _, err := ParseSort(string(sort)); err != nil {
+		return nil, err
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limit = min(limit, MaxPageSize)
+
+	if filter.After != "" && filter.Before != "" {
+		return nil, errors.WithStack(ErrInvalidCursor)
+	}
+	backwards := filter.Before != ""
+
 	// First find the community by slug
 	comm, err := r.client.Community.Query().
 		Where(community.NameEQ(communitySlug)).
@@ -85,114 +210,188 @@ func (r *Repository) ListPosts(ctx context.Context, communitySlug string, filter
 		return nil, errors.WithStack(err)
 	}
 
-	// Then query posts for that community
-	posts, err := r.client.Post.Query().
+	// Then query a page of posts for that community, fetching one extra to
+	// tell whether there's another page beyond it
+	query := r.client.Post.Query().
 		Where(
 			post.HasCommunityWith(community.ID(comm.ID)),
 			post.RoleEQ(post.RoleIssue),
+			post.DeletedAtIsNil(),
 		).
-		Order(post.ByCreatedAt(sql.OrderDesc())).
+		Modify(selectSortKey(sort)).
+		Order(orderBySortKey(sort, backwards)).
+		Limit(limit + 1).
 		WithUser().
-		All(ctx)
+		WithAttachments(func(q *ent.AttachmentQuery) {
+			q.Order(ent.Asc(attachment.FieldPosition)).
+				WithFile(func(q *ent.FileQuery) {
+					q.WithDerived()
+				})
+		})
+
+	if from := filter.After + filter.Before; from != "" {
+		c, err := decodeCursor(from, sort)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(afterSortKey(sort, c, backwards))
+	}
+
+	posts, err := query.All(ctx)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	var items []PostListItem
-	for _, p := range posts {
-		// Calculate various metrics for the post
-		solved := false
-		pendingSolutions := 0
-		commentCount := 0
-
-		if p.Role == post.RoleIssue {
-			// Get all solutions for this issue
-			solutions, err := r.client.Post.Query().
-				Where(
-					post.RoleEQ(post.RoleSolution),
-					post.ReplyToEQ(p.ID),
-				).
-				WithReplies(func(q *ent.PostQuery) {
-					q.Where(post.RoleEQ(post.RoleVerification))
-				}).
-				All(ctx)
-
-			if err == nil {
-				for _, solution := range solutions {
-					// Check if this solution has any verification replies
-					hasVerification := len(solution.Edges.Replies) > 0
-					if hasVerification {
-						solved = true
-					} else {
-						pendingSolutions++
-					}
-				}
-			}
+	hasMore := len(posts) > limit
+	if hasMore {
+		posts = posts[:limit]
+	}
+	if backwards {
+		slices.Reverse(posts)
+	}
+
+	page := &PostPage{}
+	if len(posts) > 0 {
+		first, err := cursorFor(posts[0], sort)
+		if err != nil {
+			return nil, err
+		}
+		last, err := cursorFor(posts[len(posts)-1], sort)
+		if err != nil {
+			return nil, err
+		}
 
-			// Count all replies (solutions + verifications + chats)
-			totalReplies, err := r.client.Post.Query().
-				Where(post.ReplyToEQ(p.ID)).
-				Count(ctx)
-			if err == nil {
-				commentCount = totalReplies
+		// Walking backwards, the page we came from is always next, and
+		// any extra post means there's more before this one
+		if backwards {
+			page.NextCursor = last.encode()
+			if hasMore {
+				page.PrevCursor = first.encode()
+			}
+		} else {
+			if hasMore {
+				page.NextCursor = last.encode()
+			}
+			if filter.After != "" {
+				page.PrevCursor = first.encode()
 			}
 		}
+	}
+
+	postIDs := make([]uuid.UUID, len(posts))
+	for i, p := range posts {
+		postIDs[i] = p.ID
+	}
+	scores, err := r.votes.Scores(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := r.replyStats(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
 
+	for _, p := range posts {
 		item := PostListItem{
 			Post:             p,
 			Username:         p.Edges.User.Username,
-			Solved:           solved,
-			PendingSolutions: pendingSolutions,
-			CommentCount:     commentCount,
+			Solved:           IsSolved(p),
+			PendingSolutions: stats[p.ID].PendingSolutions,
+			CommentCount:     stats[p.ID].CommentCount,
+			Score:            scores[p.ID],
 		}
-		items = append(items, item)
+		page.Items = append(page.Items, item)
 	}
 
-	return items, nil
+	return page, nil
+}
+
+type replyStats struct {
+	PostID           uuid.UUID `json:"reply_to"`
+	CommentCount     int       `json:"count"`
+	PendingSolutions int       `json:"pending_solutions"`
 }
 
-func (r *Repository) Seed(ctx context.Context) error {
-	count, err := r.client.Post.Query().Count(ctx)
+// replyStats counts the replies to many posts in a single grouped query.
+// Solutions are pending until they have a verification reply that isn't
+// deleted. Posts without replies are absent from the map.
+func (r *Repository) replyStats(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID]replyStats, error) {
+	stats := make(map[uuid.UUID]replyStats, len(postIDs))
+	if len(postIDs) == 0 {
+		return stats, nil
+	}
+
+	var rows []replyStats
+	err := r.client.Post.Query().
+		Where(post.ReplyToIn(postIDs...), post.DeletedAtIsNil()).
+		GroupBy(post.FieldReplyTo).
+		Aggregate(
+			ent.Count(),
+			func(s *sql.Selector) string {
+				return sql.As(fmt.Sprintf(
+					"COUNT(*) FILTER (WHERE %[1]s = '%[2]s' AND NOT EXISTS (SELECT 1 FROM %[3]s AS v WHERE v.%[4]s = %[5]s AND v.%[1]s = '%[6]s' AND v.%[7]s IS NULL))",
+					s.C(post.FieldRole), post.RoleSolution, post.Table, post.FieldReplyTo, s.C(post.FieldID), post.RoleVerification, post.FieldDeletedAt,
+				), "pending_solutions")
+			},
+		).
+		Scan(ctx, &rows)
 	if err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
-	if count > 0 {
-		return nil
+	for _, row := range rows {
+		stats[row.PostID] = row
 	}
 
-	comm, err := r.client.Community.Create().
-		SetName("swindon").
-		SetTitle("Swindon Community").
-		SetLocation("Swindon, UK").
-		Save(ctx)
+	return stats, nil
+}
+
+// Seed creates the swindon community with a few users and issues for
+// development, with users made through the user repository so they can log
+// in. It does nothing once the community exists.
+func (r *Repository) Seed(ctx context.Context, users *user.Repository) error {
+	exists, err := r.client.Community.Query().
+		Where(community.NameEQ("swindon")).
+		Exist(ctx)
 	if err != nil {
-		return err
+		return errors.WithStack(err)
+	}
+	if exists {
+		return nil
 	}
 
 	// Create example users
-	users := []struct {
-		username string
-		email    string
-		password string
-	}{
-		{"alice", "alice@example.com", "password123"},
-		{"bob", "bob@example.com", "password123"},
-		{"charlie", "charlie@example.com", "password123"},
-		{"diana", "diana@example.com", "password123"},
+	seedUsers := []user.CreateFields{
+		{Username: "alice", Email: "alice@example.com", Password: "password123"},
+		{Username: "bob", Email: "bob@example.com", Password: "password123"},
+		{Username: "charlie", Email: "charlie@example.com", Password: "password123"},
+		{Username: "diana", Email: "diana@example.com", Password: "password123"},
 	}
 
 	var createdUsers []*ent.User
-	for _, userData := range users {
-		user, err := r.client.User.Create().
-			SetUsername(userData.username).
-			SetEmail(userData.email).
-			SetPassword(userData.password).
-			Save(ctx)
+	for _, fields := range seedUsers {
+		u, err := users.Find(ctx, fields.Email)
+		if errors.Is(err, user.ErrNotFound) {
+			u, err = users.Create(ctx, fields)
+		}
 		if err != nil {
-			return errors.WithStack(err)
+			return err
 		}
-		createdUsers = append(createdUsers, user)
+		createdUsers = append(createdUsers, u)
+	}
+
+	// alice owns the community and bob moderates it
+	comm, err := r.Create(ctx, CommunityCreateFields{
+		Name:     "swindon",
+		Title:    "Swindon Community",
+		Location: "Swindon, UK",
+	}, createdUsers[0])
+	if err != nil {
+		return err
+	}
+	if err := r.Assign(ctx, comm.ID, createdUsers[1], membership.RoleModerator); err != nil {
+		return err
 	}
 
 	posts := []string{
@@ -221,17 +420,26 @@ func (r *Repository) Seed(ctx context.Context) error {
 	return nil
 }
 
-func (r *Repository) ForFrontpage(ctx context.Context, filter Filter) ([]*ent.Community, error) {
+// ForFrontpage lists communities, optionally only those near a point
+func (r *Repository) ForFrontpage(ctx context.Context, filter Filter) ([]*FrontpageCommunity, error) {
 	query := r.client.Community.Query()
 
 	if filter.Location != "" {
 		query = query.Where(community.LocationEQ(filter.Location))
 	}
 
+	if filter.Near != nil {
+		return r.near(ctx, query, *filter.Near, filter.RadiusKm)
+	}
+
 	communities, err := query.All(ctx)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	return communities, nil
+	items := make([]*FrontpageCommunity, len(communities))
+	for i, comm := range communities {
+		items[i] = &FrontpageCommunity{Community: comm}
+	}
+	return items, nil
 }
@@ -0,0 +1,341 @@
+package community_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/community"
+	"fixit/engine/ent"
+	entCommunity "fixit/engine/ent/community"
+	entPost "fixit/engine/ent/post"
+	entVote "fixit/engine/ent/vote"
+	"fixit/engine/factory"
+	"fixit/engine/user"
+)
+
+func TestRepository_ListPostsPagination(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := community.NewRepository(client)
+
+	user := factory.User(t, client, "page-user-*")
+	comm := factory.Community(t, client, "page-community-*")
+
+	var created []*ent.Post
+	for i := range 5 {
+		created = append(created, createIssue(t, client, user, comm, fmt.Sprintf("Issue number %d", i)))
+	}
+
+	// Test: Pages walk forwards newest first without overlap
+	first, err := repo.ListPosts(ctx, comm.Name, &community.Filter{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{created[4].ID, created[3].ID}, ids(first))
+	assert.Empty(t, first.PrevCursor)
+	require.NotEmpty(t, first.NextCursor)
+
+	second, err := repo.ListPosts(ctx, comm.Name, &community.Filter{Limit: 2, After: first.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{created[2].ID, created[1].ID}, ids(second))
+	assert.NotEmpty(t, second.PrevCursor)
+	require.NotEmpty(t, second.NextCursor)
+
+	last, err := repo.ListPosts(ctx, comm.Name, &community.Filter{Limit: 2, After: second.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{created[0].ID}, ids(last))
+	assert.Empty(t, last.NextCursor)
+
+	// Test: Walking backwards returns the same pages
+	back, err := repo.ListPosts(ctx, comm.Name, &community.Filter{Limit: 2, Before: last.PrevCursor})
+	require.NoError(t, err)
+	assert.Equal(t, ids(second), ids(back))
+
+	back, err = repo.ListPosts(ctx, comm.Name, &community.Filter{Limit: 2, Before: back.PrevCursor})
+	require.NoError(t, err)
+	assert.Equal(t, ids(first), ids(back))
+	assert.Empty(t, back.PrevCursor)
+
+	// Test: Cursors are tied to their sort
+	_, err = repo.ListPosts(ctx, comm.Name, &community.Filter{Sort: community.SortVotes, After: first.NextCursor})
+	assert.ErrorIs(t, err, community.ErrInvalidCursor)
+
+	_, err = repo.ListPosts(ctx, comm.Name, &community.Filter{After: "not-a-cursor"})
+	assert.ErrorIs(t, err, community.ErrInvalidCursor)
+}
+
+func TestRepository_ListPostsSorts(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := community.NewRepository(client)
+
+	author := factory.User(t, client, "sort-author-*")
+	voter := factory.User(t, client, "sort-voter-*")
+	comm := factory.Community(t, client, "sort-community-*")
+
+	oldest := createIssue(t, client, author, comm, "Oldest issue")
+	popular := createIssue(t, client, author, comm, "Popular issue")
+	newest := createIssue(t, client, author, comm, "Newest issue")
+	// Closing an issue solves it too, even without an accepted solution
+	closed := createIssue(t, client, author, comm, "Closed issue")
+	_, err := closed.Update().SetStatus(entPost.StatusClosed).Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Vote.Create().
+		SetKind(entVote.KindInteresting).
+		SetValue(1).
+		SetPostID(popular.ID).
+		SetUser(voter).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// A reply makes the oldest issue the most recently active
+	_, err = client.Post.Create().
+		SetTitle("Still happening").
+		SetRole(entPost.RoleChat).
+		SetReplyTo(oldest.ID).
+		SetUser(voter).
+		SetCommunity(comm).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Accepting a solution moves the newest issue behind unsolved ones
+	solution, err := client.Post.Create().
+		SetTitle("Fixed it").
+		SetRole(entPost.RoleSolution).
+		SetReplyTo(newest.ID).
+		SetUser(voter).
+		SetCommunity(comm).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = newest.Update().SetAcceptedSolutionID(solution.ID).Save(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		sort community.Sort
+		want []uuid.UUID
+	}{
+		{community.SortNewest, []uuid.UUID{closed.ID, newest.ID, popular.ID, oldest.ID}},
+		{community.SortVotes, []uuid.UUID{popular.ID, closed.ID, newest.ID, oldest.ID}},
+		{community.SortActivity, []uuid.UUID{newest.ID, oldest.ID, closed.ID, popular.ID}},
+		{community.SortUnsolved, []uuid.UUID{popular.ID, oldest.ID, closed.ID, newest.ID}},
+	}
+
+	page, err := repo.ListPosts(ctx, comm.Name, nil)
+	require.NoError(t, err)
+	for _, item := range page.Items {
+		assert.Equal(t, item.ID == newest.ID || item.ID == closed.ID, item.Solved, item.Title)
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			page, err := repo.ListPosts(ctx, comm.Name, &community.Filter{Sort: tt.sort})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(page))
+
+			// Paging one at a time gives the same order
+			var paged []uuid.UUID
+			filter := &community.Filter{Sort: tt.sort, Limit: 1}
+			for {
+				page, err := repo.ListPosts(ctx, comm.Name, filter)
+				require.NoError(t, err)
+				paged = append(paged, ids(page)...)
+				if page.NextCursor == "" {
+					break
+				}
+				filter.After = page.NextCursor
+			}
+			assert.Equal(t, tt.want, paged)
+		})
+	}
+
+	_, err = community.ParseSort("hottest")
+	assert.ErrorIs(t, err, community.ErrInvalidSort)
+}
+
+func TestRepository_ListPostsReplyStats(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := community.NewRepository(client)
+
+	author := factory.User(t, client, "stats-author-*")
+	solver := factory.User(t, client, "stats-solver-*")
+	comm := factory.Community(t, client, "stats-community-*")
+
+	issue := createIssue(t, client, author, comm, "Issue with replies")
+	quiet := createIssue(t, client, author, comm, "Issue without replies")
+
+	verified := createReply(t, client, solver, comm, issue, entPost.RoleSolution)
+	createReply(t, client, solver, comm, issue, entPost.RoleSolution)
+	createReply(t, client, author, comm, issue, entPost.RoleChat)
+	createReply(t, client, author, comm, verified, entPost.RoleVerification)
+	// A deleted verification leaves its solution pending
+	unverified := createReply(t, client, solver, comm, issue, entPost.RoleSolution)
+	retracted := createReply(t, client, author, comm, unverified, entPost.RoleVerification)
+	_, err := retracted.Update().SetDeletedAt(time.Now()).Save(ctx)
+	require.NoError(t, err)
+
+	page, err := repo.ListPosts(ctx, comm.Name, nil)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+
+	byID := map[uuid.UUID]community.PostListItem{}
+	for _, item := range page.Items {
+		byID[item.ID] = item
+	}
+
+	// Verifications reply to the solution, so only direct replies count
+	assert.Equal(t, 4, byID[issue.ID].CommentCount)
+	assert.Equal(t, 2, byID[issue.ID].PendingSolutions)
+	assert.False(t, byID[issue.ID].Solved)
+
+	assert.Equal(t, 0, byID[quiet.ID].CommentCount)
+	assert.Equal(t, 0, byID[quiet.ID].PendingSolutions)
+}
+
+func TestRepository_ListPostsQueryCountIsConstant(t *testing.T) {
+	assert.Equal(t, countListPostsQueries(t, 2), countListPostsQueries(t, 20))
+}
+
+// BenchmarkListPosts reports the queries each page takes, which should not
+// grow with the number of posts on it
+func BenchmarkListPosts(b *testing.B) {
+	for _, size := range []int{10, 50} {
+		b.Run(fmt.Sprintf("posts=%d", size), func(b *testing.B) {
+			client, queries := setupCountingTestDB(b)
+			repo := community.NewRepository(client)
+			comm := seedThreads(b, client, size)
+
+			b.ResetTimer()
+			queries.Store(0)
+			for range b.N {
+				_, err := repo.ListPosts(context.Background(), comm.Name, &community.Filter{Limit: size})
+				require.NoError(b, err)
+			}
+			b.ReportMetric(float64(queries.Load())/float64(b.N), "queries/op")
+		})
+	}
+}
+
+// countListPostsQueries lists a page of the given number of issues, each
+// with replies, and returns how many queries it took
+func countListPostsQueries(tb testing.TB, size int) int64 {
+	client, queries := setupCountingTestDB(tb)
+	repo := community.NewRepository(client)
+	comm := seedThreads(tb, client, size)
+
+	queries.Store(0)
+	page, err := repo.ListPosts(context.Background(), comm.Name, &community.Filter{Limit: size})
+	require.NoError(tb, err)
+	require.Len(tb, page.Items, size)
+
+	return queries.Load()
+}
+
+func TestRepository_Delete(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := community.NewRepository(client)
+
+	user := factory.User(t, client, "delete-user-*")
+	empty := factory.Community(t, client, "delete-empty-*")
+	busy := factory.Community(t, client, "delete-busy-*")
+	createIssue(t, client, user, busy, "Issue keeping the community")
+
+	// Test: Communities without posts are deleted
+	require.NoError(t, repo.Delete(ctx, empty.Name))
+	_, err := repo.GetBySlug(ctx, empty.Name)
+	assert.True(t, ent.IsNotFound(errors.Cause(err)))
+
+	// Test: Communities with posts are kept
+	err = repo.Delete(ctx, busy.Name)
+	assert.ErrorIs(t, err, community.ErrNotEmpty)
+	_, err = repo.GetBySlug(ctx, busy.Name)
+	assert.NoError(t, err)
+}
+
+func TestRepository_Seed(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := community.NewRepository(client)
+	users := user.New(client, factory.Passwords(t))
+
+	// Test: Seeding twice leaves one swindon community
+	require.NoError(t, repo.Seed(ctx, users))
+	require.NoError(t, repo.Seed(ctx, users))
+	count, err := client.Community.Query().Where(entCommunity.NameEQ("swindon")).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+// seedThreads creates a community of issues that each have a verified
+// solution, a pending solution and a chat reply
+func seedThreads(tb testing.TB, client *ent.Client, size int) *ent.Community {
+	author := factory.User(tb, client, "thread-author-*")
+	solver := factory.User(tb, client, "thread-solver-*")
+	comm := factory.Community(tb, client, "thread-community-*")
+
+	for i := range size {
+		issue := createIssue(tb, client, author, comm, fmt.Sprintf("Thread issue %d", i))
+		verified := createReply(tb, client, solver, comm, issue, entPost.RoleSolution)
+		createReply(tb, client, solver, comm, issue, entPost.RoleSolution)
+		createReply(tb, client, author, comm, issue, entPost.RoleChat)
+		createReply(tb, client, author, comm, verified, entPost.RoleVerification)
+	}
+
+	return comm
+}
+
+func createReply(tb testing.TB, client *ent.Client, user *ent.User, comm *ent.Community, parent *ent.Post, role entPost.Role) *ent.Post {
+	p, err := client.Post.Create().
+		SetTitle("Reply to " + parent.Title).
+		SetRole(role).
+		SetReplyTo(parent.ID).
+		SetUser(user).
+		SetCommunity(comm).
+		Save(context.Background())
+	require.NoError(tb, err)
+	return p
+}
+
+func createIssue(tb testing.TB, client *ent.Client, user *ent.User, comm *ent.Community, title string) *ent.Post {
+	p, err := client.Post.Create().
+		SetTitle(title).
+		SetUser(user).
+		SetCommunity(comm).
+		Save(context.Background())
+	require.NoError(tb, err)
+	// Keep created_at distinct so the expected order is unambiguous
+	time.Sleep(2 * time.Millisecond)
+	return p
+}
+
+func ids(page *community.PostPage) []uuid.UUID {
+	var out []uuid.UUID
+	for _, item := range page.Items {
+		out = append(out, item.ID)
+	}
+	return out
+}
+
+func setupTestDB(t *testing.T) *ent.Client {
+	return factory.DB(t, ent.Log(t.Log))
+}
+
+// setupCountingTestDB returns a client that counts every statement it sends
+func setupCountingTestDB(tb testing.TB) (*ent.Client, *atomic.Int64) {
+	queries := &atomic.Int64{}
+	client := factory.DB(tb, ent.Debug(), ent.Log(func(...any) {
+		queries.Add(1)
+	}))
+	tb.Cleanup(func() { client.Close() })
+
+	return client, queries
+}
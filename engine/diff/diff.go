@@ -0,0 +1,93 @@
+// Package diff compares two texts line by line
+package diff
+
+import "strings"
+
+// Op is what happened to a line
+type Op string
+
+const (
+	Equal  Op = "equal"
+	Insert Op = "insert"
+	Delete Op = "delete"
+)
+
+// Line is one line of a diff
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Lines lists the lines of before and after in order, marking lines only in
+// before as deleted and lines only in after as inserted
+func Lines(before, after string) []Line {
+	a, b := split(before), split(after)
+
+	// Lines the texts start and end with needn't be compared
+	var head, tail []Line
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		head = append(head, Line{Equal, a[0]})
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		tail = append([]Line{{Equal, a[len(a)-1]}}, tail...)
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+
+	// common[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:]
+	common := make([][]int, len(a)+1)
+	for i := range common {
+		common[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	lines := head
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, Line{Equal, a[i]})
+			i, j = i+1, j+1
+		case common[i+1][j] >= common[i][j+1]:
+			lines = append(lines, Line{Delete, a[i]})
+			i++
+		default:
+			lines = append(lines, Line{Insert, b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, Line{Delete, a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, Line{Insert, b[j]})
+	}
+	return append(lines, tail...)
+}
+
+// Changed is whether any line was inserted or deleted
+func Changed(lines []Line) bool {
+	for _, line := range lines {
+		if line.Op != Equal {
+			return true
+		}
+	}
+	return false
+}
+
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
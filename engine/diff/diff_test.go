@@ -0,0 +1,30 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fixit/engine/diff"
+)
+
+func TestLines(t *testing.T) {
+	lines := diff.Lines("Pothole\non Drove Road\nabout a foot wide\n", "Pothole\non Drove Road\r\nnear the school\nabout a foot wide\nand deep")
+	assert.Equal(t, []diff.Line{
+		{Op: diff.Equal, Text: "Pothole"},
+		{Op: diff.Equal, Text: "on Drove Road"},
+		{Op: diff.Insert, Text: "near the school"},
+		{Op: diff.Equal, Text: "about a foot wide"},
+		{Op: diff.Insert, Text: "and deep"},
+	}, lines)
+	assert.True(t, diff.Changed(lines))
+
+	assert.Equal(t, []diff.Line{
+		{Op: diff.Delete, Text: "one"},
+		{Op: diff.Insert, Text: "two"},
+	}, diff.Lines("one", "two"))
+	assert.Equal(t, []diff.Line{{Op: diff.Delete, Text: "gone"}}, diff.Lines("gone", ""))
+
+	assert.False(t, diff.Changed(diff.Lines("same\n", "same")))
+	assert.Empty(t, diff.Lines("", ""))
+}
@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/attachment"
+	"fixit/engine/ent/file"
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/predicate"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// AttachmentUpdate is the builder for updating Attachment entities.
+type AttachmentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AttachmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (au *AttachmentUpdate) Where(ps ...predicate.Attachment) *AttachmentUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetPostID sets the "post_id" field.
+func (au *AttachmentUpdate) SetPostID(u uuid.UUID) *AttachmentUpdate {
+	au.mutation.SetPostID(u)
+	return au
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillablePostID(u *uuid.UUID) *AttachmentUpdate {
+	if u != nil {
+		au.SetPostID(*u)
+	}
+	return au
+}
+
+// SetFileID sets the "file_id" field.
+func (au *AttachmentUpdate) SetFileID(u uuid.UUID) *AttachmentUpdate {
+	au.mutation.SetFileID(u)
+	return au
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableFileID(u *uuid.UUID) *AttachmentUpdate {
+	if u != nil {
+		au.SetFileID(*u)
+	}
+	return au
+}
+
+// SetCaption sets the "caption" field.
+func (au *AttachmentUpdate) SetCaption(s string) *AttachmentUpdate {
+	au.mutation.SetCaption(s)
+	return au
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableCaption(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetCaption(*s)
+	}
+	return au
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (au *AttachmentUpdate) ClearCaption() *AttachmentUpdate {
+	au.mutation.ClearCaption()
+	return au
+}
+
+// SetPosition sets the "position" field.
+func (au *AttachmentUpdate) SetPosition(i int) *AttachmentUpdate {
+	au.mutation.ResetPosition()
+	au.mutation.SetPosition(i)
+	return au
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillablePosition(i *int) *AttachmentUpdate {
+	if i != nil {
+		au.SetPosition(*i)
+	}
+	return au
+}
+
+// AddPosition adds i to the "position" field.
+func (au *AttachmentUpdate) AddPosition(i int) *AttachmentUpdate {
+	au.mutation.AddPosition(i)
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AttachmentUpdate) SetUpdatedAt(t time.Time) *AttachmentUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (au *AttachmentUpdate) SetPost(p *Post) *AttachmentUpdate {
+	return au.SetPostID(p.ID)
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (au *AttachmentUpdate) SetFile(f *File) *AttachmentUpdate {
+	return au.SetFileID(f.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (au *AttachmentUpdate) Mutation() *AttachmentMutation {
+	return au.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (au *AttachmentUpdate) ClearPost() *AttachmentUpdate {
+	au.mutation.ClearPost()
+	return au
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (au *AttachmentUpdate) ClearFile() *AttachmentUpdate {
+	au.mutation.ClearFile()
+	return au
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AttachmentUpdate) Save(ctx context.Context) (int, error) {
+	au.defaults()
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AttachmentUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AttachmentUpdate) defaults() {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AttachmentUpdate) check() error {
+	if v, ok := au.mutation.Caption(); ok {
+		if err := attachment.CaptionValidator(v); err != nil {
+			return &ValidationError{Name: "caption", err: fmt.Errorf(`ent: validator failed for field "Attachment.caption": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Position(); ok {
+		if err := attachment.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Attachment.position": %w`, err)}
+		}
+	}
+	if au.mutation.PostCleared() && len(au.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.post"`)
+	}
+	if au.mutation.FileCleared() && len(au.mutation.FileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.file"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (au *AttachmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttachmentUpdate {
+	au.modifiers = append(au.modifiers, modifiers...)
+	return au
+}
+
+func (au *AttachmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.Caption(); ok {
+		_spec.SetField(attachment.FieldCaption, field.TypeString, value)
+	}
+	if au.mutation.CaptionCleared() {
+		_spec.ClearField(attachment.FieldCaption, field.TypeString)
+	}
+	if value, ok := au.mutation.Position(); ok {
+		_spec.SetField(attachment.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedPosition(); ok {
+		_spec.AddField(attachment.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if au.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.PostTable,
+			Columns: []string{attachment.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.PostTable,
+			Columns: []string{attachment.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.FileTable,
+			Columns: []string{attachment.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.FileTable,
+			Columns: []string{attachment.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(au.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AttachmentUpdateOne is the builder for updating a single Attachment entity.
+type AttachmentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AttachmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPostID sets the "post_id" field.
+func (auo *AttachmentUpdateOne) SetPostID(u uuid.UUID) *AttachmentUpdateOne {
+	auo.mutation.SetPostID(u)
+	return auo
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillablePostID(u *uuid.UUID) *AttachmentUpdateOne {
+	if u != nil {
+		auo.SetPostID(*u)
+	}
+	return auo
+}
+
+// SetFileID sets the "file_id" field.
+func (auo *AttachmentUpdateOne) SetFileID(u uuid.UUID) *AttachmentUpdateOne {
+	auo.mutation.SetFileID(u)
+	return auo
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableFileID(u *uuid.UUID) *AttachmentUpdateOne {
+	if u != nil {
+		auo.SetFileID(*u)
+	}
+	return auo
+}
+
+// SetCaption sets the "caption" field.
+func (auo *AttachmentUpdateOne) SetCaption(s string) *AttachmentUpdateOne {
+	auo.mutation.SetCaption(s)
+	return auo
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableCaption(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetCaption(*s)
+	}
+	return auo
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (auo *AttachmentUpdateOne) ClearCaption() *AttachmentUpdateOne {
+	auo.mutation.ClearCaption()
+	return auo
+}
+
+// SetPosition sets the "position" field.
+func (auo *AttachmentUpdateOne) SetPosition(i int) *AttachmentUpdateOne {
+	auo.mutation.ResetPosition()
+	auo.mutation.SetPosition(i)
+	return auo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillablePosition(i *int) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetPosition(*i)
+	}
+	return auo
+}
+
+// AddPosition adds i to the "position" field.
+func (auo *AttachmentUpdateOne) AddPosition(i int) *AttachmentUpdateOne {
+	auo.mutation.AddPosition(i)
+	return auo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AttachmentUpdateOne) SetUpdatedAt(t time.Time) *AttachmentUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (auo *AttachmentUpdateOne) SetPost(p *Post) *AttachmentUpdateOne {
+	return auo.SetPostID(p.ID)
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (auo *AttachmentUpdateOne) SetFile(f *File) *AttachmentUpdateOne {
+	return auo.SetFileID(f.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (auo *AttachmentUpdateOne) Mutation() *AttachmentMutation {
+	return auo.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (auo *AttachmentUpdateOne) ClearPost() *AttachmentUpdateOne {
+	auo.mutation.ClearPost()
+	return auo
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (auo *AttachmentUpdateOne) ClearFile() *AttachmentUpdateOne {
+	auo.mutation.ClearFile()
+	return auo
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (auo *AttachmentUpdateOne) Where(ps ...predicate.Attachment) *AttachmentUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AttachmentUpdateOne) Select(field string, fields ...string) *AttachmentUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Attachment entity.
+func (auo *AttachmentUpdateOne) Save(ctx context.Context) (*Attachment, error) {
+	auo.defaults()
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) SaveX(ctx context.Context) *Attachment {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AttachmentUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AttachmentUpdateOne) check() error {
+	if v, ok := auo.mutation.Caption(); ok {
+		if err := attachment.CaptionValidator(v); err != nil {
+			return &ValidationError{Name: "caption", err: fmt.Errorf(`ent: validator failed for field "Attachment.caption": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Position(); ok {
+		if err := attachment.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Attachment.position": %w`, err)}
+		}
+	}
+	if auo.mutation.PostCleared() && len(auo.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.post"`)
+	}
+	if auo.mutation.FileCleared() && len(auo.mutation.FileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.file"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (auo *AttachmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttachmentUpdateOne {
+	auo.modifiers = append(auo.modifiers, modifiers...)
+	return auo
+}
+
+func (auo *AttachmentUpdateOne) sqlSave(ctx context.Context) (_node *Attachment, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Attachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachment.FieldID)
+		for _, f := range fields {
+			if !attachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.Caption(); ok {
+		_spec.SetField(attachment.FieldCaption, field.TypeString, value)
+	}
+	if auo.mutation.CaptionCleared() {
+		_spec.ClearField(attachment.FieldCaption, field.TypeString)
+	}
+	if value, ok := auo.mutation.Position(); ok {
+		_spec.SetField(attachment.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedPosition(); ok {
+		_spec.AddField(attachment.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if auo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.PostTable,
+			Columns: []string{attachment.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.PostTable,
+			Columns: []string{attachment.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.FileTable,
+			Columns: []string{attachment.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.FileTable,
+			Columns: []string{attachment.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(auo.modifiers...)
+	_node = &Attachment{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
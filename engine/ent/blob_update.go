@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/blob"
+	"fixit/engine/ent/predicate"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlobUpdate is the builder for updating Blob entities.
+type BlobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BlobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BlobUpdate builder.
+func (bu *BlobUpdate) Where(ps ...predicate.Blob) *BlobUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetData sets the "data" field.
+func (bu *BlobUpdate) SetData(b []byte) *BlobUpdate {
+	bu.mutation.SetData(b)
+	return bu
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (bu *BlobUpdate) Mutation() *BlobMutation {
+	return bu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BlobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BlobUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BlobUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BlobUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bu *BlobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlobUpdate {
+	bu.modifiers = append(bu.modifiers, modifiers...)
+	return bu
+}
+
+func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(blob.Table, blob.Columns, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeString))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Data(); ok {
+		_spec.SetField(blob.FieldData, field.TypeBytes, value)
+	}
+	_spec.AddModifiers(bu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BlobUpdateOne is the builder for updating a single Blob entity.
+type BlobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BlobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetData sets the "data" field.
+func (buo *BlobUpdateOne) SetData(b []byte) *BlobUpdateOne {
+	buo.mutation.SetData(b)
+	return buo
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (buo *BlobUpdateOne) Mutation() *BlobMutation {
+	return buo.mutation
+}
+
+// Where appends a list predicates to the BlobUpdate builder.
+func (buo *BlobUpdateOne) Where(ps ...predicate.Blob) *BlobUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BlobUpdateOne) Select(field string, fields ...string) *BlobUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Blob entity.
+func (buo *BlobUpdateOne) Save(ctx context.Context) (*Blob, error) {
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BlobUpdateOne) SaveX(ctx context.Context) *Blob {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BlobUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BlobUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (buo *BlobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlobUpdateOne {
+	buo.modifiers = append(buo.modifiers, modifiers...)
+	return buo
+}
+
+func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error) {
+	_spec := sqlgraph.NewUpdateSpec(blob.Table, blob.Columns, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeString))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Blob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blob.FieldID)
+		for _, f := range fields {
+			if !blob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Data(); ok {
+		_spec.SetField(blob.FieldData, field.TypeBytes, value)
+	}
+	_spec.AddModifiers(buo.modifiers...)
+	_node = &Blob{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
@@ -184,6 +184,11 @@ func (cc *CommunityCreate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Community.title": %w`, err)}
 		}
 	}
+	if v, ok := cc.mutation.Geography(); ok {
+		if err := community.GeographyValidator(v); err != nil {
+			return &ValidationError{Name: "geography", err: fmt.Errorf(`ent: validator failed for field "Community.geography": %w`, err)}
+		}
+	}
 	if _, ok := cc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Community.created_at"`)}
 	}
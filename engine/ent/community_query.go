@@ -23,6 +23,7 @@ type CommunityQuery struct {
 	order      []community.OrderOption
 	inters     []Interceptor
 	predicates []predicate.Community
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -252,8 +253,9 @@ func (cq *CommunityQuery) Clone() *CommunityQuery {
 		inters:     append([]Interceptor{}, cq.inters...),
 		predicates: append([]predicate.Community{}, cq.predicates...),
 		// clone intermediate query.
-		sql:  cq.sql.Clone(),
-		path: cq.path,
+		sql:       cq.sql.Clone(),
+		path:      cq.path,
+		modifiers: append([]func(*sql.Selector){}, cq.modifiers...),
 	}
 }
 
@@ -344,6 +346,9 @@ func (cq *CommunityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Co
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -358,6 +363,9 @@ func (cq *CommunityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Co
 
 func (cq *CommunityQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	_spec.Node.Columns = cq.ctx.Fields
 	if len(cq.ctx.Fields) > 0 {
 		_spec.Unique = cq.ctx.Unique != nil && *cq.ctx.Unique
@@ -420,6 +428,9 @@ func (cq *CommunityQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if cq.ctx.Unique != nil && *cq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range cq.modifiers {
+		m(selector)
+	}
 	for _, p := range cq.predicates {
 		p(selector)
 	}
@@ -437,6 +448,12 @@ func (cq *CommunityQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cq *CommunityQuery) Modify(modifiers ...func(s *sql.Selector)) *CommunitySelect {
+	cq.modifiers = append(cq.modifiers, modifiers...)
+	return cq.Select()
+}
+
 // CommunityGroupBy is the group-by builder for Community entities.
 type CommunityGroupBy struct {
 	selector
@@ -526,3 +543,9 @@ func (cs *CommunitySelect) sqlScan(ctx context.Context, root *CommunityQuery, v
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cs *CommunitySelect) Modify(modifiers ...func(s *sql.Selector)) *CommunitySelect {
+	cs.modifiers = append(cs.modifiers, modifiers...)
+	return cs
+}
@@ -18,8 +18,9 @@ import (
 // CommunityUpdate is the builder for updating Community entities.
 type CommunityUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CommunityMutation
+	hooks     []Hook
+	mutation  *CommunityMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CommunityUpdate builder.
@@ -175,9 +176,20 @@ func (cu *CommunityUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Community.title": %w`, err)}
 		}
 	}
+	if v, ok := cu.mutation.Geography(); ok {
+		if err := community.GeographyValidator(v); err != nil {
+			return &ValidationError{Name: "geography", err: fmt.Errorf(`ent: validator failed for field "Community.geography": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cu *CommunityUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommunityUpdate {
+	cu.modifiers = append(cu.modifiers, modifiers...)
+	return cu
+}
+
 func (cu *CommunityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := cu.check(); err != nil {
 		return n, err
@@ -217,6 +229,7 @@ func (cu *CommunityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.UpdatedAt(); ok {
 		_spec.SetField(community.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(cu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{community.Label}
@@ -232,9 +245,10 @@ func (cu *CommunityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // CommunityUpdateOne is the builder for updating a single Community entity.
 type CommunityUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CommunityMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CommunityMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -397,9 +411,20 @@ func (cuo *CommunityUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Community.title": %w`, err)}
 		}
 	}
+	if v, ok := cuo.mutation.Geography(); ok {
+		if err := community.GeographyValidator(v); err != nil {
+			return &ValidationError{Name: "geography", err: fmt.Errorf(`ent: validator failed for field "Community.geography": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cuo *CommunityUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommunityUpdateOne {
+	cuo.modifiers = append(cuo.modifiers, modifiers...)
+	return cuo
+}
+
 func (cuo *CommunityUpdateOne) sqlSave(ctx context.Context) (_node *Community, err error) {
 	if err := cuo.check(); err != nil {
 		return _node, err
@@ -456,6 +481,7 @@ func (cuo *CommunityUpdateOne) sqlSave(ctx context.Context) (_node *Community, e
 	if value, ok := cuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(community.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(cuo.modifiers...)
 	_node = &Community{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
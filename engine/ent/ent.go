@@ -5,8 +5,17 @@ package ent
 import (
 	"context"
 	"errors"
+	"fixit/engine/ent/attachment"
+	"fixit/engine/ent/blob"
 	"fixit/engine/ent/community"
+	"fixit/engine/ent/file"
+	"fixit/engine/ent/identity"
+	"fixit/engine/ent/membership"
 	"fixit/engine/ent/post"
+	"fixit/engine/ent/postrevision"
+	"fixit/engine/ent/remembertoken"
+	"fixit/engine/ent/session"
+	"fixit/engine/ent/statuschange"
 	"fixit/engine/ent/user"
 	"fixit/engine/ent/vote"
 	"fmt"
@@ -76,10 +85,19 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			community.Table: community.ValidColumn,
-			post.Table:      post.ValidColumn,
-			user.Table:      user.ValidColumn,
-			vote.Table:      vote.ValidColumn,
+			attachment.Table:    attachment.ValidColumn,
+			blob.Table:          blob.ValidColumn,
+			community.Table:     community.ValidColumn,
+			file.Table:          file.ValidColumn,
+			identity.Table:      identity.ValidColumn,
+			membership.Table:    membership.ValidColumn,
+			post.Table:          post.ValidColumn,
+			postrevision.Table:  postrevision.ValidColumn,
+			remembertoken.Table: remembertoken.ValidColumn,
+			session.Table:       session.ValidColumn,
+			statuschange.Table:  statuschange.ValidColumn,
+			user.Table:          user.ValidColumn,
+			vote.Table:          vote.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fixit/engine/ent/file"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// File is the model entity for the File schema.
+type File struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Filename holds the value of the "filename" field.
+	Filename string `json:"filename,omitempty"`
+	// Extension holds the value of the "extension" field.
+	Extension string `json:"extension,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Variant holds the value of the "variant" field.
+	Variant file.Variant `json:"variant,omitempty"`
+	// OriginalID holds the value of the "original_id" field.
+	OriginalID *uuid.UUID `json:"original_id,omitempty"`
+	// Width holds the value of the "width" field.
+	Width int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height int `json:"height,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FileQuery when eager-loading is set.
+	Edges        FileEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FileEdges holds the relations/edges for other nodes in the graph.
+type FileEdges struct {
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// Derived holds the value of the derived edge.
+	Derived []*File `json:"derived,omitempty"`
+	// Original holds the value of the original edge.
+	Original *File `json:"original,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[0] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// DerivedOrErr returns the Derived value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) DerivedOrErr() ([]*File, error) {
+	if e.loadedTypes[1] {
+		return e.Derived, nil
+	}
+	return nil, &NotLoadedError{edge: "derived"}
+}
+
+// OriginalOrErr returns the Original value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileEdges) OriginalOrErr() (*File, error) {
+	if e.Original != nil {
+		return e.Original, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: file.Label}
+	}
+	return nil, &NotLoadedError{edge: "original"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*File) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case file.FieldOriginalID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case file.FieldSize, file.FieldWidth, file.FieldHeight:
+			values[i] = new(sql.NullInt64)
+		case file.FieldFilename, file.FieldExtension, file.FieldContentType, file.FieldVariant:
+			values[i] = new(sql.NullString)
+		case file.FieldCreatedAt, file.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case file.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the File fields.
+func (f *File) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case file.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				f.ID = *value
+			}
+		case file.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				f.Filename = value.String
+			}
+		case file.FieldExtension:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field extension", values[i])
+			} else if value.Valid {
+				f.Extension = value.String
+			}
+		case file.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				f.ContentType = value.String
+			}
+		case file.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				f.Size = value.Int64
+			}
+		case file.FieldVariant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant", values[i])
+			} else if value.Valid {
+				f.Variant = file.Variant(value.String)
+			}
+		case file.FieldOriginalID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field original_id", values[i])
+			} else if value.Valid {
+				f.OriginalID = new(uuid.UUID)
+				*f.OriginalID = *value.S.(*uuid.UUID)
+			}
+		case file.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				f.Width = int(value.Int64)
+			}
+		case file.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				f.Height = int(value.Int64)
+			}
+		case file.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				f.CreatedAt = value.Time
+			}
+		case file.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				f.UpdatedAt = value.Time
+			}
+		default:
+			f.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the File.
+// This includes values selected through modifiers, order, etc.
+func (f *File) Value(name string) (ent.Value, error) {
+	return f.selectValues.Get(name)
+}
+
+// QueryAttachments queries the "attachments" edge of the File entity.
+func (f *File) QueryAttachments() *AttachmentQuery {
+	return NewFileClient(f.config).QueryAttachments(f)
+}
+
+// QueryDerived queries the "derived" edge of the File entity.
+func (f *File) QueryDerived() *FileQuery {
+	return NewFileClient(f.config).QueryDerived(f)
+}
+
+// QueryOriginal queries the "original" edge of the File entity.
+func (f *File) QueryOriginal() *FileQuery {
+	return NewFileClient(f.config).QueryOriginal(f)
+}
+
+// Update returns a builder for updating this File.
+// Note that you need to call File.Unwrap() before calling this method if this File
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (f *File) Update() *FileUpdateOne {
+	return NewFileClient(f.config).UpdateOne(f)
+}
+
+// Unwrap unwraps the File entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (f *File) Unwrap() *File {
+	_tx, ok := f.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: File is not a transactional entity")
+	}
+	f.config.driver = _tx.drv
+	return f
+}
+
+// String implements the fmt.Stringer.
+func (f *File) String() string {
+	var builder strings.Builder
+	builder.WriteString("File(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", f.ID))
+	builder.WriteString("filename=")
+	builder.WriteString(f.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("extension=")
+	builder.WriteString(f.Extension)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(f.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", f.Size))
+	builder.WriteString(", ")
+	builder.WriteString("variant=")
+	builder.WriteString(fmt.Sprintf("%v", f.Variant))
+	builder.WriteString(", ")
+	if v := f.OriginalID; v != nil {
+		builder.WriteString("original_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", f.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", f.Height))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(f.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(f.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Files is a parsable slice of File.
+type Files []*File
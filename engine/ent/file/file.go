@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package file
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+const (
+	// Label holds the string label denoting the file type in the database.
+	Label = "file"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFilename holds the string denoting the filename field in the database.
+	FieldFilename = "filename"
+	// FieldExtension holds the string denoting the extension field in the database.
+	FieldExtension = "extension"
+	// FieldContentType holds the string denoting the content_type field in the database.
+	FieldContentType = "content_type"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldVariant holds the string denoting the variant field in the database.
+	FieldVariant = "variant"
+	// FieldOriginalID holds the string denoting the original_id field in the database.
+	FieldOriginalID = "original_id"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
+	// EdgeDerived holds the string denoting the derived edge name in mutations.
+	EdgeDerived = "derived"
+	// EdgeOriginal holds the string denoting the original edge name in mutations.
+	EdgeOriginal = "original"
+	// Table holds the table name of the file in the database.
+	Table = "file"
+	// AttachmentsTable is the table that holds the attachments relation/edge.
+	AttachmentsTable = "attachment"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachment"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "file_id"
+	// DerivedTable is the table that holds the derived relation/edge.
+	DerivedTable = "file"
+	// DerivedColumn is the table column denoting the derived relation/edge.
+	DerivedColumn = "original_id"
+	// OriginalTable is the table that holds the original relation/edge.
+	OriginalTable = "file"
+	// OriginalColumn is the table column denoting the original relation/edge.
+	OriginalColumn = "original_id"
+)
+
+// Columns holds all SQL columns for file fields.
+var Columns = []string{
+	FieldID,
+	FieldFilename,
+	FieldExtension,
+	FieldContentType,
+	FieldSize,
+	FieldVariant,
+	FieldOriginalID,
+	FieldWidth,
+	FieldHeight,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	FilenameValidator func(string) error
+	// ExtensionValidator is a validator for the "extension" field. It is called by the builders before save.
+	ExtensionValidator func(string) error
+	// ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	ContentTypeValidator func(string) error
+	// SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	SizeValidator func(int64) error
+	// DefaultWidth holds the default value on creation for the "width" field.
+	DefaultWidth int
+	// WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	WidthValidator func(int) error
+	// DefaultHeight holds the default value on creation for the "height" field.
+	DefaultHeight int
+	// HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	HeightValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Variant defines the type for the "variant" enum field.
+type Variant string
+
+// VariantOriginal is the default value of the Variant enum.
+const DefaultVariant = VariantOriginal
+
+// Variant values.
+const (
+	VariantOriginal Variant = "original"
+	VariantList     Variant = "list"
+	VariantDetail   Variant = "detail"
+)
+
+func (v Variant) String() string {
+	return string(v)
+}
+
+// VariantValidator is a validator for the "variant" field enum values. It is called by the builders before save.
+func VariantValidator(v Variant) error {
+	switch v {
+	case VariantOriginal, VariantList, VariantDetail:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for variant field: %q", v)
+	}
+}
+
+// OrderOption defines the ordering options for the File queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFilename orders the results by the filename field.
+func ByFilename(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilename, opts...).ToFunc()
+}
+
+// ByExtension orders the results by the extension field.
+func ByExtension(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExtension, opts...).ToFunc()
+}
+
+// ByContentType orders the results by the content_type field.
+func ByContentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentType, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByVariant orders the results by the variant field.
+func ByVariant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariant, opts...).ToFunc()
+}
+
+// ByOriginalID orders the results by the original_id field.
+func ByOriginalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalID, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByAttachmentsCount orders the results by attachments count.
+func ByAttachmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttachmentsStep(), opts...)
+	}
+}
+
+// ByAttachments orders the results by attachments terms.
+func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttachmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDerivedCount orders the results by derived count.
+func ByDerivedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDerivedStep(), opts...)
+	}
+}
+
+// ByDerived orders the results by derived terms.
+func ByDerived(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDerivedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOriginalField orders the results by original field.
+func ByOriginalField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOriginalStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttachmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttachmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, AttachmentsTable, AttachmentsColumn),
+	)
+}
+func newDerivedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, DerivedTable, DerivedColumn),
+	)
+}
+func newOriginalStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, OriginalTable, OriginalColumn),
+	)
+}
@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/attachment"
+	"fixit/engine/ent/file"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// FileCreate is the builder for creating a File entity.
+type FileCreate struct {
+	config
+	mutation *FileMutation
+	hooks    []Hook
+}
+
+// SetFilename sets the "filename" field.
+func (fc *FileCreate) SetFilename(s string) *FileCreate {
+	fc.mutation.SetFilename(s)
+	return fc
+}
+
+// SetExtension sets the "extension" field.
+func (fc *FileCreate) SetExtension(s string) *FileCreate {
+	fc.mutation.SetExtension(s)
+	return fc
+}
+
+// SetContentType sets the "content_type" field.
+func (fc *FileCreate) SetContentType(s string) *FileCreate {
+	fc.mutation.SetContentType(s)
+	return fc
+}
+
+// SetSize sets the "size" field.
+func (fc *FileCreate) SetSize(i int64) *FileCreate {
+	fc.mutation.SetSize(i)
+	return fc
+}
+
+// SetVariant sets the "variant" field.
+func (fc *FileCreate) SetVariant(f file.Variant) *FileCreate {
+	fc.mutation.SetVariant(f)
+	return fc
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (fc *FileCreate) SetNillableVariant(f *file.Variant) *FileCreate {
+	if f != nil {
+		fc.SetVariant(*f)
+	}
+	return fc
+}
+
+// SetOriginalID sets the "original_id" field.
+func (fc *FileCreate) SetOriginalID(u uuid.UUID) *FileCreate {
+	fc.mutation.SetOriginalID(u)
+	return fc
+}
+
+// SetNillableOriginalID sets the "original_id" field if the given value is not nil.
+func (fc *FileCreate) SetNillableOriginalID(u *uuid.UUID) *FileCreate {
+	if u != nil {
+		fc.SetOriginalID(*u)
+	}
+	return fc
+}
+
+// SetWidth sets the "width" field.
+func (fc *FileCreate) SetWidth(i int) *FileCreate {
+	fc.mutation.SetWidth(i)
+	return fc
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fc *FileCreate) SetNillableWidth(i *int) *FileCreate {
+	if i != nil {
+		fc.SetWidth(*i)
+	}
+	return fc
+}
+
+// SetHeight sets the "height" field.
+func (fc *FileCreate) SetHeight(i int) *FileCreate {
+	fc.mutation.SetHeight(i)
+	return fc
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fc *FileCreate) SetNillableHeight(i *int) *FileCreate {
+	if i != nil {
+		fc.SetHeight(*i)
+	}
+	return fc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fc *FileCreate) SetCreatedAt(t time.Time) *FileCreate {
+	fc.mutation.SetCreatedAt(t)
+	return fc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fc *FileCreate) SetNillableCreatedAt(t *time.Time) *FileCreate {
+	if t != nil {
+		fc.SetCreatedAt(*t)
+	}
+	return fc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fc *FileCreate) SetUpdatedAt(t time.Time) *FileCreate {
+	fc.mutation.SetUpdatedAt(t)
+	return fc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (fc *FileCreate) SetNillableUpdatedAt(t *time.Time) *FileCreate {
+	if t != nil {
+		fc.SetUpdatedAt(*t)
+	}
+	return fc
+}
+
+// SetID sets the "id" field.
+func (fc *FileCreate) SetID(u uuid.UUID) *FileCreate {
+	fc.mutation.SetID(u)
+	return fc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (fc *FileCreate) SetNillableID(u *uuid.UUID) *FileCreate {
+	if u != nil {
+		fc.SetID(*u)
+	}
+	return fc
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (fc *FileCreate) AddAttachmentIDs(ids ...uuid.UUID) *FileCreate {
+	fc.mutation.AddAttachmentIDs(ids...)
+	return fc
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (fc *FileCreate) AddAttachments(a ...*Attachment) *FileCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return fc.AddAttachmentIDs(ids...)
+}
+
+// AddDerivedIDs adds the "derived" edge to the File entity by IDs.
+func (fc *FileCreate) AddDerivedIDs(ids ...uuid.UUID) *FileCreate {
+	fc.mutation.AddDerivedIDs(ids...)
+	return fc
+}
+
+// AddDerived adds the "derived" edges to the File entity.
+func (fc *FileCreate) AddDerived(f ...*File) *FileCreate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fc.AddDerivedIDs(ids...)
+}
+
+// SetOriginal sets the "original" edge to the File entity.
+func (fc *FileCreate) SetOriginal(f *File) *FileCreate {
+	return fc.SetOriginalID(f.ID)
+}
+
+// Mutation returns the FileMutation object of the builder.
+func (fc *FileCreate) Mutation() *FileMutation {
+	return fc.mutation
+}
+
+// Save creates the File in the database.
+func (fc *FileCreate) Save(ctx context.Context) (*File, error) {
+	fc.defaults()
+	return withHooks(ctx, fc.sqlSave, fc.mutation, fc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fc *FileCreate) SaveX(ctx context.Context) *File {
+	v, err := fc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fc *FileCreate) Exec(ctx context.Context) error {
+	_, err := fc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fc *FileCreate) ExecX(ctx context.Context) {
+	if err := fc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fc *FileCreate) defaults() {
+	if _, ok := fc.mutation.Variant(); !ok {
+		v := file.DefaultVariant
+		fc.mutation.SetVariant(v)
+	}
+	if _, ok := fc.mutation.Width(); !ok {
+		v := file.DefaultWidth
+		fc.mutation.SetWidth(v)
+	}
+	if _, ok := fc.mutation.Height(); !ok {
+		v := file.DefaultHeight
+		fc.mutation.SetHeight(v)
+	}
+	if _, ok := fc.mutation.CreatedAt(); !ok {
+		v := file.DefaultCreatedAt()
+		fc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fc.mutation.UpdatedAt(); !ok {
+		v := file.DefaultUpdatedAt()
+		fc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := fc.mutation.ID(); !ok {
+		v := file.DefaultID()
+		fc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fc *FileCreate) check() error {
+	if _, ok := fc.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "File.filename"`)}
+	}
+	if v, ok := fc.mutation.Filename(); ok {
+		if err := file.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "File.filename": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.Extension(); !ok {
+		return &ValidationError{Name: "extension", err: errors.New(`ent: missing required field "File.extension"`)}
+	}
+	if v, ok := fc.mutation.Extension(); ok {
+		if err := file.ExtensionValidator(v); err != nil {
+			return &ValidationError{Name: "extension", err: fmt.Errorf(`ent: validator failed for field "File.extension": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "File.content_type"`)}
+	}
+	if v, ok := fc.mutation.ContentType(); ok {
+		if err := file.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "File.content_type": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "File.size"`)}
+	}
+	if v, ok := fc.mutation.Size(); ok {
+		if err := file.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.Variant(); !ok {
+		return &ValidationError{Name: "variant", err: errors.New(`ent: missing required field "File.variant"`)}
+	}
+	if v, ok := fc.mutation.Variant(); ok {
+		if err := file.VariantValidator(v); err != nil {
+			return &ValidationError{Name: "variant", err: fmt.Errorf(`ent: validator failed for field "File.variant": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "File.width"`)}
+	}
+	if v, ok := fc.mutation.Width(); ok {
+		if err := file.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "File.width": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "File.height"`)}
+	}
+	if v, ok := fc.mutation.Height(); ok {
+		if err := file.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "File.height": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "File.created_at"`)}
+	}
+	if _, ok := fc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "File.updated_at"`)}
+	}
+	return nil
+}
+
+func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
+	if err := fc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	fc.mutation.id = &_node.ID
+	fc.mutation.done = true
+	return _node, nil
+}
+
+func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
+	var (
+		_node = &File{config: fc.config}
+		_spec = sqlgraph.NewCreateSpec(file.Table, sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID))
+	)
+	if id, ok := fc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := fc.mutation.Filename(); ok {
+		_spec.SetField(file.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := fc.mutation.Extension(); ok {
+		_spec.SetField(file.FieldExtension, field.TypeString, value)
+		_node.Extension = value
+	}
+	if value, ok := fc.mutation.ContentType(); ok {
+		_spec.SetField(file.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := fc.mutation.Size(); ok {
+		_spec.SetField(file.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := fc.mutation.Variant(); ok {
+		_spec.SetField(file.FieldVariant, field.TypeEnum, value)
+		_node.Variant = value
+	}
+	if value, ok := fc.mutation.Width(); ok {
+		_spec.SetField(file.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := fc.mutation.Height(); ok {
+		_spec.SetField(file.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := fc.mutation.CreatedAt(); ok {
+		_spec.SetField(file.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := fc.mutation.UpdatedAt(); ok {
+		_spec.SetField(file.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := fc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.AttachmentsTable,
+			Columns: []string{file.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.DerivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.DerivedTable,
+			Columns: []string{file.DerivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.OriginalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   file.OriginalTable,
+			Columns: []string{file.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OriginalID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FileCreateBulk is the builder for creating many File entities in bulk.
+type FileCreateBulk struct {
+	config
+	err      error
+	builders []*FileCreate
+}
+
+// Save creates the File entities in the database.
+func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
+	if fcb.err != nil {
+		return nil, fcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
+	nodes := make([]*File, len(fcb.builders))
+	mutators := make([]Mutator, len(fcb.builders))
+	for i := range fcb.builders {
+		func(i int, root context.Context) {
+			builder := fcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcb *FileCreateBulk) SaveX(ctx context.Context) []*File {
+	v, err := fcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fcb *FileCreateBulk) Exec(ctx context.Context) error {
+	_, err := fcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcb *FileCreateBulk) ExecX(ctx context.Context) {
+	if err := fcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fixit/engine/ent/file"
+	"fixit/engine/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FileDelete is the builder for deleting a File entity.
+type FileDelete struct {
+	config
+	hooks    []Hook
+	mutation *FileMutation
+}
+
+// Where appends a list predicates to the FileDelete builder.
+func (fd *FileDelete) Where(ps ...predicate.File) *FileDelete {
+	fd.mutation.Where(ps...)
+	return fd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fd *FileDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fd.sqlExec, fd.mutation, fd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fd *FileDelete) ExecX(ctx context.Context) int {
+	n, err := fd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fd *FileDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(file.Table, sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID))
+	if ps := fd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fd.mutation.done = true
+	return affected, err
+}
+
+// FileDeleteOne is the builder for deleting a single File entity.
+type FileDeleteOne struct {
+	fd *FileDelete
+}
+
+// Where appends a list predicates to the FileDelete builder.
+func (fdo *FileDeleteOne) Where(ps ...predicate.File) *FileDeleteOne {
+	fdo.fd.mutation.Where(ps...)
+	return fdo
+}
+
+// Exec executes the deletion query.
+func (fdo *FileDeleteOne) Exec(ctx context.Context) error {
+	n, err := fdo.fd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{file.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fdo *FileDeleteOne) ExecX(ctx context.Context) {
+	if err := fdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1058 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/attachment"
+	"fixit/engine/ent/file"
+	"fixit/engine/ent/predicate"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// FileUpdate is the builder for updating File entities.
+type FileUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FileUpdate builder.
+func (fu *FileUpdate) Where(ps ...predicate.File) *FileUpdate {
+	fu.mutation.Where(ps...)
+	return fu
+}
+
+// SetFilename sets the "filename" field.
+func (fu *FileUpdate) SetFilename(s string) *FileUpdate {
+	fu.mutation.SetFilename(s)
+	return fu
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableFilename(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetFilename(*s)
+	}
+	return fu
+}
+
+// SetExtension sets the "extension" field.
+func (fu *FileUpdate) SetExtension(s string) *FileUpdate {
+	fu.mutation.SetExtension(s)
+	return fu
+}
+
+// SetNillableExtension sets the "extension" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableExtension(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetExtension(*s)
+	}
+	return fu
+}
+
+// SetContentType sets the "content_type" field.
+func (fu *FileUpdate) SetContentType(s string) *FileUpdate {
+	fu.mutation.SetContentType(s)
+	return fu
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableContentType(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetContentType(*s)
+	}
+	return fu
+}
+
+// SetSize sets the "size" field.
+func (fu *FileUpdate) SetSize(i int64) *FileUpdate {
+	fu.mutation.ResetSize()
+	fu.mutation.SetSize(i)
+	return fu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableSize(i *int64) *FileUpdate {
+	if i != nil {
+		fu.SetSize(*i)
+	}
+	return fu
+}
+
+// AddSize adds i to the "size" field.
+func (fu *FileUpdate) AddSize(i int64) *FileUpdate {
+	fu.mutation.AddSize(i)
+	return fu
+}
+
+// SetVariant sets the "variant" field.
+func (fu *FileUpdate) SetVariant(f file.Variant) *FileUpdate {
+	fu.mutation.SetVariant(f)
+	return fu
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableVariant(f *file.Variant) *FileUpdate {
+	if f != nil {
+		fu.SetVariant(*f)
+	}
+	return fu
+}
+
+// SetOriginalID sets the "original_id" field.
+func (fu *FileUpdate) SetOriginalID(u uuid.UUID) *FileUpdate {
+	fu.mutation.SetOriginalID(u)
+	return fu
+}
+
+// SetNillableOriginalID sets the "original_id" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableOriginalID(u *uuid.UUID) *FileUpdate {
+	if u != nil {
+		fu.SetOriginalID(*u)
+	}
+	return fu
+}
+
+// ClearOriginalID clears the value of the "original_id" field.
+func (fu *FileUpdate) ClearOriginalID() *FileUpdate {
+	fu.mutation.ClearOriginalID()
+	return fu
+}
+
+// SetWidth sets the "width" field.
+func (fu *FileUpdate) SetWidth(i int) *FileUpdate {
+	fu.mutation.ResetWidth()
+	fu.mutation.SetWidth(i)
+	return fu
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableWidth(i *int) *FileUpdate {
+	if i != nil {
+		fu.SetWidth(*i)
+	}
+	return fu
+}
+
+// AddWidth adds i to the "width" field.
+func (fu *FileUpdate) AddWidth(i int) *FileUpdate {
+	fu.mutation.AddWidth(i)
+	return fu
+}
+
+// SetHeight sets the "height" field.
+func (fu *FileUpdate) SetHeight(i int) *FileUpdate {
+	fu.mutation.ResetHeight()
+	fu.mutation.SetHeight(i)
+	return fu
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableHeight(i *int) *FileUpdate {
+	if i != nil {
+		fu.SetHeight(*i)
+	}
+	return fu
+}
+
+// AddHeight adds i to the "height" field.
+func (fu *FileUpdate) AddHeight(i int) *FileUpdate {
+	fu.mutation.AddHeight(i)
+	return fu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fu *FileUpdate) SetUpdatedAt(t time.Time) *FileUpdate {
+	fu.mutation.SetUpdatedAt(t)
+	return fu
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (fu *FileUpdate) AddAttachmentIDs(ids ...uuid.UUID) *FileUpdate {
+	fu.mutation.AddAttachmentIDs(ids...)
+	return fu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (fu *FileUpdate) AddAttachments(a ...*Attachment) *FileUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return fu.AddAttachmentIDs(ids...)
+}
+
+// AddDerivedIDs adds the "derived" edge to the File entity by IDs.
+func (fu *FileUpdate) AddDerivedIDs(ids ...uuid.UUID) *FileUpdate {
+	fu.mutation.AddDerivedIDs(ids...)
+	return fu
+}
+
+// AddDerived adds the "derived" edges to the File entity.
+func (fu *FileUpdate) AddDerived(f ...*File) *FileUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.AddDerivedIDs(ids...)
+}
+
+// SetOriginal sets the "original" edge to the File entity.
+func (fu *FileUpdate) SetOriginal(f *File) *FileUpdate {
+	return fu.SetOriginalID(f.ID)
+}
+
+// Mutation returns the FileMutation object of the builder.
+func (fu *FileUpdate) Mutation() *FileMutation {
+	return fu.mutation
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (fu *FileUpdate) ClearAttachments() *FileUpdate {
+	fu.mutation.ClearAttachments()
+	return fu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (fu *FileUpdate) RemoveAttachmentIDs(ids ...uuid.UUID) *FileUpdate {
+	fu.mutation.RemoveAttachmentIDs(ids...)
+	return fu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (fu *FileUpdate) RemoveAttachments(a ...*Attachment) *FileUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return fu.RemoveAttachmentIDs(ids...)
+}
+
+// ClearDerived clears all "derived" edges to the File entity.
+func (fu *FileUpdate) ClearDerived() *FileUpdate {
+	fu.mutation.ClearDerived()
+	return fu
+}
+
+// RemoveDerivedIDs removes the "derived" edge to File entities by IDs.
+func (fu *FileUpdate) RemoveDerivedIDs(ids ...uuid.UUID) *FileUpdate {
+	fu.mutation.RemoveDerivedIDs(ids...)
+	return fu
+}
+
+// RemoveDerived removes "derived" edges to File entities.
+func (fu *FileUpdate) RemoveDerived(f ...*File) *FileUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.RemoveDerivedIDs(ids...)
+}
+
+// ClearOriginal clears the "original" edge to the File entity.
+func (fu *FileUpdate) ClearOriginal() *FileUpdate {
+	fu.mutation.ClearOriginal()
+	return fu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
+	fu.defaults()
+	return withHooks(ctx, fu.sqlSave, fu.mutation, fu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fu *FileUpdate) SaveX(ctx context.Context) int {
+	affected, err := fu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fu *FileUpdate) Exec(ctx context.Context) error {
+	_, err := fu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fu *FileUpdate) ExecX(ctx context.Context) {
+	if err := fu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fu *FileUpdate) defaults() {
+	if _, ok := fu.mutation.UpdatedAt(); !ok {
+		v := file.UpdateDefaultUpdatedAt()
+		fu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fu *FileUpdate) check() error {
+	if v, ok := fu.mutation.Filename(); ok {
+		if err := file.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "File.filename": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.Extension(); ok {
+		if err := file.ExtensionValidator(v); err != nil {
+			return &ValidationError{Name: "extension", err: fmt.Errorf(`ent: validator failed for field "File.extension": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.ContentType(); ok {
+		if err := file.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "File.content_type": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.Size(); ok {
+		if err := file.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.Variant(); ok {
+		if err := file.VariantValidator(v); err != nil {
+			return &ValidationError{Name: "variant", err: fmt.Errorf(`ent: validator failed for field "File.variant": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.Width(); ok {
+		if err := file.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "File.width": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.Height(); ok {
+		if err := file.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "File.height": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fu *FileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileUpdate {
+	fu.modifiers = append(fu.modifiers, modifiers...)
+	return fu
+}
+
+func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(file.Table, file.Columns, sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID))
+	if ps := fu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fu.mutation.Filename(); ok {
+		_spec.SetField(file.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.Extension(); ok {
+		_spec.SetField(file.FieldExtension, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.ContentType(); ok {
+		_spec.SetField(file.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.Size(); ok {
+		_spec.SetField(file.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := fu.mutation.AddedSize(); ok {
+		_spec.AddField(file.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := fu.mutation.Variant(); ok {
+		_spec.SetField(file.FieldVariant, field.TypeEnum, value)
+	}
+	if value, ok := fu.mutation.Width(); ok {
+		_spec.SetField(file.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedWidth(); ok {
+		_spec.AddField(file.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.Height(); ok {
+		_spec.SetField(file.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedHeight(); ok {
+		_spec.AddField(file.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.UpdatedAt(); ok {
+		_spec.SetField(file.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if fu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.AttachmentsTable,
+			Columns: []string{file.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !fu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.AttachmentsTable,
+			Columns: []string{file.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.AttachmentsTable,
+			Columns: []string{file.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.DerivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.DerivedTable,
+			Columns: []string{file.DerivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.RemovedDerivedIDs(); len(nodes) > 0 && !fu.mutation.DerivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.DerivedTable,
+			Columns: []string{file.DerivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.DerivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.DerivedTable,
+			Columns: []string{file.DerivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.OriginalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   file.OriginalTable,
+			Columns: []string{file.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.OriginalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   file.OriginalTable,
+			Columns: []string{file.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(fu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{file.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fu.mutation.done = true
+	return n, nil
+}
+
+// FileUpdateOne is the builder for updating a single File entity.
+type FileUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetFilename sets the "filename" field.
+func (fuo *FileUpdateOne) SetFilename(s string) *FileUpdateOne {
+	fuo.mutation.SetFilename(s)
+	return fuo
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableFilename(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetFilename(*s)
+	}
+	return fuo
+}
+
+// SetExtension sets the "extension" field.
+func (fuo *FileUpdateOne) SetExtension(s string) *FileUpdateOne {
+	fuo.mutation.SetExtension(s)
+	return fuo
+}
+
+// SetNillableExtension sets the "extension" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableExtension(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetExtension(*s)
+	}
+	return fuo
+}
+
+// SetContentType sets the "content_type" field.
+func (fuo *FileUpdateOne) SetContentType(s string) *FileUpdateOne {
+	fuo.mutation.SetContentType(s)
+	return fuo
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableContentType(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetContentType(*s)
+	}
+	return fuo
+}
+
+// SetSize sets the "size" field.
+func (fuo *FileUpdateOne) SetSize(i int64) *FileUpdateOne {
+	fuo.mutation.ResetSize()
+	fuo.mutation.SetSize(i)
+	return fuo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableSize(i *int64) *FileUpdateOne {
+	if i != nil {
+		fuo.SetSize(*i)
+	}
+	return fuo
+}
+
+// AddSize adds i to the "size" field.
+func (fuo *FileUpdateOne) AddSize(i int64) *FileUpdateOne {
+	fuo.mutation.AddSize(i)
+	return fuo
+}
+
+// SetVariant sets the "variant" field.
+func (fuo *FileUpdateOne) SetVariant(f file.Variant) *FileUpdateOne {
+	fuo.mutation.SetVariant(f)
+	return fuo
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableVariant(f *file.Variant) *FileUpdateOne {
+	if f != nil {
+		fuo.SetVariant(*f)
+	}
+	return fuo
+}
+
+// SetOriginalID sets the "original_id" field.
+func (fuo *FileUpdateOne) SetOriginalID(u uuid.UUID) *FileUpdateOne {
+	fuo.mutation.SetOriginalID(u)
+	return fuo
+}
+
+// SetNillableOriginalID sets the "original_id" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableOriginalID(u *uuid.UUID) *FileUpdateOne {
+	if u != nil {
+		fuo.SetOriginalID(*u)
+	}
+	return fuo
+}
+
+// ClearOriginalID clears the value of the "original_id" field.
+func (fuo *FileUpdateOne) ClearOriginalID() *FileUpdateOne {
+	fuo.mutation.ClearOriginalID()
+	return fuo
+}
+
+// SetWidth sets the "width" field.
+func (fuo *FileUpdateOne) SetWidth(i int) *FileUpdateOne {
+	fuo.mutation.ResetWidth()
+	fuo.mutation.SetWidth(i)
+	return fuo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableWidth(i *int) *FileUpdateOne {
+	if i != nil {
+		fuo.SetWidth(*i)
+	}
+	return fuo
+}
+
+// AddWidth adds i to the "width" field.
+func (fuo *FileUpdateOne) AddWidth(i int) *FileUpdateOne {
+	fuo.mutation.AddWidth(i)
+	return fuo
+}
+
+// SetHeight sets the "height" field.
+func (fuo *FileUpdateOne) SetHeight(i int) *FileUpdateOne {
+	fuo.mutation.ResetHeight()
+	fuo.mutation.SetHeight(i)
+	return fuo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableHeight(i *int) *FileUpdateOne {
+	if i != nil {
+		fuo.SetHeight(*i)
+	}
+	return fuo
+}
+
+// AddHeight adds i to the "height" field.
+func (fuo *FileUpdateOne) AddHeight(i int) *FileUpdateOne {
+	fuo.mutation.AddHeight(i)
+	return fuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fuo *FileUpdateOne) SetUpdatedAt(t time.Time) *FileUpdateOne {
+	fuo.mutation.SetUpdatedAt(t)
+	return fuo
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (fuo *FileUpdateOne) AddAttachmentIDs(ids ...uuid.UUID) *FileUpdateOne {
+	fuo.mutation.AddAttachmentIDs(ids...)
+	return fuo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (fuo *FileUpdateOne) AddAttachments(a ...*Attachment) *FileUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return fuo.AddAttachmentIDs(ids...)
+}
+
+// AddDerivedIDs adds the "derived" edge to the File entity by IDs.
+func (fuo *FileUpdateOne) AddDerivedIDs(ids ...uuid.UUID) *FileUpdateOne {
+	fuo.mutation.AddDerivedIDs(ids...)
+	return fuo
+}
+
+// AddDerived adds the "derived" edges to the File entity.
+func (fuo *FileUpdateOne) AddDerived(f ...*File) *FileUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.AddDerivedIDs(ids...)
+}
+
+// SetOriginal sets the "original" edge to the File entity.
+func (fuo *FileUpdateOne) SetOriginal(f *File) *FileUpdateOne {
+	return fuo.SetOriginalID(f.ID)
+}
+
+// Mutation returns the FileMutation object of the builder.
+func (fuo *FileUpdateOne) Mutation() *FileMutation {
+	return fuo.mutation
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (fuo *FileUpdateOne) ClearAttachments() *FileUpdateOne {
+	fuo.mutation.ClearAttachments()
+	return fuo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (fuo *FileUpdateOne) RemoveAttachmentIDs(ids ...uuid.UUID) *FileUpdateOne {
+	fuo.mutation.RemoveAttachmentIDs(ids...)
+	return fuo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (fuo *FileUpdateOne) RemoveAttachments(a ...*Attachment) *FileUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return fuo.RemoveAttachmentIDs(ids...)
+}
+
+// ClearDerived clears all "derived" edges to the File entity.
+func (fuo *FileUpdateOne) ClearDerived() *FileUpdateOne {
+	fuo.mutation.ClearDerived()
+	return fuo
+}
+
+// RemoveDerivedIDs removes the "derived" edge to File entities by IDs.
+func (fuo *FileUpdateOne) RemoveDerivedIDs(ids ...uuid.UUID) *FileUpdateOne {
+	fuo.mutation.RemoveDerivedIDs(ids...)
+	return fuo
+}
+
+// RemoveDerived removes "derived" edges to File entities.
+func (fuo *FileUpdateOne) RemoveDerived(f ...*File) *FileUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.RemoveDerivedIDs(ids...)
+}
+
+// ClearOriginal clears the "original" edge to the File entity.
+func (fuo *FileUpdateOne) ClearOriginal() *FileUpdateOne {
+	fuo.mutation.ClearOriginal()
+	return fuo
+}
+
+// Where appends a list predicates to the FileUpdate builder.
+func (fuo *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
+	fuo.mutation.Where(ps...)
+	return fuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fuo *FileUpdateOne) Select(field string, fields ...string) *FileUpdateOne {
+	fuo.fields = append([]string{field}, fields...)
+	return fuo
+}
+
+// Save executes the query and returns the updated File entity.
+func (fuo *FileUpdateOne) Save(ctx context.Context) (*File, error) {
+	fuo.defaults()
+	return withHooks(ctx, fuo.sqlSave, fuo.mutation, fuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fuo *FileUpdateOne) SaveX(ctx context.Context) *File {
+	node, err := fuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fuo *FileUpdateOne) Exec(ctx context.Context) error {
+	_, err := fuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fuo *FileUpdateOne) ExecX(ctx context.Context) {
+	if err := fuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fuo *FileUpdateOne) defaults() {
+	if _, ok := fuo.mutation.UpdatedAt(); !ok {
+		v := file.UpdateDefaultUpdatedAt()
+		fuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fuo *FileUpdateOne) check() error {
+	if v, ok := fuo.mutation.Filename(); ok {
+		if err := file.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "File.filename": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.Extension(); ok {
+		if err := file.ExtensionValidator(v); err != nil {
+			return &ValidationError{Name: "extension", err: fmt.Errorf(`ent: validator failed for field "File.extension": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.ContentType(); ok {
+		if err := file.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "File.content_type": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.Size(); ok {
+		if err := file.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.Variant(); ok {
+		if err := file.VariantValidator(v); err != nil {
+			return &ValidationError{Name: "variant", err: fmt.Errorf(`ent: validator failed for field "File.variant": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.Width(); ok {
+		if err := file.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "File.width": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.Height(); ok {
+		if err := file.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "File.height": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fuo *FileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileUpdateOne {
+	fuo.modifiers = append(fuo.modifiers, modifiers...)
+	return fuo
+}
+
+func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
+	if err := fuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(file.Table, file.Columns, sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID))
+	id, ok := fuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "File.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, file.FieldID)
+		for _, f := range fields {
+			if !file.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != file.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fuo.mutation.Filename(); ok {
+		_spec.SetField(file.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.Extension(); ok {
+		_spec.SetField(file.FieldExtension, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.ContentType(); ok {
+		_spec.SetField(file.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.Size(); ok {
+		_spec.SetField(file.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := fuo.mutation.AddedSize(); ok {
+		_spec.AddField(file.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := fuo.mutation.Variant(); ok {
+		_spec.SetField(file.FieldVariant, field.TypeEnum, value)
+	}
+	if value, ok := fuo.mutation.Width(); ok {
+		_spec.SetField(file.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedWidth(); ok {
+		_spec.AddField(file.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.Height(); ok {
+		_spec.SetField(file.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedHeight(); ok {
+		_spec.AddField(file.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(file.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if fuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.AttachmentsTable,
+			Columns: []string{file.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !fuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.AttachmentsTable,
+			Columns: []string{file.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.AttachmentsTable,
+			Columns: []string{file.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.DerivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.DerivedTable,
+			Columns: []string{file.DerivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.RemovedDerivedIDs(); len(nodes) > 0 && !fuo.mutation.DerivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.DerivedTable,
+			Columns: []string{file.DerivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.DerivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   file.DerivedTable,
+			Columns: []string{file.DerivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.OriginalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   file.OriginalTable,
+			Columns: []string{file.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.OriginalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   file.OriginalTable,
+			Columns: []string{file.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(fuo.modifiers...)
+	_node = &File{config: fuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{file.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fuo.mutation.done = true
+	return _node, nil
+}
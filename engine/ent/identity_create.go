@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/identity"
+	"fixit/engine/ent/user"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// IdentityCreate is the builder for creating a Identity entity.
+type IdentityCreate struct {
+	config
+	mutation *IdentityMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ic *IdentityCreate) SetUserID(u uuid.UUID) *IdentityCreate {
+	ic.mutation.SetUserID(u)
+	return ic
+}
+
+// SetProvider sets the "provider" field.
+func (ic *IdentityCreate) SetProvider(s string) *IdentityCreate {
+	ic.mutation.SetProvider(s)
+	return ic
+}
+
+// SetSubject sets the "subject" field.
+func (ic *IdentityCreate) SetSubject(s string) *IdentityCreate {
+	ic.mutation.SetSubject(s)
+	return ic
+}
+
+// SetEmail sets the "email" field.
+func (ic *IdentityCreate) SetEmail(s string) *IdentityCreate {
+	ic.mutation.SetEmail(s)
+	return ic
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (ic *IdentityCreate) SetNillableEmail(s *string) *IdentityCreate {
+	if s != nil {
+		ic.SetEmail(*s)
+	}
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *IdentityCreate) SetCreatedAt(t time.Time) *IdentityCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *IdentityCreate) SetNillableCreatedAt(t *time.Time) *IdentityCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetID sets the "id" field.
+func (ic *IdentityCreate) SetID(u uuid.UUID) *IdentityCreate {
+	ic.mutation.SetID(u)
+	return ic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ic *IdentityCreate) SetNillableID(u *uuid.UUID) *IdentityCreate {
+	if u != nil {
+		ic.SetID(*u)
+	}
+	return ic
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ic *IdentityCreate) SetUser(u *User) *IdentityCreate {
+	return ic.SetUserID(u.ID)
+}
+
+// Mutation returns the IdentityMutation object of the builder.
+func (ic *IdentityCreate) Mutation() *IdentityMutation {
+	return ic.mutation
+}
+
+// Save creates the Identity in the database.
+func (ic *IdentityCreate) Save(ctx context.Context) (*Identity, error) {
+	ic.defaults()
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *IdentityCreate) SaveX(ctx context.Context) *Identity {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *IdentityCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *IdentityCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *IdentityCreate) defaults() {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		v := identity.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.ID(); !ok {
+		v := identity.DefaultID()
+		ic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *IdentityCreate) check() error {
+	if _, ok := ic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Identity.user_id"`)}
+	}
+	if _, ok := ic.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "Identity.provider"`)}
+	}
+	if v, ok := ic.mutation.Provider(); ok {
+		if err := identity.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Identity.provider": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "Identity.subject"`)}
+	}
+	if v, ok := ic.mutation.Subject(); ok {
+		if err := identity.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "Identity.subject": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Identity.created_at"`)}
+	}
+	if len(ic.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Identity.user"`)}
+	}
+	return nil
+}
+
+func (ic *IdentityCreate) sqlSave(ctx context.Context) (*Identity, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *IdentityCreate) createSpec() (*Identity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Identity{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(identity.Table, sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUUID))
+	)
+	if id, ok := ic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ic.mutation.Provider(); ok {
+		_spec.SetField(identity.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := ic.mutation.Subject(); ok {
+		_spec.SetField(identity.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := ic.mutation.Email(); ok {
+		_spec.SetField(identity.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(identity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identity.UserTable,
+			Columns: []string{identity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IdentityCreateBulk is the builder for creating many Identity entities in bulk.
+type IdentityCreateBulk struct {
+	config
+	err      error
+	builders []*IdentityCreate
+}
+
+// Save creates the Identity entities in the database.
+func (icb *IdentityCreateBulk) Save(ctx context.Context) ([]*Identity, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Identity, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdentityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *IdentityCreateBulk) SaveX(ctx context.Context) []*Identity {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *IdentityCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *IdentityCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
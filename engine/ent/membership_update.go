@@ -0,0 +1,487 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/community"
+	"fixit/engine/ent/membership"
+	"fixit/engine/ent/predicate"
+	"fixit/engine/ent/user"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// MembershipUpdate is the builder for updating Membership entities.
+type MembershipUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MembershipMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MembershipUpdate builder.
+func (mu *MembershipUpdate) Where(ps ...predicate.Membership) *MembershipUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetRole sets the "role" field.
+func (mu *MembershipUpdate) SetRole(m membership.Role) *MembershipUpdate {
+	mu.mutation.SetRole(m)
+	return mu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (mu *MembershipUpdate) SetNillableRole(m *membership.Role) *MembershipUpdate {
+	if m != nil {
+		mu.SetRole(*m)
+	}
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MembershipUpdate) SetUpdatedAt(t time.Time) *MembershipUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// SetCommunityID sets the "community" edge to the Community entity by ID.
+func (mu *MembershipUpdate) SetCommunityID(id uuid.UUID) *MembershipUpdate {
+	mu.mutation.SetCommunityID(id)
+	return mu
+}
+
+// SetCommunity sets the "community" edge to the Community entity.
+func (mu *MembershipUpdate) SetCommunity(c *Community) *MembershipUpdate {
+	return mu.SetCommunityID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (mu *MembershipUpdate) SetUserID(id uuid.UUID) *MembershipUpdate {
+	mu.mutation.SetUserID(id)
+	return mu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mu *MembershipUpdate) SetUser(u *User) *MembershipUpdate {
+	return mu.SetUserID(u.ID)
+}
+
+// Mutation returns the MembershipMutation object of the builder.
+func (mu *MembershipUpdate) Mutation() *MembershipMutation {
+	return mu.mutation
+}
+
+// ClearCommunity clears the "community" edge to the Community entity.
+func (mu *MembershipUpdate) ClearCommunity() *MembershipUpdate {
+	mu.mutation.ClearCommunity()
+	return mu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (mu *MembershipUpdate) ClearUser() *MembershipUpdate {
+	mu.mutation.ClearUser()
+	return mu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MembershipUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MembershipUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MembershipUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MembershipUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MembershipUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := membership.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MembershipUpdate) check() error {
+	if v, ok := mu.mutation.Role(); ok {
+		if err := membership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Membership.role": %w`, err)}
+		}
+	}
+	if mu.mutation.CommunityCleared() && len(mu.mutation.CommunityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Membership.community"`)
+	}
+	if mu.mutation.UserCleared() && len(mu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Membership.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mu *MembershipUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MembershipUpdate {
+	mu.modifiers = append(mu.modifiers, modifiers...)
+	return mu
+}
+
+func (mu *MembershipUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(membership.Table, membership.Columns, sqlgraph.NewFieldSpec(membership.FieldID, field.TypeUUID))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.Role(); ok {
+		_spec.SetField(membership.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.SetField(membership.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if mu.mutation.CommunityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membership.CommunityTable,
+			Columns: []string{membership.CommunityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(community.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.CommunityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membership.CommunityTable,
+			Columns: []string{membership.CommunityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(community.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membership.UserTable,
+			Columns: []string{membership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membership.UserTable,
+			Columns: []string{membership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MembershipUpdateOne is the builder for updating a single Membership entity.
+type MembershipUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MembershipMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRole sets the "role" field.
+func (muo *MembershipUpdateOne) SetRole(m membership.Role) *MembershipUpdateOne {
+	muo.mutation.SetRole(m)
+	return muo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (muo *MembershipUpdateOne) SetNillableRole(m *membership.Role) *MembershipUpdateOne {
+	if m != nil {
+		muo.SetRole(*m)
+	}
+	return muo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MembershipUpdateOne) SetUpdatedAt(t time.Time) *MembershipUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// SetCommunityID sets the "community" edge to the Community entity by ID.
+func (muo *MembershipUpdateOne) SetCommunityID(id uuid.UUID) *MembershipUpdateOne {
+	muo.mutation.SetCommunityID(id)
+	return muo
+}
+
+// SetCommunity sets the "community" edge to the Community entity.
+func (muo *MembershipUpdateOne) SetCommunity(c *Community) *MembershipUpdateOne {
+	return muo.SetCommunityID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (muo *MembershipUpdateOne) SetUserID(id uuid.UUID) *MembershipUpdateOne {
+	muo.mutation.SetUserID(id)
+	return muo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (muo *MembershipUpdateOne) SetUser(u *User) *MembershipUpdateOne {
+	return muo.SetUserID(u.ID)
+}
+
+// Mutation returns the MembershipMutation object of the builder.
+func (muo *MembershipUpdateOne) Mutation() *MembershipMutation {
+	return muo.mutation
+}
+
+// ClearCommunity clears the "community" edge to the Community entity.
+func (muo *MembershipUpdateOne) ClearCommunity() *MembershipUpdateOne {
+	muo.mutation.ClearCommunity()
+	return muo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (muo *MembershipUpdateOne) ClearUser() *MembershipUpdateOne {
+	muo.mutation.ClearUser()
+	return muo
+}
+
+// Where appends a list predicates to the MembershipUpdate builder.
+func (muo *MembershipUpdateOne) Where(ps ...predicate.Membership) *MembershipUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MembershipUpdateOne) Select(field string, fields ...string) *MembershipUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Membership entity.
+func (muo *MembershipUpdateOne) Save(ctx context.Context) (*Membership, error) {
+	muo.defaults()
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MembershipUpdateOne) SaveX(ctx context.Context) *Membership {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MembershipUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MembershipUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MembershipUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := membership.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MembershipUpdateOne) check() error {
+	if v, ok := muo.mutation.Role(); ok {
+		if err := membership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Membership.role": %w`, err)}
+		}
+	}
+	if muo.mutation.CommunityCleared() && len(muo.mutation.CommunityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Membership.community"`)
+	}
+	if muo.mutation.UserCleared() && len(muo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Membership.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (muo *MembershipUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MembershipUpdateOne {
+	muo.modifiers = append(muo.modifiers, modifiers...)
+	return muo
+}
+
+func (muo *MembershipUpdateOne) sqlSave(ctx context.Context) (_node *Membership, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(membership.Table, membership.Columns, sqlgraph.NewFieldSpec(membership.FieldID, field.TypeUUID))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Membership.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, membership.FieldID)
+		for _, f := range fields {
+			if !membership.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != membership.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.Role(); ok {
+		_spec.SetField(membership.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(membership.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if muo.mutation.CommunityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membership.CommunityTable,
+			Columns: []string{membership.CommunityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(community.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.CommunityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membership.CommunityTable,
+			Columns: []string{membership.CommunityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(community.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membership.UserTable,
+			Columns: []string{membership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membership.UserTable,
+			Columns: []string{membership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(muo.modifiers...)
+	_node = &Membership{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
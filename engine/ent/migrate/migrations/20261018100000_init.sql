@@ -0,0 +1,61 @@
+-- Create "community" table
+CREATE TABLE "community" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "title" character varying NOT NULL,
+  "location" character varying NULL,
+  "banner_image_url" character varying NULL,
+  "geography" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create "user" table
+CREATE TABLE "user" (
+  "id" uuid NOT NULL,
+  "username" character varying NOT NULL,
+  "email" character varying NOT NULL,
+  "password" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "user_email_key" to table: "user"
+CREATE UNIQUE INDEX "user_email_key" ON "user" ("email");
+-- Create index "user_username_key" to table: "user"
+CREATE UNIQUE INDEX "user_username_key" ON "user" ("username");
+-- Create "post" table
+CREATE TABLE "post" (
+  "id" uuid NOT NULL,
+  "title" character varying NOT NULL,
+  "body" text NULL,
+  "role" character varying NOT NULL DEFAULT 'issue',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "tags" jsonb NULL,
+  "image_url" character varying NULL,
+  "post_user" uuid NOT NULL,
+  "post_community" uuid NOT NULL,
+  "reply_to" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "post_community_community" FOREIGN KEY ("post_community") REFERENCES "community" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "post_post_parent" FOREIGN KEY ("reply_to") REFERENCES "post" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "post_user_user" FOREIGN KEY ("post_user") REFERENCES "user" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create "vote" table
+CREATE TABLE "vote" (
+  "id" uuid NOT NULL,
+  "kind" character varying NOT NULL,
+  "value" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "vote_post" uuid NOT NULL,
+  "vote_user" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "vote_post_post" FOREIGN KEY ("vote_post") REFERENCES "post" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "vote_user_user" FOREIGN KEY ("vote_user") REFERENCES "user" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "vote_kind" to table: "vote"
+CREATE INDEX "vote_kind" ON "vote" ("kind");
+-- Create index "vote_kind_vote_user" to table: "vote"
+CREATE UNIQUE INDEX "vote_kind_vote_user" ON "vote" ("kind", "vote_user");
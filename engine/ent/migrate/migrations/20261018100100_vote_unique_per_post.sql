@@ -0,0 +1,4 @@
+-- Drop index "vote_kind_vote_user" from table: "vote"
+DROP INDEX "vote_kind_vote_user";
+-- Create index "vote_kind_vote_post_vote_user" to table: "vote"
+CREATE UNIQUE INDEX "vote_kind_vote_post_vote_user" ON "vote" ("kind", "vote_post", "vote_user");
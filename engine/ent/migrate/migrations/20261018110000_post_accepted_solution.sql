@@ -0,0 +1,4 @@
+-- Modify "post" table
+ALTER TABLE "post" ADD COLUMN "accepted_solution_id" uuid NULL, ADD CONSTRAINT "post_post_accepted_solution" FOREIGN KEY ("accepted_solution_id") REFERENCES "post" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "post_accepted_solution_id_key" to table: "post"
+CREATE UNIQUE INDEX "post_accepted_solution_id_key" ON "post" ("accepted_solution_id");
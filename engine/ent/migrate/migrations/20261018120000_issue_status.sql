@@ -0,0 +1,28 @@
+-- Modify "post" table
+ALTER TABLE "post" ADD COLUMN "status" character varying NOT NULL DEFAULT 'open';
+-- Backfill existing issues from their solutions and verifications
+UPDATE "post" AS "issue" SET "status" = 'proposed'
+WHERE "issue"."role" = 'issue'
+  AND EXISTS (SELECT 1 FROM "post" AS "solution" WHERE "solution"."reply_to" = "issue"."id" AND "solution"."role" = 'solution');
+UPDATE "post" AS "issue" SET "status" = 'verified'
+WHERE "issue"."role" = 'issue'
+  AND EXISTS (
+    SELECT 1 FROM "post" AS "solution"
+    JOIN "post" AS "verification" ON "verification"."reply_to" = "solution"."id" AND "verification"."role" = 'verification'
+    WHERE "solution"."reply_to" = "issue"."id" AND "solution"."role" = 'solution'
+  );
+-- Create "status_change" table
+CREATE TABLE "status_change" (
+  "id" uuid NOT NULL,
+  "from_status" character varying NOT NULL,
+  "to_status" character varying NOT NULL,
+  "reason" text NULL,
+  "created_at" timestamptz NOT NULL,
+  "status_change_post" uuid NOT NULL,
+  "status_change_user" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "status_change_post_post" FOREIGN KEY ("status_change_post") REFERENCES "post" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "status_change_user_user" FOREIGN KEY ("status_change_user") REFERENCES "user" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "statuschange_status_change_post" to table: "status_change"
+CREATE INDEX "statuschange_status_change_post" ON "status_change" ("status_change_post");
@@ -0,0 +1,2 @@
+-- Create index "post_post_community" to table: "post"
+CREATE INDEX "post_post_community" ON "post" ("post_community");
@@ -0,0 +1,7 @@
+-- Create index "post_search" to table: "post"
+-- The expression must match search.Document for the planner to use it
+CREATE INDEX "post_search" ON "post" USING GIN ((
+  setweight(to_tsvector('english', coalesce("title", '')), 'A') ||
+  setweight(to_tsvector('english', coalesce("body", '')), 'B') ||
+  setweight(jsonb_to_tsvector('english', coalesce("tags", '[]'::jsonb), '["string"]'), 'C')
+));
@@ -0,0 +1,2 @@
+-- Modify "post" table
+ALTER TABLE "post" ADD COLUMN "geography" character varying NULL, ADD COLUMN "address" character varying NULL;
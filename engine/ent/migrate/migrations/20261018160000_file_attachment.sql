@@ -0,0 +1,27 @@
+-- Create "file" table
+CREATE TABLE "file" (
+  "id" uuid NOT NULL,
+  "filename" character varying NOT NULL,
+  "extension" character varying NOT NULL,
+  "content_type" character varying NOT NULL,
+  "size" bigint NOT NULL,
+  "data" bytea NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create "attachment" table
+CREATE TABLE "attachment" (
+  "id" uuid NOT NULL,
+  "caption" character varying NULL,
+  "position" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "post_id" uuid NOT NULL,
+  "file_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "attachment_file_file" FOREIGN KEY ("file_id") REFERENCES "file" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "attachment_post_post" FOREIGN KEY ("post_id") REFERENCES "post" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "attachment_post_id_position" to table: "attachment"
+CREATE INDEX "attachment_post_id_position" ON "attachment" ("post_id", "position");
@@ -0,0 +1,12 @@
+-- Create "blob" table
+CREATE TABLE "blob" (
+  "id" character varying NOT NULL,
+  "data" bytea NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Move file contents into the blob store, keyed by file ID
+INSERT INTO "blob" ("id", "data", "created_at")
+SELECT "id"::text, "data", "created_at" FROM "file";
+-- Modify "file" table
+ALTER TABLE "file" DROP COLUMN "data";
@@ -0,0 +1,4 @@
+-- Modify "file" table
+ALTER TABLE "file" ADD COLUMN "variant" character varying NOT NULL DEFAULT 'original', ADD COLUMN "width" bigint NOT NULL DEFAULT 0, ADD COLUMN "height" bigint NOT NULL DEFAULT 0, ADD COLUMN "original_id" uuid NULL, ADD CONSTRAINT "file_file_original" FOREIGN KEY ("original_id") REFERENCES "file" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "file_original_id_variant" to table: "file"
+CREATE UNIQUE INDEX "file_original_id_variant" ON "file" ("original_id", "variant");
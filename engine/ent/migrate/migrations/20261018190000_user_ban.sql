@@ -0,0 +1,2 @@
+-- Modify "user" table
+ALTER TABLE "user" ADD COLUMN "banned_at" timestamptz NULL;
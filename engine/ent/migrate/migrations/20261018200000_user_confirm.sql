@@ -0,0 +1,6 @@
+-- Modify "user" table
+ALTER TABLE "user" ADD COLUMN "confirmed" boolean NOT NULL DEFAULT false, ADD COLUMN "confirm_selector" character varying NULL, ADD COLUMN "confirm_verifier" character varying NULL;
+-- Accounts made before confirmation was required stay usable
+UPDATE "user" SET "confirmed" = true;
+-- Create index "user_confirm_selector" to table: "user"
+CREATE INDEX "user_confirm_selector" ON "user" ("confirm_selector");
@@ -0,0 +1,4 @@
+-- Modify "user" table
+ALTER TABLE "user" ADD COLUMN "recover_selector" character varying NULL, ADD COLUMN "recover_verifier" character varying NULL, ADD COLUMN "recover_expiry" timestamptz NULL;
+-- Create index "user_recover_selector" to table: "user"
+CREATE INDEX "user_recover_selector" ON "user" ("recover_selector");
@@ -0,0 +1,32 @@
+-- Modify "user" table
+ALTER TABLE "user" ADD COLUMN "session_version" bigint NOT NULL DEFAULT 0;
+-- Create "remember_token" table
+CREATE TABLE "remember_token" (
+  "id" uuid NOT NULL,
+  "token" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "remember_token_user_user" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "remember_token_token_key" to table: "remember_token"
+CREATE UNIQUE INDEX "remember_token_token_key" ON "remember_token" ("token");
+-- Create index "remembertoken_user_id" to table: "remember_token"
+CREATE INDEX "remembertoken_user_id" ON "remember_token" ("user_id");
+-- Create "session" table
+CREATE TABLE "session" (
+  "id" uuid NOT NULL,
+  "values" jsonb NOT NULL,
+  "user_agent" character varying NULL,
+  "ip" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "last_seen_at" timestamptz NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "user_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "session_user_user" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "session_expires_at" to table: "session"
+CREATE INDEX "session_expires_at" ON "session" ("expires_at");
+-- Create index "session_user_id" to table: "session"
+CREATE INDEX "session_user_id" ON "session" ("user_id");
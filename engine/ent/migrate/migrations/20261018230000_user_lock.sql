@@ -0,0 +1,2 @@
+-- Modify "user" table
+ALTER TABLE "user" ADD COLUMN "attempt_count" bigint NOT NULL DEFAULT 0, ADD COLUMN "last_attempt_at" timestamptz NULL, ADD COLUMN "locked_until" timestamptz NULL;
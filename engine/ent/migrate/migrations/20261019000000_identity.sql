@@ -0,0 +1,15 @@
+-- Create "identity" table
+CREATE TABLE "identity" (
+  "id" uuid NOT NULL,
+  "provider" character varying NOT NULL,
+  "subject" character varying NOT NULL,
+  "email" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "identity_user_user" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "identity_provider_subject" to table: "identity"
+CREATE UNIQUE INDEX "identity_provider_subject" ON "identity" ("provider", "subject");
+-- Create index "identity_user_id" to table: "identity"
+CREATE INDEX "identity_user_id" ON "identity" ("user_id");
@@ -0,0 +1,2 @@
+-- Modify "post" table
+ALTER TABLE "post" ADD COLUMN "body_html" text NULL;
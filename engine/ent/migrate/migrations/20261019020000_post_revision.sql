@@ -0,0 +1,16 @@
+-- Modify "post" table
+ALTER TABLE "post" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create "post_revision" table
+CREATE TABLE "post_revision" (
+  "id" uuid NOT NULL,
+  "title" character varying NOT NULL,
+  "body" text NULL,
+  "created_at" timestamptz NOT NULL,
+  "post_revision_post" uuid NOT NULL,
+  "post_revision_user" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "post_revision_post_post" FOREIGN KEY ("post_revision_post") REFERENCES "post" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "post_revision_user_user" FOREIGN KEY ("post_revision_user") REFERENCES "user" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "postrevision_post_revision_post" to table: "post_revision"
+CREATE INDEX "postrevision_post_revision_post" ON "post_revision" ("post_revision_post");
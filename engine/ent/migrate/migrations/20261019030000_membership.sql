@@ -0,0 +1,14 @@
+-- Create "membership" table
+CREATE TABLE "membership" (
+  "id" uuid NOT NULL,
+  "role" character varying NOT NULL DEFAULT 'member',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "membership_community" uuid NOT NULL,
+  "membership_user" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "membership_community_community" FOREIGN KEY ("membership_community") REFERENCES "community" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "membership_user_user" FOREIGN KEY ("membership_user") REFERENCES "user" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "membership_membership_community_membership_user" to table: "membership"
+CREATE UNIQUE INDEX "membership_membership_community_membership_user" ON "membership" ("membership_community", "membership_user");
@@ -0,0 +1,8 @@
+-- Drop "vote" table
+DROP TABLE "vote";
+-- Drop "post" table
+DROP TABLE "post";
+-- Drop "user" table
+DROP TABLE "user";
+-- Drop "community" table
+DROP TABLE "community";
@@ -0,0 +1,5 @@
+-- Drop index "vote_kind_vote_post_vote_user" from table: "vote"
+DROP INDEX "vote_kind_vote_post_vote_user";
+-- Create index "vote_kind_vote_user" to table: "vote"
+-- Fails if a user has voted the same way on more than one post
+CREATE UNIQUE INDEX "vote_kind_vote_user" ON "vote" ("kind", "vote_user");
@@ -0,0 +1,2 @@
+-- Modify "post" table
+ALTER TABLE "post" DROP COLUMN "accepted_solution_id";
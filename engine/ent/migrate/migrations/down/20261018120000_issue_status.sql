@@ -0,0 +1,4 @@
+-- Drop "status_change" table
+DROP TABLE "status_change";
+-- Modify "post" table
+ALTER TABLE "post" DROP COLUMN "status";
@@ -0,0 +1,2 @@
+-- Drop index "post_post_community" from table: "post"
+DROP INDEX "post_post_community";
@@ -0,0 +1,2 @@
+-- Drop index "post_search" from table: "post"
+DROP INDEX "post_search";
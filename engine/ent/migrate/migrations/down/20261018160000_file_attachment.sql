@@ -0,0 +1,4 @@
+-- Drop "attachment" table
+DROP TABLE "attachment";
+-- Drop "file" table
+DROP TABLE "file";
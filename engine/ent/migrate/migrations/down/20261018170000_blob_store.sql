@@ -0,0 +1,8 @@
+-- Modify "file" table
+ALTER TABLE "file" ADD COLUMN "data" bytea NULL;
+-- Move file contents back out of the blob table. Files kept in another
+-- blob store must be migrated to postgres first.
+UPDATE "file" SET "data" = "blob"."data" FROM "blob" WHERE "blob"."id" = "file"."id"::text;
+ALTER TABLE "file" ALTER COLUMN "data" SET NOT NULL;
+-- Drop "blob" table
+DROP TABLE "blob";
@@ -0,0 +1,6 @@
+-- Remove derived files, their blobs are left behind in the blob store
+DELETE FROM "file" WHERE "original_id" IS NOT NULL;
+-- Drop index "file_original_id_variant" from table: "file"
+DROP INDEX "file_original_id_variant";
+-- Modify "file" table
+ALTER TABLE "file" DROP COLUMN "variant", DROP COLUMN "width", DROP COLUMN "height", DROP COLUMN "original_id";
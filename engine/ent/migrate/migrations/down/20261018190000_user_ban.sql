@@ -0,0 +1,2 @@
+-- Modify "user" table
+ALTER TABLE "user" DROP COLUMN "banned_at";
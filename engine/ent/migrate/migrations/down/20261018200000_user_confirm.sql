@@ -0,0 +1,4 @@
+-- Drop index "user_confirm_selector" from table: "user"
+DROP INDEX "user_confirm_selector";
+-- Modify "user" table
+ALTER TABLE "user" DROP COLUMN "confirmed", DROP COLUMN "confirm_selector", DROP COLUMN "confirm_verifier";
@@ -0,0 +1,4 @@
+-- Drop index "user_recover_selector" from table: "user"
+DROP INDEX "user_recover_selector";
+-- Modify "user" table
+ALTER TABLE "user" DROP COLUMN "recover_selector", DROP COLUMN "recover_verifier", DROP COLUMN "recover_expiry";
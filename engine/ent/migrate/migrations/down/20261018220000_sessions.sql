@@ -0,0 +1,6 @@
+-- Drop "session" table
+DROP TABLE "session";
+-- Drop "remember_token" table
+DROP TABLE "remember_token";
+-- Modify "user" table
+ALTER TABLE "user" DROP COLUMN "session_version";
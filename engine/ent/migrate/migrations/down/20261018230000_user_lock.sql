@@ -0,0 +1,2 @@
+-- Modify "user" table
+ALTER TABLE "user" DROP COLUMN "attempt_count", DROP COLUMN "last_attempt_at", DROP COLUMN "locked_until";
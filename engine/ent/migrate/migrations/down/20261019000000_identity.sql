@@ -0,0 +1,2 @@
+-- Drop "identity" table
+DROP TABLE "identity";
@@ -0,0 +1,4 @@
+-- Drop "post_revision" table
+DROP TABLE "post_revision";
+-- Modify "post" table
+ALTER TABLE "post" DROP COLUMN "deleted_at";
@@ -0,0 +1,2 @@
+-- Drop "membership" table
+DROP TABLE "membership";
@@ -0,0 +1,9 @@
+// Package migrations embeds the versioned SQL migrations, so the binary can
+// apply them without the source tree. Each file in down/ reverts the
+// migration of the same name.
+package migrations
+
+import "embed"
+
+//go:embed *.sql atlas.sum down/*.sql
+var FS embed.FS
@@ -9,6 +9,55 @@ import (
 )
 
 var (
+	// AttachmentColumns holds the columns for the "attachment" table.
+	AttachmentColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "caption", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "post_id", Type: field.TypeUUID},
+		{Name: "file_id", Type: field.TypeUUID},
+	}
+	// AttachmentTable holds the schema information for the "attachment" table.
+	AttachmentTable = &schema.Table{
+		Name:       "attachment",
+		Columns:    AttachmentColumns,
+		PrimaryKey: []*schema.Column{AttachmentColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachment_post_post",
+				Columns:    []*schema.Column{AttachmentColumns[5]},
+				RefColumns: []*schema.Column{PostColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attachment_file_file",
+				Columns:    []*schema.Column{AttachmentColumns[6]},
+				RefColumns: []*schema.Column{FileColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachment_post_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentColumns[5], AttachmentColumns[2]},
+			},
+		},
+	}
+	// BlobColumns holds the columns for the "blob" table.
+	BlobColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 255},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BlobTable holds the schema information for the "blob" table.
+	BlobTable = &schema.Table{
+		Name:       "blob",
+		Columns:    BlobColumns,
+		PrimaryKey: []*schema.Column{BlobColumns[0]},
+	}
 	// CommunityColumns holds the columns for the "community" table.
 	CommunityColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -26,19 +75,131 @@ var (
 		Columns:    CommunityColumns,
 		PrimaryKey: []*schema.Column{CommunityColumns[0]},
 	}
+	// FileColumns holds the columns for the "file" table.
+	FileColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "filename", Type: field.TypeString, Size: 255},
+		{Name: "extension", Type: field.TypeString, Size: 10},
+		{Name: "content_type", Type: field.TypeString, Size: 128},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "variant", Type: field.TypeEnum, Enums: []string{"original", "list", "detail"}, Default: "original"},
+		{Name: "width", Type: field.TypeInt, Default: 0},
+		{Name: "height", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "original_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// FileTable holds the schema information for the "file" table.
+	FileTable = &schema.Table{
+		Name:       "file",
+		Columns:    FileColumns,
+		PrimaryKey: []*schema.Column{FileColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "file_file_original",
+				Columns:    []*schema.Column{FileColumns[10]},
+				RefColumns: []*schema.Column{FileColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "file_original_id_variant",
+				Unique:  true,
+				Columns: []*schema.Column{FileColumns[10], FileColumns[5]},
+			},
+		},
+	}
+	// IdentityColumns holds the columns for the "identity" table.
+	IdentityColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// IdentityTable holds the schema information for the "identity" table.
+	IdentityTable = &schema.Table{
+		Name:       "identity",
+		Columns:    IdentityColumns,
+		PrimaryKey: []*schema.Column{IdentityColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identity_user_user",
+				Columns:    []*schema.Column{IdentityColumns[5]},
+				RefColumns: []*schema.Column{UserColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "identity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{IdentityColumns[1], IdentityColumns[2]},
+			},
+			{
+				Name:    "identity_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{IdentityColumns[5]},
+			},
+		},
+	}
+	// MembershipColumns holds the columns for the "membership" table.
+	MembershipColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "moderator", "member"}, Default: "member"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "membership_community", Type: field.TypeUUID},
+		{Name: "membership_user", Type: field.TypeUUID},
+	}
+	// MembershipTable holds the schema information for the "membership" table.
+	MembershipTable = &schema.Table{
+		Name:       "membership",
+		Columns:    MembershipColumns,
+		PrimaryKey: []*schema.Column{MembershipColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "membership_community_community",
+				Columns:    []*schema.Column{MembershipColumns[4]},
+				RefColumns: []*schema.Column{CommunityColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "membership_user_user",
+				Columns:    []*schema.Column{MembershipColumns[5]},
+				RefColumns: []*schema.Column{UserColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "membership_membership_community_membership_user",
+				Unique:  true,
+				Columns: []*schema.Column{MembershipColumns[4], MembershipColumns[5]},
+			},
+		},
+	}
 	// PostColumns holds the columns for the "post" table.
 	PostColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "title", Type: field.TypeString, Size: 128},
 		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "body_html", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "role", Type: field.TypeEnum, Enums: []string{"issue", "solution", "verification", "chat"}, Default: "issue"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "proposed", "verified", "closed"}, Default: "open"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "image_url", Type: field.TypeString, Nullable: true},
+		{Name: "geography", Type: field.TypeString, Nullable: true},
+		{Name: "address", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "post_user", Type: field.TypeUUID},
 		{Name: "post_community", Type: field.TypeUUID},
 		{Name: "reply_to", Type: field.TypeUUID, Nullable: true},
+		{Name: "accepted_solution_id", Type: field.TypeUUID, Unique: true, Nullable: true},
 	}
 	// PostTable holds the schema information for the "post" table.
 	PostTable = &schema.Table{
@@ -48,22 +209,173 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "post_user_user",
-				Columns:    []*schema.Column{PostColumns[8]},
+				Columns:    []*schema.Column{PostColumns[13]},
 				RefColumns: []*schema.Column{UserColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "post_community_community",
-				Columns:    []*schema.Column{PostColumns[9]},
+				Columns:    []*schema.Column{PostColumns[14]},
 				RefColumns: []*schema.Column{CommunityColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "post_post_parent",
-				Columns:    []*schema.Column{PostColumns[10]},
+				Columns:    []*schema.Column{PostColumns[15]},
 				RefColumns: []*schema.Column{PostColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "post_post_accepted_solution",
+				Columns:    []*schema.Column{PostColumns[16]},
+				RefColumns: []*schema.Column{PostColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "post_post_community",
+				Unique:  false,
+				Columns: []*schema.Column{PostColumns[14]},
+			},
+		},
+	}
+	// PostRevisionColumns holds the columns for the "post_revision" table.
+	PostRevisionColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_revision_post", Type: field.TypeUUID},
+		{Name: "post_revision_user", Type: field.TypeUUID},
+	}
+	// PostRevisionTable holds the schema information for the "post_revision" table.
+	PostRevisionTable = &schema.Table{
+		Name:       "post_revision",
+		Columns:    PostRevisionColumns,
+		PrimaryKey: []*schema.Column{PostRevisionColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_revision_post_post",
+				Columns:    []*schema.Column{PostRevisionColumns[4]},
+				RefColumns: []*schema.Column{PostColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "post_revision_user_user",
+				Columns:    []*schema.Column{PostRevisionColumns[5]},
+				RefColumns: []*schema.Column{UserColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postrevision_post_revision_post",
+				Unique:  false,
+				Columns: []*schema.Column{PostRevisionColumns[4]},
+			},
+		},
+	}
+	// RememberTokenColumns holds the columns for the "remember_token" table.
+	RememberTokenColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// RememberTokenTable holds the schema information for the "remember_token" table.
+	RememberTokenTable = &schema.Table{
+		Name:       "remember_token",
+		Columns:    RememberTokenColumns,
+		PrimaryKey: []*schema.Column{RememberTokenColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "remember_token_user_user",
+				Columns:    []*schema.Column{RememberTokenColumns[3]},
+				RefColumns: []*schema.Column{UserColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "remembertoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RememberTokenColumns[3]},
+			},
+		},
+	}
+	// SessionColumns holds the columns for the "session" table.
+	SessionColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "values", Type: field.TypeJSON},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// SessionTable holds the schema information for the "session" table.
+	SessionTable = &schema.Table{
+		Name:       "session",
+		Columns:    SessionColumns,
+		PrimaryKey: []*schema.Column{SessionColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "session_user_user",
+				Columns:    []*schema.Column{SessionColumns[7]},
+				RefColumns: []*schema.Column{UserColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionColumns[7]},
+			},
+			{
+				Name:    "session_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionColumns[6]},
+			},
+		},
+	}
+	// StatusChangeColumns holds the columns for the "status_change" table.
+	StatusChangeColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "from_status", Type: field.TypeEnum, Enums: []string{"open", "proposed", "verified", "closed"}},
+		{Name: "to_status", Type: field.TypeEnum, Enums: []string{"open", "proposed", "verified", "closed"}},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "status_change_post", Type: field.TypeUUID},
+		{Name: "status_change_user", Type: field.TypeUUID},
+	}
+	// StatusChangeTable holds the schema information for the "status_change" table.
+	StatusChangeTable = &schema.Table{
+		Name:       "status_change",
+		Columns:    StatusChangeColumns,
+		PrimaryKey: []*schema.Column{StatusChangeColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "status_change_post_post",
+				Columns:    []*schema.Column{StatusChangeColumns[5]},
+				RefColumns: []*schema.Column{PostColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "status_change_user_user",
+				Columns:    []*schema.Column{StatusChangeColumns[6]},
+				RefColumns: []*schema.Column{UserColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "statuschange_status_change_post",
+				Unique:  false,
+				Columns: []*schema.Column{StatusChangeColumns[5]},
+			},
 		},
 	}
 	// UserColumns holds the columns for the "user" table.
@@ -72,6 +384,17 @@ var (
 		{Name: "username", Type: field.TypeString, Unique: true, Size: 64},
 		{Name: "email", Type: field.TypeString, Unique: true, Size: 128},
 		{Name: "password", Type: field.TypeString},
+		{Name: "confirmed", Type: field.TypeBool, Default: false},
+		{Name: "confirm_selector", Type: field.TypeString, Nullable: true},
+		{Name: "confirm_verifier", Type: field.TypeString, Nullable: true},
+		{Name: "recover_selector", Type: field.TypeString, Nullable: true},
+		{Name: "recover_verifier", Type: field.TypeString, Nullable: true},
+		{Name: "recover_expiry", Type: field.TypeTime, Nullable: true},
+		{Name: "session_version", Type: field.TypeInt, Default: 0},
+		{Name: "attempt_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "banned_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -80,6 +403,18 @@ var (
 		Name:       "user",
 		Columns:    UserColumns,
 		PrimaryKey: []*schema.Column{UserColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_confirm_selector",
+				Unique:  false,
+				Columns: []*schema.Column{UserColumns[5]},
+			},
+			{
+				Name:    "user_recover_selector",
+				Unique:  false,
+				Columns: []*schema.Column{UserColumns[7]},
+			},
+		},
 	}
 	// VoteColumns holds the columns for the "vote" table.
 	VoteColumns = []*schema.Column{
@@ -117,31 +452,80 @@ var (
 				Columns: []*schema.Column{VoteColumns[1]},
 			},
 			{
-				Name:    "vote_kind_vote_user",
+				Name:    "vote_kind_vote_post_vote_user",
 				Unique:  true,
-				Columns: []*schema.Column{VoteColumns[1], VoteColumns[6]},
+				Columns: []*schema.Column{VoteColumns[1], VoteColumns[5], VoteColumns[6]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AttachmentTable,
+		BlobTable,
 		CommunityTable,
+		FileTable,
+		IdentityTable,
+		MembershipTable,
 		PostTable,
+		PostRevisionTable,
+		RememberTokenTable,
+		SessionTable,
+		StatusChangeTable,
 		UserTable,
 		VoteTable,
 	}
 )
 
 func init() {
+	AttachmentTable.ForeignKeys[0].RefTable = PostTable
+	AttachmentTable.ForeignKeys[1].RefTable = FileTable
+	AttachmentTable.Annotation = &entsql.Annotation{
+		Table: "attachment",
+	}
+	BlobTable.Annotation = &entsql.Annotation{
+		Table: "blob",
+	}
 	CommunityTable.Annotation = &entsql.Annotation{
 		Table: "community",
 	}
+	FileTable.ForeignKeys[0].RefTable = FileTable
+	FileTable.Annotation = &entsql.Annotation{
+		Table: "file",
+	}
+	IdentityTable.ForeignKeys[0].RefTable = UserTable
+	IdentityTable.Annotation = &entsql.Annotation{
+		Table: "identity",
+	}
+	MembershipTable.ForeignKeys[0].RefTable = CommunityTable
+	MembershipTable.ForeignKeys[1].RefTable = UserTable
+	MembershipTable.Annotation = &entsql.Annotation{
+		Table: "membership",
+	}
 	PostTable.ForeignKeys[0].RefTable = UserTable
 	PostTable.ForeignKeys[1].RefTable = CommunityTable
 	PostTable.ForeignKeys[2].RefTable = PostTable
+	PostTable.ForeignKeys[3].RefTable = PostTable
 	PostTable.Annotation = &entsql.Annotation{
 		Table: "post",
 	}
+	PostRevisionTable.ForeignKeys[0].RefTable = PostTable
+	PostRevisionTable.ForeignKeys[1].RefTable = UserTable
+	PostRevisionTable.Annotation = &entsql.Annotation{
+		Table: "post_revision",
+	}
+	RememberTokenTable.ForeignKeys[0].RefTable = UserTable
+	RememberTokenTable.Annotation = &entsql.Annotation{
+		Table: "remember_token",
+	}
+	SessionTable.ForeignKeys[0].RefTable = UserTable
+	SessionTable.Annotation = &entsql.Annotation{
+		Table: "session",
+	}
+	StatusChangeTable.ForeignKeys[0].RefTable = PostTable
+	StatusChangeTable.ForeignKeys[1].RefTable = UserTable
+	StatusChangeTable.Annotation = &entsql.Annotation{
+		Table: "status_change",
+	}
 	UserTable.Annotation = &entsql.Annotation{
 		Table: "user",
 	}
@@ -25,8 +25,12 @@ type Post struct {
 	Title string `json:"title,omitempty"`
 	// Body holds the value of the "body" field.
 	Body string `json:"body,omitempty"`
+	// BodyHTML holds the value of the "body_html" field.
+	BodyHTML string `json:"body_html,omitempty"`
 	// Role holds the value of the "role" field.
 	Role post.Role `json:"role,omitempty"`
+	// Status holds the value of the "status" field.
+	Status post.Status `json:"status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -37,6 +41,14 @@ type Post struct {
 	ReplyTo *uuid.UUID `json:"reply_to,omitempty"`
 	// ImageURL holds the value of the "image_url" field.
 	ImageURL string `json:"image_url,omitempty"`
+	// Geography holds the value of the "geography" field.
+	Geography string `json:"geography,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// AcceptedSolutionID holds the value of the "accepted_solution_id" field.
+	AcceptedSolutionID *uuid.UUID `json:"accepted_solution_id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PostQuery when eager-loading is set.
 	Edges          PostEdges `json:"edges"`
@@ -55,11 +67,19 @@ type PostEdges struct {
 	Replies []*Post `json:"replies,omitempty"`
 	// Parent holds the value of the parent edge.
 	Parent *Post `json:"parent,omitempty"`
+	// AcceptedSolution holds the value of the accepted_solution edge.
+	AcceptedSolution *Post `json:"accepted_solution,omitempty"`
 	// Votes holds the value of the votes edge.
 	Votes []*Vote `json:"votes,omitempty"`
+	// StatusChanges holds the value of the status_changes edge.
+	StatusChanges []*StatusChange `json:"status_changes,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*PostRevision `json:"revisions,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [9]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -104,27 +124,65 @@ func (e PostEdges) ParentOrErr() (*Post, error) {
 	return nil, &NotLoadedError{edge: "parent"}
 }
 
+// AcceptedSolutionOrErr returns the AcceptedSolution value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostEdges) AcceptedSolutionOrErr() (*Post, error) {
+	if e.AcceptedSolution != nil {
+		return e.AcceptedSolution, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "accepted_solution"}
+}
+
 // VotesOrErr returns the Votes value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) VotesOrErr() ([]*Vote, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Votes, nil
 	}
 	return nil, &NotLoadedError{edge: "votes"}
 }
 
+// StatusChangesOrErr returns the StatusChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) StatusChangesOrErr() ([]*StatusChange, error) {
+	if e.loadedTypes[6] {
+		return e.StatusChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "status_changes"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) RevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[7] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[8] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Post) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case post.FieldReplyTo:
+		case post.FieldReplyTo, post.FieldAcceptedSolutionID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case post.FieldTags:
 			values[i] = new([]byte)
-		case post.FieldTitle, post.FieldBody, post.FieldRole, post.FieldImageURL:
+		case post.FieldTitle, post.FieldBody, post.FieldBodyHTML, post.FieldRole, post.FieldStatus, post.FieldImageURL, post.FieldGeography, post.FieldAddress:
 			values[i] = new(sql.NullString)
-		case post.FieldCreatedAt, post.FieldUpdatedAt:
+		case post.FieldCreatedAt, post.FieldUpdatedAt, post.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case post.FieldID:
 			values[i] = new(uuid.UUID)
@@ -165,12 +223,24 @@ func (po *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				po.Body = value.String
 			}
+		case post.FieldBodyHTML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body_html", values[i])
+			} else if value.Valid {
+				po.BodyHTML = value.String
+			}
 		case post.FieldRole:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field role", values[i])
 			} else if value.Valid {
 				po.Role = post.Role(value.String)
 			}
+		case post.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				po.Status = post.Status(value.String)
+			}
 		case post.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -204,6 +274,32 @@ func (po *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				po.ImageURL = value.String
 			}
+		case post.FieldGeography:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field geography", values[i])
+			} else if value.Valid {
+				po.Geography = value.String
+			}
+		case post.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				po.Address = value.String
+			}
+		case post.FieldAcceptedSolutionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_solution_id", values[i])
+			} else if value.Valid {
+				po.AcceptedSolutionID = new(uuid.UUID)
+				*po.AcceptedSolutionID = *value.S.(*uuid.UUID)
+			}
+		case post.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				po.DeletedAt = new(time.Time)
+				*po.DeletedAt = value.Time
+			}
 		case post.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field post_user", values[i])
@@ -251,11 +347,31 @@ func (po *Post) QueryParent() *PostQuery {
 	return NewPostClient(po.config).QueryParent(po)
 }
 
+// QueryAcceptedSolution queries the "accepted_solution" edge of the Post entity.
+func (po *Post) QueryAcceptedSolution() *PostQuery {
+	return NewPostClient(po.config).QueryAcceptedSolution(po)
+}
+
 // QueryVotes queries the "votes" edge of the Post entity.
 func (po *Post) QueryVotes() *VoteQuery {
 	return NewPostClient(po.config).QueryVotes(po)
 }
 
+// QueryStatusChanges queries the "status_changes" edge of the Post entity.
+func (po *Post) QueryStatusChanges() *StatusChangeQuery {
+	return NewPostClient(po.config).QueryStatusChanges(po)
+}
+
+// QueryRevisions queries the "revisions" edge of the Post entity.
+func (po *Post) QueryRevisions() *PostRevisionQuery {
+	return NewPostClient(po.config).QueryRevisions(po)
+}
+
+// QueryAttachments queries the "attachments" edge of the Post entity.
+func (po *Post) QueryAttachments() *AttachmentQuery {
+	return NewPostClient(po.config).QueryAttachments(po)
+}
+
 // Update returns a builder for updating this Post.
 // Note that you need to call Post.Unwrap() before calling this method if this Post
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -285,9 +401,15 @@ func (po *Post) String() string {
 	builder.WriteString("body=")
 	builder.WriteString(po.Body)
 	builder.WriteString(", ")
+	builder.WriteString("body_html=")
+	builder.WriteString(po.BodyHTML)
+	builder.WriteString(", ")
 	builder.WriteString("role=")
 	builder.WriteString(fmt.Sprintf("%v", po.Role))
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", po.Status))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(po.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -304,6 +426,22 @@ func (po *Post) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("image_url=")
 	builder.WriteString(po.ImageURL)
+	builder.WriteString(", ")
+	builder.WriteString("geography=")
+	builder.WriteString(po.Geography)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(po.Address)
+	builder.WriteString(", ")
+	if v := po.AcceptedSolutionID; v != nil {
+		builder.WriteString("accepted_solution_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := po.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -66,6 +66,11 @@ func Body(v string) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldBody, v))
 }
 
+// BodyHTML applies equality check predicate on the "body_html" field. It's identical to BodyHTMLEQ.
+func BodyHTML(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldBodyHTML, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldCreatedAt, v))
@@ -86,6 +91,26 @@ func ImageURL(v string) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldImageURL, v))
 }
 
+// Geography applies equality check predicate on the "geography" field. It's identical to GeographyEQ.
+func Geography(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldGeography, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldAddress, v))
+}
+
+// AcceptedSolutionID applies equality check predicate on the "accepted_solution_id" field. It's identical to AcceptedSolutionIDEQ.
+func AcceptedSolutionID(v uuid.UUID) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldAcceptedSolutionID, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldTitle, v))
@@ -226,6 +251,81 @@ func BodyContainsFold(v string) predicate.Post {
 	return predicate.Post(sql.FieldContainsFold(FieldBody, v))
 }
 
+// BodyHTMLEQ applies the EQ predicate on the "body_html" field.
+func BodyHTMLEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldBodyHTML, v))
+}
+
+// BodyHTMLNEQ applies the NEQ predicate on the "body_html" field.
+func BodyHTMLNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldBodyHTML, v))
+}
+
+// BodyHTMLIn applies the In predicate on the "body_html" field.
+func BodyHTMLIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldBodyHTML, vs...))
+}
+
+// BodyHTMLNotIn applies the NotIn predicate on the "body_html" field.
+func BodyHTMLNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldBodyHTML, vs...))
+}
+
+// BodyHTMLGT applies the GT predicate on the "body_html" field.
+func BodyHTMLGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldBodyHTML, v))
+}
+
+// BodyHTMLGTE applies the GTE predicate on the "body_html" field.
+func BodyHTMLGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldBodyHTML, v))
+}
+
+// BodyHTMLLT applies the LT predicate on the "body_html" field.
+func BodyHTMLLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldBodyHTML, v))
+}
+
+// BodyHTMLLTE applies the LTE predicate on the "body_html" field.
+func BodyHTMLLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldBodyHTML, v))
+}
+
+// BodyHTMLContains applies the Contains predicate on the "body_html" field.
+func BodyHTMLContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldBodyHTML, v))
+}
+
+// BodyHTMLHasPrefix applies the HasPrefix predicate on the "body_html" field.
+func BodyHTMLHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldBodyHTML, v))
+}
+
+// BodyHTMLHasSuffix applies the HasSuffix predicate on the "body_html" field.
+func BodyHTMLHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldBodyHTML, v))
+}
+
+// BodyHTMLIsNil applies the IsNil predicate on the "body_html" field.
+func BodyHTMLIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldBodyHTML))
+}
+
+// BodyHTMLNotNil applies the NotNil predicate on the "body_html" field.
+func BodyHTMLNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldBodyHTML))
+}
+
+// BodyHTMLEqualFold applies the EqualFold predicate on the "body_html" field.
+func BodyHTMLEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldBodyHTML, v))
+}
+
+// BodyHTMLContainsFold applies the ContainsFold predicate on the "body_html" field.
+func BodyHTMLContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldBodyHTML, v))
+}
+
 // RoleEQ applies the EQ predicate on the "role" field.
 func RoleEQ(v Role) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldRole, v))
@@ -246,6 +346,26 @@ func RoleNotIn(vs ...Role) predicate.Post {
 	return predicate.Post(sql.FieldNotIn(FieldRole, vs...))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldStatus, vs...))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldCreatedAt, v))
@@ -441,6 +561,236 @@ func ImageURLContainsFold(v string) predicate.Post {
 	return predicate.Post(sql.FieldContainsFold(FieldImageURL, v))
 }
 
+// GeographyEQ applies the EQ predicate on the "geography" field.
+func GeographyEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldGeography, v))
+}
+
+// GeographyNEQ applies the NEQ predicate on the "geography" field.
+func GeographyNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldGeography, v))
+}
+
+// GeographyIn applies the In predicate on the "geography" field.
+func GeographyIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldGeography, vs...))
+}
+
+// GeographyNotIn applies the NotIn predicate on the "geography" field.
+func GeographyNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldGeography, vs...))
+}
+
+// GeographyGT applies the GT predicate on the "geography" field.
+func GeographyGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldGeography, v))
+}
+
+// GeographyGTE applies the GTE predicate on the "geography" field.
+func GeographyGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldGeography, v))
+}
+
+// GeographyLT applies the LT predicate on the "geography" field.
+func GeographyLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldGeography, v))
+}
+
+// GeographyLTE applies the LTE predicate on the "geography" field.
+func GeographyLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldGeography, v))
+}
+
+// GeographyContains applies the Contains predicate on the "geography" field.
+func GeographyContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldGeography, v))
+}
+
+// GeographyHasPrefix applies the HasPrefix predicate on the "geography" field.
+func GeographyHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldGeography, v))
+}
+
+// GeographyHasSuffix applies the HasSuffix predicate on the "geography" field.
+func GeographyHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldGeography, v))
+}
+
+// GeographyIsNil applies the IsNil predicate on the "geography" field.
+func GeographyIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldGeography))
+}
+
+// GeographyNotNil applies the NotNil predicate on the "geography" field.
+func GeographyNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldGeography))
+}
+
+// GeographyEqualFold applies the EqualFold predicate on the "geography" field.
+func GeographyEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldGeography, v))
+}
+
+// GeographyContainsFold applies the ContainsFold predicate on the "geography" field.
+func GeographyContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldGeography, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressIsNil applies the IsNil predicate on the "address" field.
+func AddressIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldAddress))
+}
+
+// AddressNotNil applies the NotNil predicate on the "address" field.
+func AddressNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldAddress))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// AcceptedSolutionIDEQ applies the EQ predicate on the "accepted_solution_id" field.
+func AcceptedSolutionIDEQ(v uuid.UUID) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldAcceptedSolutionID, v))
+}
+
+// AcceptedSolutionIDNEQ applies the NEQ predicate on the "accepted_solution_id" field.
+func AcceptedSolutionIDNEQ(v uuid.UUID) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldAcceptedSolutionID, v))
+}
+
+// AcceptedSolutionIDIn applies the In predicate on the "accepted_solution_id" field.
+func AcceptedSolutionIDIn(vs ...uuid.UUID) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldAcceptedSolutionID, vs...))
+}
+
+// AcceptedSolutionIDNotIn applies the NotIn predicate on the "accepted_solution_id" field.
+func AcceptedSolutionIDNotIn(vs ...uuid.UUID) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldAcceptedSolutionID, vs...))
+}
+
+// AcceptedSolutionIDIsNil applies the IsNil predicate on the "accepted_solution_id" field.
+func AcceptedSolutionIDIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldAcceptedSolutionID))
+}
+
+// AcceptedSolutionIDNotNil applies the NotNil predicate on the "accepted_solution_id" field.
+func AcceptedSolutionIDNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldAcceptedSolutionID))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Post {
 	return predicate.Post(func(s *sql.Selector) {
@@ -533,6 +883,29 @@ func HasParentWith(preds ...predicate.Post) predicate.Post {
 	})
 }
 
+// HasAcceptedSolution applies the HasEdge predicate on the "accepted_solution" edge.
+func HasAcceptedSolution() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, AcceptedSolutionTable, AcceptedSolutionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAcceptedSolutionWith applies the HasEdge predicate on the "accepted_solution" edge with a given conditions (other predicates).
+func HasAcceptedSolutionWith(preds ...predicate.Post) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newAcceptedSolutionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasVotes applies the HasEdge predicate on the "votes" edge.
 func HasVotes() predicate.Post {
 	return predicate.Post(func(s *sql.Selector) {
@@ -556,6 +929,75 @@ func HasVotesWith(preds ...predicate.Vote) predicate.Post {
 	})
 }
 
+// HasStatusChanges applies the HasEdge predicate on the "status_changes" edge.
+func HasStatusChanges() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, StatusChangesTable, StatusChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusChangesWith applies the HasEdge predicate on the "status_changes" edge with a given conditions (other predicates).
+func HasStatusChangesWith(preds ...predicate.StatusChange) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newStatusChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.PostRevision) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Post) predicate.Post {
 	return predicate.Post(sql.AndPredicates(predicates...))
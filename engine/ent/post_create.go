@@ -5,8 +5,11 @@ package ent
 import (
 	"context"
 	"errors"
+	"fixit/engine/ent/attachment"
 	"fixit/engine/ent/community"
 	"fixit/engine/ent/post"
+	"fixit/engine/ent/postrevision"
+	"fixit/engine/ent/statuschange"
 	"fixit/engine/ent/user"
 	"fixit/engine/ent/vote"
 	"fmt"
@@ -44,6 +47,20 @@ func (pc *PostCreate) SetNillableBody(s *string) *PostCreate {
 	return pc
 }
 
+// SetBodyHTML sets the "body_html" field.
+func (pc *PostCreate) SetBodyHTML(s string) *PostCreate {
+	pc.mutation.SetBodyHTML(s)
+	return pc
+}
+
+// SetNillableBodyHTML sets the "body_html" field if the given value is not nil.
+func (pc *PostCreate) SetNillableBodyHTML(s *string) *PostCreate {
+	if s != nil {
+		pc.SetBodyHTML(*s)
+	}
+	return pc
+}
+
 // SetRole sets the "role" field.
 func (pc *PostCreate) SetRole(po post.Role) *PostCreate {
 	pc.mutation.SetRole(po)
@@ -58,6 +75,20 @@ func (pc *PostCreate) SetNillableRole(po *post.Role) *PostCreate {
 	return pc
 }
 
+// SetStatus sets the "status" field.
+func (pc *PostCreate) SetStatus(po post.Status) *PostCreate {
+	pc.mutation.SetStatus(po)
+	return pc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pc *PostCreate) SetNillableStatus(po *post.Status) *PostCreate {
+	if po != nil {
+		pc.SetStatus(*po)
+	}
+	return pc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pc *PostCreate) SetCreatedAt(t time.Time) *PostCreate {
 	pc.mutation.SetCreatedAt(t)
@@ -120,6 +151,62 @@ func (pc *PostCreate) SetNillableImageURL(s *string) *PostCreate {
 	return pc
 }
 
+// SetGeography sets the "geography" field.
+func (pc *PostCreate) SetGeography(s string) *PostCreate {
+	pc.mutation.SetGeography(s)
+	return pc
+}
+
+// SetNillableGeography sets the "geography" field if the given value is not nil.
+func (pc *PostCreate) SetNillableGeography(s *string) *PostCreate {
+	if s != nil {
+		pc.SetGeography(*s)
+	}
+	return pc
+}
+
+// SetAddress sets the "address" field.
+func (pc *PostCreate) SetAddress(s string) *PostCreate {
+	pc.mutation.SetAddress(s)
+	return pc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (pc *PostCreate) SetNillableAddress(s *string) *PostCreate {
+	if s != nil {
+		pc.SetAddress(*s)
+	}
+	return pc
+}
+
+// SetAcceptedSolutionID sets the "accepted_solution_id" field.
+func (pc *PostCreate) SetAcceptedSolutionID(u uuid.UUID) *PostCreate {
+	pc.mutation.SetAcceptedSolutionID(u)
+	return pc
+}
+
+// SetNillableAcceptedSolutionID sets the "accepted_solution_id" field if the given value is not nil.
+func (pc *PostCreate) SetNillableAcceptedSolutionID(u *uuid.UUID) *PostCreate {
+	if u != nil {
+		pc.SetAcceptedSolutionID(*u)
+	}
+	return pc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *PostCreate) SetDeletedAt(t time.Time) *PostCreate {
+	pc.mutation.SetDeletedAt(t)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *PostCreate) SetNillableDeletedAt(t *time.Time) *PostCreate {
+	if t != nil {
+		pc.SetDeletedAt(*t)
+	}
+	return pc
+}
+
 // SetID sets the "id" field.
 func (pc *PostCreate) SetID(u uuid.UUID) *PostCreate {
 	pc.mutation.SetID(u)
@@ -190,6 +277,11 @@ func (pc *PostCreate) SetParent(p *Post) *PostCreate {
 	return pc.SetParentID(p.ID)
 }
 
+// SetAcceptedSolution sets the "accepted_solution" edge to the Post entity.
+func (pc *PostCreate) SetAcceptedSolution(p *Post) *PostCreate {
+	return pc.SetAcceptedSolutionID(p.ID)
+}
+
 // AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
 func (pc *PostCreate) AddVoteIDs(ids ...uuid.UUID) *PostCreate {
 	pc.mutation.AddVoteIDs(ids...)
@@ -205,6 +297,51 @@ func (pc *PostCreate) AddVotes(v ...*Vote) *PostCreate {
 	return pc.AddVoteIDs(ids...)
 }
 
+// AddStatusChangeIDs adds the "status_changes" edge to the StatusChange entity by IDs.
+func (pc *PostCreate) AddStatusChangeIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddStatusChangeIDs(ids...)
+	return pc
+}
+
+// AddStatusChanges adds the "status_changes" edges to the StatusChange entity.
+func (pc *PostCreate) AddStatusChanges(s ...*StatusChange) *PostCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pc.AddStatusChangeIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (pc *PostCreate) AddRevisionIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddRevisionIDs(ids...)
+	return pc
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (pc *PostCreate) AddRevisions(p ...*PostRevision) *PostCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddRevisionIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (pc *PostCreate) AddAttachmentIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddAttachmentIDs(ids...)
+	return pc
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (pc *PostCreate) AddAttachments(a ...*Attachment) *PostCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pc.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (pc *PostCreate) Mutation() *PostMutation {
 	return pc.mutation
@@ -244,6 +381,10 @@ func (pc *PostCreate) defaults() {
 		v := post.DefaultRole
 		pc.mutation.SetRole(v)
 	}
+	if _, ok := pc.mutation.Status(); !ok {
+		v := post.DefaultStatus
+		pc.mutation.SetStatus(v)
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		v := post.DefaultCreatedAt()
 		pc.mutation.SetCreatedAt(v)
@@ -280,12 +421,30 @@ func (pc *PostCreate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Post.role": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Post.status"`)}
+	}
+	if v, ok := pc.mutation.Status(); ok {
+		if err := post.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Post.status": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Post.created_at"`)}
 	}
 	if _, ok := pc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Post.updated_at"`)}
 	}
+	if v, ok := pc.mutation.Geography(); ok {
+		if err := post.GeographyValidator(v); err != nil {
+			return &ValidationError{Name: "geography", err: fmt.Errorf(`ent: validator failed for field "Post.geography": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.Address(); ok {
+		if err := post.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Post.address": %w`, err)}
+		}
+	}
 	if len(pc.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Post.user"`)}
 	}
@@ -335,10 +494,18 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldBody, field.TypeString, value)
 		_node.Body = value
 	}
+	if value, ok := pc.mutation.BodyHTML(); ok {
+		_spec.SetField(post.FieldBodyHTML, field.TypeString, value)
+		_node.BodyHTML = value
+	}
 	if value, ok := pc.mutation.Role(); ok {
 		_spec.SetField(post.FieldRole, field.TypeEnum, value)
 		_node.Role = value
 	}
+	if value, ok := pc.mutation.Status(); ok {
+		_spec.SetField(post.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
 	if value, ok := pc.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -355,6 +522,18 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldImageURL, field.TypeString, value)
 		_node.ImageURL = value
 	}
+	if value, ok := pc.mutation.Geography(); ok {
+		_spec.SetField(post.FieldGeography, field.TypeString, value)
+		_node.Geography = value
+	}
+	if value, ok := pc.mutation.Address(); ok {
+		_spec.SetField(post.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := pc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -422,6 +601,23 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_node.ReplyTo = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.AcceptedSolutionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.AcceptedSolutionTable,
+			Columns: []string{post.AcceptedSolutionColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AcceptedSolutionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := pc.mutation.VotesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -438,6 +634,54 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.StatusChangesTable,
+			Columns: []string{post.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.AttachmentsTable,
+			Columns: []string{post.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
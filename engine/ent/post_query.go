@@ -5,9 +5,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"fixit/engine/ent/attachment"
 	"fixit/engine/ent/community"
 	"fixit/engine/ent/post"
+	"fixit/engine/ent/postrevision"
 	"fixit/engine/ent/predicate"
+	"fixit/engine/ent/statuschange"
 	"fixit/engine/ent/user"
 	"fixit/engine/ent/vote"
 	"fmt"
@@ -23,16 +26,21 @@ import (
 // PostQuery is the builder for querying Post entities.
 type PostQuery struct {
 	config
-	ctx           *QueryContext
-	order         []post.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Post
-	withUser      *UserQuery
-	withCommunity *CommunityQuery
-	withReplies   *PostQuery
-	withParent    *PostQuery
-	withVotes     *VoteQuery
-	withFKs       bool
+	ctx                  *QueryContext
+	order                []post.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Post
+	withUser             *UserQuery
+	withCommunity        *CommunityQuery
+	withReplies          *PostQuery
+	withParent           *PostQuery
+	withAcceptedSolution *PostQuery
+	withVotes            *VoteQuery
+	withStatusChanges    *StatusChangeQuery
+	withRevisions        *PostRevisionQuery
+	withAttachments      *AttachmentQuery
+	withFKs              bool
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -157,6 +165,28 @@ func (pq *PostQuery) QueryParent() *PostQuery {
 	return query
 }
 
+// QueryAcceptedSolution chains the current query on the "accepted_solution" edge.
+func (pq *PostQuery) QueryAcceptedSolution() *PostQuery {
+	query := (&PostClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, post.AcceptedSolutionTable, post.AcceptedSolutionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryVotes chains the current query on the "votes" edge.
 func (pq *PostQuery) QueryVotes() *VoteQuery {
 	query := (&VoteClient{config: pq.config}).Query()
@@ -179,6 +209,72 @@ func (pq *PostQuery) QueryVotes() *VoteQuery {
 	return query
 }
 
+// QueryStatusChanges chains the current query on the "status_changes" edge.
+func (pq *PostQuery) QueryStatusChanges() *StatusChangeQuery {
+	query := (&StatusChangeClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(statuschange.Table, statuschange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, post.StatusChangesTable, post.StatusChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (pq *PostQuery) QueryRevisions() *PostRevisionQuery {
+	query := (&PostRevisionClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(postrevision.Table, postrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, post.RevisionsTable, post.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the "attachments" edge.
+func (pq *PostQuery) QueryAttachments() *AttachmentQuery {
+	query := (&AttachmentClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, post.AttachmentsTable, post.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Post entity from the query.
 // Returns a *NotFoundError when no Post was found.
 func (pq *PostQuery) First(ctx context.Context) (*Post, error) {
@@ -366,19 +462,24 @@ func (pq *PostQuery) Clone() *PostQuery {
 		return nil
 	}
 	return &PostQuery{
-		config:        pq.config,
-		ctx:           pq.ctx.Clone(),
-		order:         append([]post.OrderOption{}, pq.order...),
-		inters:        append([]Interceptor{}, pq.inters...),
-		predicates:    append([]predicate.Post{}, pq.predicates...),
-		withUser:      pq.withUser.Clone(),
-		withCommunity: pq.withCommunity.Clone(),
-		withReplies:   pq.withReplies.Clone(),
-		withParent:    pq.withParent.Clone(),
-		withVotes:     pq.withVotes.Clone(),
+		config:               pq.config,
+		ctx:                  pq.ctx.Clone(),
+		order:                append([]post.OrderOption{}, pq.order...),
+		inters:               append([]Interceptor{}, pq.inters...),
+		predicates:           append([]predicate.Post{}, pq.predicates...),
+		withUser:             pq.withUser.Clone(),
+		withCommunity:        pq.withCommunity.Clone(),
+		withReplies:          pq.withReplies.Clone(),
+		withParent:           pq.withParent.Clone(),
+		withAcceptedSolution: pq.withAcceptedSolution.Clone(),
+		withVotes:            pq.withVotes.Clone(),
+		withStatusChanges:    pq.withStatusChanges.Clone(),
+		withRevisions:        pq.withRevisions.Clone(),
+		withAttachments:      pq.withAttachments.Clone(),
 		// clone intermediate query.
-		sql:  pq.sql.Clone(),
-		path: pq.path,
+		sql:       pq.sql.Clone(),
+		path:      pq.path,
+		modifiers: append([]func(*sql.Selector){}, pq.modifiers...),
 	}
 }
 
@@ -426,6 +527,17 @@ func (pq *PostQuery) WithParent(opts ...func(*PostQuery)) *PostQuery {
 	return pq
 }
 
+// WithAcceptedSolution tells the query-builder to eager-load the nodes that are connected to
+// the "accepted_solution" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PostQuery) WithAcceptedSolution(opts ...func(*PostQuery)) *PostQuery {
+	query := (&PostClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withAcceptedSolution = query
+	return pq
+}
+
 // WithVotes tells the query-builder to eager-load the nodes that are connected to
 // the "votes" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PostQuery) WithVotes(opts ...func(*VoteQuery)) *PostQuery {
@@ -437,6 +549,39 @@ func (pq *PostQuery) WithVotes(opts ...func(*VoteQuery)) *PostQuery {
 	return pq
 }
 
+// WithStatusChanges tells the query-builder to eager-load the nodes that are connected to
+// the "status_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PostQuery) WithStatusChanges(opts ...func(*StatusChangeQuery)) *PostQuery {
+	query := (&StatusChangeClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withStatusChanges = query
+	return pq
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PostQuery) WithRevisions(opts ...func(*PostRevisionQuery)) *PostQuery {
+	query := (&PostRevisionClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withRevisions = query
+	return pq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PostQuery) WithAttachments(opts ...func(*AttachmentQuery)) *PostQuery {
+	query := (&AttachmentClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withAttachments = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -516,12 +661,16 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 		nodes       = []*Post{}
 		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [9]bool{
 			pq.withUser != nil,
 			pq.withCommunity != nil,
 			pq.withReplies != nil,
 			pq.withParent != nil,
+			pq.withAcceptedSolution != nil,
 			pq.withVotes != nil,
+			pq.withStatusChanges != nil,
+			pq.withRevisions != nil,
+			pq.withAttachments != nil,
 		}
 	)
 	if pq.withUser != nil || pq.withCommunity != nil {
@@ -539,6 +688,9 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -573,6 +725,12 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 			return nil, err
 		}
 	}
+	if query := pq.withAcceptedSolution; query != nil {
+		if err := pq.loadAcceptedSolution(ctx, query, nodes, nil,
+			func(n *Post, e *Post) { n.Edges.AcceptedSolution = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := pq.withVotes; query != nil {
 		if err := pq.loadVotes(ctx, query, nodes,
 			func(n *Post) { n.Edges.Votes = []*Vote{} },
@@ -580,6 +738,27 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 			return nil, err
 		}
 	}
+	if query := pq.withStatusChanges; query != nil {
+		if err := pq.loadStatusChanges(ctx, query, nodes,
+			func(n *Post) { n.Edges.StatusChanges = []*StatusChange{} },
+			func(n *Post, e *StatusChange) { n.Edges.StatusChanges = append(n.Edges.StatusChanges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withRevisions; query != nil {
+		if err := pq.loadRevisions(ctx, query, nodes,
+			func(n *Post) { n.Edges.Revisions = []*PostRevision{} },
+			func(n *Post, e *PostRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withAttachments; query != nil {
+		if err := pq.loadAttachments(ctx, query, nodes,
+			func(n *Post) { n.Edges.Attachments = []*Attachment{} },
+			func(n *Post, e *Attachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -713,6 +892,38 @@ func (pq *PostQuery) loadParent(ctx context.Context, query *PostQuery, nodes []*
 	}
 	return nil
 }
+func (pq *PostQuery) loadAcceptedSolution(ctx context.Context, query *PostQuery, nodes []*Post, init func(*Post), assign func(*Post, *Post)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Post)
+	for i := range nodes {
+		if nodes[i].AcceptedSolutionID == nil {
+			continue
+		}
+		fk := *nodes[i].AcceptedSolutionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(post.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "accepted_solution_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (pq *PostQuery) loadVotes(ctx context.Context, query *VoteQuery, nodes []*Post, init func(*Post), assign func(*Post, *Vote)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Post)
@@ -744,9 +955,104 @@ func (pq *PostQuery) loadVotes(ctx context.Context, query *VoteQuery, nodes []*P
 	}
 	return nil
 }
+func (pq *PostQuery) loadStatusChanges(ctx context.Context, query *StatusChangeQuery, nodes []*Post, init func(*Post), assign func(*Post, *StatusChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Post)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.StatusChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(post.StatusChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.status_change_post
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "status_change_post" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "status_change_post" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *PostQuery) loadRevisions(ctx context.Context, query *PostRevisionQuery, nodes []*Post, init func(*Post), assign func(*Post, *PostRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Post)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PostRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(post.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.post_revision_post
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "post_revision_post" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "post_revision_post" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *PostQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*Post, init func(*Post), assign func(*Post, *Attachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Post)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attachment.FieldPostID)
+	}
+	query.Where(predicate.Attachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(post.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PostID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "post_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *PostQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	_spec.Node.Columns = pq.ctx.Fields
 	if len(pq.ctx.Fields) > 0 {
 		_spec.Unique = pq.ctx.Unique != nil && *pq.ctx.Unique
@@ -773,6 +1079,9 @@ func (pq *PostQuery) querySpec() *sqlgraph.QuerySpec {
 		if pq.withParent != nil {
 			_spec.Node.AddColumnOnce(post.FieldReplyTo)
 		}
+		if pq.withAcceptedSolution != nil {
+			_spec.Node.AddColumnOnce(post.FieldAcceptedSolutionID)
+		}
 	}
 	if ps := pq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -812,6 +1121,9 @@ func (pq *PostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pq.ctx.Unique != nil && *pq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pq.modifiers {
+		m(selector)
+	}
 	for _, p := range pq.predicates {
 		p(selector)
 	}
@@ -829,6 +1141,12 @@ func (pq *PostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pq *PostQuery) Modify(modifiers ...func(s *sql.Selector)) *PostSelect {
+	pq.modifiers = append(pq.modifiers, modifiers...)
+	return pq.Select()
+}
+
 // PostGroupBy is the group-by builder for Post entities.
 type PostGroupBy struct {
 	selector
@@ -918,3 +1236,9 @@ func (ps *PostSelect) sqlScan(ctx context.Context, root *PostQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ps *PostSelect) Modify(modifiers ...func(s *sql.Selector)) *PostSelect {
+	ps.modifiers = append(ps.modifiers, modifiers...)
+	return ps
+}
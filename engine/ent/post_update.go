@@ -5,9 +5,12 @@ package ent
 import (
 	"context"
 	"errors"
+	"fixit/engine/ent/attachment"
 	"fixit/engine/ent/community"
 	"fixit/engine/ent/post"
+	"fixit/engine/ent/postrevision"
 	"fixit/engine/ent/predicate"
+	"fixit/engine/ent/statuschange"
 	"fixit/engine/ent/user"
 	"fixit/engine/ent/vote"
 	"fmt"
@@ -23,8 +26,9 @@ import (
 // PostUpdate is the builder for updating Post entities.
 type PostUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PostMutation
+	hooks     []Hook
+	mutation  *PostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PostUpdate builder.
@@ -67,6 +71,26 @@ func (pu *PostUpdate) ClearBody() *PostUpdate {
 	return pu
 }
 
+// SetBodyHTML sets the "body_html" field.
+func (pu *PostUpdate) SetBodyHTML(s string) *PostUpdate {
+	pu.mutation.SetBodyHTML(s)
+	return pu
+}
+
+// SetNillableBodyHTML sets the "body_html" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableBodyHTML(s *string) *PostUpdate {
+	if s != nil {
+		pu.SetBodyHTML(*s)
+	}
+	return pu
+}
+
+// ClearBodyHTML clears the value of the "body_html" field.
+func (pu *PostUpdate) ClearBodyHTML() *PostUpdate {
+	pu.mutation.ClearBodyHTML()
+	return pu
+}
+
 // SetRole sets the "role" field.
 func (pu *PostUpdate) SetRole(po post.Role) *PostUpdate {
 	pu.mutation.SetRole(po)
@@ -81,6 +105,20 @@ func (pu *PostUpdate) SetNillableRole(po *post.Role) *PostUpdate {
 	return pu
 }
 
+// SetStatus sets the "status" field.
+func (pu *PostUpdate) SetStatus(po post.Status) *PostUpdate {
+	pu.mutation.SetStatus(po)
+	return pu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableStatus(po *post.Status) *PostUpdate {
+	if po != nil {
+		pu.SetStatus(*po)
+	}
+	return pu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (pu *PostUpdate) SetUpdatedAt(t time.Time) *PostUpdate {
 	pu.mutation.SetUpdatedAt(t)
@@ -145,6 +183,86 @@ func (pu *PostUpdate) ClearImageURL() *PostUpdate {
 	return pu
 }
 
+// SetGeography sets the "geography" field.
+func (pu *PostUpdate) SetGeography(s string) *PostUpdate {
+	pu.mutation.SetGeography(s)
+	return pu
+}
+
+// SetNillableGeography sets the "geography" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableGeography(s *string) *PostUpdate {
+	if s != nil {
+		pu.SetGeography(*s)
+	}
+	return pu
+}
+
+// ClearGeography clears the value of the "geography" field.
+func (pu *PostUpdate) ClearGeography() *PostUpdate {
+	pu.mutation.ClearGeography()
+	return pu
+}
+
+// SetAddress sets the "address" field.
+func (pu *PostUpdate) SetAddress(s string) *PostUpdate {
+	pu.mutation.SetAddress(s)
+	return pu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableAddress(s *string) *PostUpdate {
+	if s != nil {
+		pu.SetAddress(*s)
+	}
+	return pu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (pu *PostUpdate) ClearAddress() *PostUpdate {
+	pu.mutation.ClearAddress()
+	return pu
+}
+
+// SetAcceptedSolutionID sets the "accepted_solution_id" field.
+func (pu *PostUpdate) SetAcceptedSolutionID(u uuid.UUID) *PostUpdate {
+	pu.mutation.SetAcceptedSolutionID(u)
+	return pu
+}
+
+// SetNillableAcceptedSolutionID sets the "accepted_solution_id" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableAcceptedSolutionID(u *uuid.UUID) *PostUpdate {
+	if u != nil {
+		pu.SetAcceptedSolutionID(*u)
+	}
+	return pu
+}
+
+// ClearAcceptedSolutionID clears the value of the "accepted_solution_id" field.
+func (pu *PostUpdate) ClearAcceptedSolutionID() *PostUpdate {
+	pu.mutation.ClearAcceptedSolutionID()
+	return pu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *PostUpdate) SetDeletedAt(t time.Time) *PostUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableDeletedAt(t *time.Time) *PostUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *PostUpdate) ClearDeletedAt() *PostUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (pu *PostUpdate) SetUserID(id uuid.UUID) *PostUpdate {
 	pu.mutation.SetUserID(id)
@@ -201,6 +319,11 @@ func (pu *PostUpdate) SetParent(p *Post) *PostUpdate {
 	return pu.SetParentID(p.ID)
 }
 
+// SetAcceptedSolution sets the "accepted_solution" edge to the Post entity.
+func (pu *PostUpdate) SetAcceptedSolution(p *Post) *PostUpdate {
+	return pu.SetAcceptedSolutionID(p.ID)
+}
+
 // AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
 func (pu *PostUpdate) AddVoteIDs(ids ...uuid.UUID) *PostUpdate {
 	pu.mutation.AddVoteIDs(ids...)
@@ -216,6 +339,51 @@ func (pu *PostUpdate) AddVotes(v ...*Vote) *PostUpdate {
 	return pu.AddVoteIDs(ids...)
 }
 
+// AddStatusChangeIDs adds the "status_changes" edge to the StatusChange entity by IDs.
+func (pu *PostUpdate) AddStatusChangeIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.AddStatusChangeIDs(ids...)
+	return pu
+}
+
+// AddStatusChanges adds the "status_changes" edges to the StatusChange entity.
+func (pu *PostUpdate) AddStatusChanges(s ...*StatusChange) *PostUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.AddStatusChangeIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (pu *PostUpdate) AddRevisionIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.AddRevisionIDs(ids...)
+	return pu
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (pu *PostUpdate) AddRevisions(p ...*PostRevision) *PostUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddRevisionIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (pu *PostUpdate) AddAttachmentIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.AddAttachmentIDs(ids...)
+	return pu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (pu *PostUpdate) AddAttachments(a ...*Attachment) *PostUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pu.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (pu *PostUpdate) Mutation() *PostMutation {
 	return pu.mutation
@@ -260,6 +428,12 @@ func (pu *PostUpdate) ClearParent() *PostUpdate {
 	return pu
 }
 
+// ClearAcceptedSolution clears the "accepted_solution" edge to the Post entity.
+func (pu *PostUpdate) ClearAcceptedSolution() *PostUpdate {
+	pu.mutation.ClearAcceptedSolution()
+	return pu
+}
+
 // ClearVotes clears all "votes" edges to the Vote entity.
 func (pu *PostUpdate) ClearVotes() *PostUpdate {
 	pu.mutation.ClearVotes()
@@ -281,6 +455,69 @@ func (pu *PostUpdate) RemoveVotes(v ...*Vote) *PostUpdate {
 	return pu.RemoveVoteIDs(ids...)
 }
 
+// ClearStatusChanges clears all "status_changes" edges to the StatusChange entity.
+func (pu *PostUpdate) ClearStatusChanges() *PostUpdate {
+	pu.mutation.ClearStatusChanges()
+	return pu
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to StatusChange entities by IDs.
+func (pu *PostUpdate) RemoveStatusChangeIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.RemoveStatusChangeIDs(ids...)
+	return pu
+}
+
+// RemoveStatusChanges removes "status_changes" edges to StatusChange entities.
+func (pu *PostUpdate) RemoveStatusChanges(s ...*StatusChange) *PostUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.RemoveStatusChangeIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (pu *PostUpdate) ClearRevisions() *PostUpdate {
+	pu.mutation.ClearRevisions()
+	return pu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (pu *PostUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.RemoveRevisionIDs(ids...)
+	return pu
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (pu *PostUpdate) RemoveRevisions(p ...*PostRevision) *PostUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveRevisionIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (pu *PostUpdate) ClearAttachments() *PostUpdate {
+	pu.mutation.ClearAttachments()
+	return pu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (pu *PostUpdate) RemoveAttachmentIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.RemoveAttachmentIDs(ids...)
+	return pu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (pu *PostUpdate) RemoveAttachments(a ...*Attachment) *PostUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pu.RemoveAttachmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PostUpdate) Save(ctx context.Context) (int, error) {
 	pu.defaults()
@@ -329,6 +566,21 @@ func (pu *PostUpdate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Post.role": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.Status(); ok {
+		if err := post.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Post.status": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Geography(); ok {
+		if err := post.GeographyValidator(v); err != nil {
+			return &ValidationError{Name: "geography", err: fmt.Errorf(`ent: validator failed for field "Post.geography": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Address(); ok {
+		if err := post.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Post.address": %w`, err)}
+		}
+	}
 	if pu.mutation.UserCleared() && len(pu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Post.user"`)
 	}
@@ -338,6 +590,12 @@ func (pu *PostUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pu *PostUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostUpdate {
+	pu.modifiers = append(pu.modifiers, modifiers...)
+	return pu
+}
+
 func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := pu.check(); err != nil {
 		return n, err
@@ -359,9 +617,18 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.BodyCleared() {
 		_spec.ClearField(post.FieldBody, field.TypeString)
 	}
+	if value, ok := pu.mutation.BodyHTML(); ok {
+		_spec.SetField(post.FieldBodyHTML, field.TypeString, value)
+	}
+	if pu.mutation.BodyHTMLCleared() {
+		_spec.ClearField(post.FieldBodyHTML, field.TypeString)
+	}
 	if value, ok := pu.mutation.Role(); ok {
 		_spec.SetField(post.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := pu.mutation.Status(); ok {
+		_spec.SetField(post.FieldStatus, field.TypeEnum, value)
+	}
 	if value, ok := pu.mutation.UpdatedAt(); ok {
 		_spec.SetField(post.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -382,6 +649,24 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.ImageURLCleared() {
 		_spec.ClearField(post.FieldImageURL, field.TypeString)
 	}
+	if value, ok := pu.mutation.Geography(); ok {
+		_spec.SetField(post.FieldGeography, field.TypeString, value)
+	}
+	if pu.mutation.GeographyCleared() {
+		_spec.ClearField(post.FieldGeography, field.TypeString)
+	}
+	if value, ok := pu.mutation.Address(); ok {
+		_spec.SetField(post.FieldAddress, field.TypeString, value)
+	}
+	if pu.mutation.AddressCleared() {
+		_spec.ClearField(post.FieldAddress, field.TypeString)
+	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.ClearField(post.FieldDeletedAt, field.TypeTime)
+	}
 	if pu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -514,6 +799,35 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.AcceptedSolutionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.AcceptedSolutionTable,
+			Columns: []string{post.AcceptedSolutionColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.AcceptedSolutionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.AcceptedSolutionTable,
+			Columns: []string{post.AcceptedSolutionColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if pu.mutation.VotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -559,6 +873,142 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.StatusChangesTable,
+			Columns: []string{post.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !pu.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.StatusChangesTable,
+			Columns: []string{post.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.StatusChangesTable,
+			Columns: []string{post.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !pu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.AttachmentsTable,
+			Columns: []string{post.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !pu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.AttachmentsTable,
+			Columns: []string{post.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.AttachmentsTable,
+			Columns: []string{post.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{post.Label}
@@ -574,9 +1024,10 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // PostUpdateOne is the builder for updating a single Post entity.
 type PostUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PostMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetTitle sets the "title" field.
@@ -613,6 +1064,26 @@ func (puo *PostUpdateOne) ClearBody() *PostUpdateOne {
 	return puo
 }
 
+// SetBodyHTML sets the "body_html" field.
+func (puo *PostUpdateOne) SetBodyHTML(s string) *PostUpdateOne {
+	puo.mutation.SetBodyHTML(s)
+	return puo
+}
+
+// SetNillableBodyHTML sets the "body_html" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableBodyHTML(s *string) *PostUpdateOne {
+	if s != nil {
+		puo.SetBodyHTML(*s)
+	}
+	return puo
+}
+
+// ClearBodyHTML clears the value of the "body_html" field.
+func (puo *PostUpdateOne) ClearBodyHTML() *PostUpdateOne {
+	puo.mutation.ClearBodyHTML()
+	return puo
+}
+
 // SetRole sets the "role" field.
 func (puo *PostUpdateOne) SetRole(po post.Role) *PostUpdateOne {
 	puo.mutation.SetRole(po)
@@ -627,6 +1098,20 @@ func (puo *PostUpdateOne) SetNillableRole(po *post.Role) *PostUpdateOne {
 	return puo
 }
 
+// SetStatus sets the "status" field.
+func (puo *PostUpdateOne) SetStatus(po post.Status) *PostUpdateOne {
+	puo.mutation.SetStatus(po)
+	return puo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableStatus(po *post.Status) *PostUpdateOne {
+	if po != nil {
+		puo.SetStatus(*po)
+	}
+	return puo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (puo *PostUpdateOne) SetUpdatedAt(t time.Time) *PostUpdateOne {
 	puo.mutation.SetUpdatedAt(t)
@@ -691,6 +1176,86 @@ func (puo *PostUpdateOne) ClearImageURL() *PostUpdateOne {
 	return puo
 }
 
+// SetGeography sets the "geography" field.
+func (puo *PostUpdateOne) SetGeography(s string) *PostUpdateOne {
+	puo.mutation.SetGeography(s)
+	return puo
+}
+
+// SetNillableGeography sets the "geography" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableGeography(s *string) *PostUpdateOne {
+	if s != nil {
+		puo.SetGeography(*s)
+	}
+	return puo
+}
+
+// ClearGeography clears the value of the "geography" field.
+func (puo *PostUpdateOne) ClearGeography() *PostUpdateOne {
+	puo.mutation.ClearGeography()
+	return puo
+}
+
+// SetAddress sets the "address" field.
+func (puo *PostUpdateOne) SetAddress(s string) *PostUpdateOne {
+	puo.mutation.SetAddress(s)
+	return puo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableAddress(s *string) *PostUpdateOne {
+	if s != nil {
+		puo.SetAddress(*s)
+	}
+	return puo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (puo *PostUpdateOne) ClearAddress() *PostUpdateOne {
+	puo.mutation.ClearAddress()
+	return puo
+}
+
+// SetAcceptedSolutionID sets the "accepted_solution_id" field.
+func (puo *PostUpdateOne) SetAcceptedSolutionID(u uuid.UUID) *PostUpdateOne {
+	puo.mutation.SetAcceptedSolutionID(u)
+	return puo
+}
+
+// SetNillableAcceptedSolutionID sets the "accepted_solution_id" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableAcceptedSolutionID(u *uuid.UUID) *PostUpdateOne {
+	if u != nil {
+		puo.SetAcceptedSolutionID(*u)
+	}
+	return puo
+}
+
+// ClearAcceptedSolutionID clears the value of the "accepted_solution_id" field.
+func (puo *PostUpdateOne) ClearAcceptedSolutionID() *PostUpdateOne {
+	puo.mutation.ClearAcceptedSolutionID()
+	return puo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *PostUpdateOne) SetDeletedAt(t time.Time) *PostUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableDeletedAt(t *time.Time) *PostUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *PostUpdateOne) ClearDeletedAt() *PostUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (puo *PostUpdateOne) SetUserID(id uuid.UUID) *PostUpdateOne {
 	puo.mutation.SetUserID(id)
@@ -747,6 +1312,11 @@ func (puo *PostUpdateOne) SetParent(p *Post) *PostUpdateOne {
 	return puo.SetParentID(p.ID)
 }
 
+// SetAcceptedSolution sets the "accepted_solution" edge to the Post entity.
+func (puo *PostUpdateOne) SetAcceptedSolution(p *Post) *PostUpdateOne {
+	return puo.SetAcceptedSolutionID(p.ID)
+}
+
 // AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
 func (puo *PostUpdateOne) AddVoteIDs(ids ...uuid.UUID) *PostUpdateOne {
 	puo.mutation.AddVoteIDs(ids...)
@@ -762,6 +1332,51 @@ func (puo *PostUpdateOne) AddVotes(v ...*Vote) *PostUpdateOne {
 	return puo.AddVoteIDs(ids...)
 }
 
+// AddStatusChangeIDs adds the "status_changes" edge to the StatusChange entity by IDs.
+func (puo *PostUpdateOne) AddStatusChangeIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.AddStatusChangeIDs(ids...)
+	return puo
+}
+
+// AddStatusChanges adds the "status_changes" edges to the StatusChange entity.
+func (puo *PostUpdateOne) AddStatusChanges(s ...*StatusChange) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.AddStatusChangeIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (puo *PostUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.AddRevisionIDs(ids...)
+	return puo
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (puo *PostUpdateOne) AddRevisions(p ...*PostRevision) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddRevisionIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (puo *PostUpdateOne) AddAttachmentIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.AddAttachmentIDs(ids...)
+	return puo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (puo *PostUpdateOne) AddAttachments(a ...*Attachment) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return puo.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (puo *PostUpdateOne) Mutation() *PostMutation {
 	return puo.mutation
@@ -806,6 +1421,12 @@ func (puo *PostUpdateOne) ClearParent() *PostUpdateOne {
 	return puo
 }
 
+// ClearAcceptedSolution clears the "accepted_solution" edge to the Post entity.
+func (puo *PostUpdateOne) ClearAcceptedSolution() *PostUpdateOne {
+	puo.mutation.ClearAcceptedSolution()
+	return puo
+}
+
 // ClearVotes clears all "votes" edges to the Vote entity.
 func (puo *PostUpdateOne) ClearVotes() *PostUpdateOne {
 	puo.mutation.ClearVotes()
@@ -827,6 +1448,69 @@ func (puo *PostUpdateOne) RemoveVotes(v ...*Vote) *PostUpdateOne {
 	return puo.RemoveVoteIDs(ids...)
 }
 
+// ClearStatusChanges clears all "status_changes" edges to the StatusChange entity.
+func (puo *PostUpdateOne) ClearStatusChanges() *PostUpdateOne {
+	puo.mutation.ClearStatusChanges()
+	return puo
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to StatusChange entities by IDs.
+func (puo *PostUpdateOne) RemoveStatusChangeIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.RemoveStatusChangeIDs(ids...)
+	return puo
+}
+
+// RemoveStatusChanges removes "status_changes" edges to StatusChange entities.
+func (puo *PostUpdateOne) RemoveStatusChanges(s ...*StatusChange) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveStatusChangeIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (puo *PostUpdateOne) ClearRevisions() *PostUpdateOne {
+	puo.mutation.ClearRevisions()
+	return puo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (puo *PostUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.RemoveRevisionIDs(ids...)
+	return puo
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (puo *PostUpdateOne) RemoveRevisions(p ...*PostRevision) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveRevisionIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (puo *PostUpdateOne) ClearAttachments() *PostUpdateOne {
+	puo.mutation.ClearAttachments()
+	return puo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (puo *PostUpdateOne) RemoveAttachmentIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.RemoveAttachmentIDs(ids...)
+	return puo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (puo *PostUpdateOne) RemoveAttachments(a ...*Attachment) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return puo.RemoveAttachmentIDs(ids...)
+}
+
 // Where appends a list predicates to the PostUpdate builder.
 func (puo *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
 	puo.mutation.Where(ps...)
@@ -888,6 +1572,21 @@ func (puo *PostUpdateOne) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Post.role": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.Status(); ok {
+		if err := post.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Post.status": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Geography(); ok {
+		if err := post.GeographyValidator(v); err != nil {
+			return &ValidationError{Name: "geography", err: fmt.Errorf(`ent: validator failed for field "Post.geography": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Address(); ok {
+		if err := post.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Post.address": %w`, err)}
+		}
+	}
 	if puo.mutation.UserCleared() && len(puo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Post.user"`)
 	}
@@ -897,6 +1596,12 @@ func (puo *PostUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (puo *PostUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostUpdateOne {
+	puo.modifiers = append(puo.modifiers, modifiers...)
+	return puo
+}
+
 func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 	if err := puo.check(); err != nil {
 		return _node, err
@@ -935,9 +1640,18 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 	if puo.mutation.BodyCleared() {
 		_spec.ClearField(post.FieldBody, field.TypeString)
 	}
+	if value, ok := puo.mutation.BodyHTML(); ok {
+		_spec.SetField(post.FieldBodyHTML, field.TypeString, value)
+	}
+	if puo.mutation.BodyHTMLCleared() {
+		_spec.ClearField(post.FieldBodyHTML, field.TypeString)
+	}
 	if value, ok := puo.mutation.Role(); ok {
 		_spec.SetField(post.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := puo.mutation.Status(); ok {
+		_spec.SetField(post.FieldStatus, field.TypeEnum, value)
+	}
 	if value, ok := puo.mutation.UpdatedAt(); ok {
 		_spec.SetField(post.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -958,6 +1672,24 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 	if puo.mutation.ImageURLCleared() {
 		_spec.ClearField(post.FieldImageURL, field.TypeString)
 	}
+	if value, ok := puo.mutation.Geography(); ok {
+		_spec.SetField(post.FieldGeography, field.TypeString, value)
+	}
+	if puo.mutation.GeographyCleared() {
+		_spec.ClearField(post.FieldGeography, field.TypeString)
+	}
+	if value, ok := puo.mutation.Address(); ok {
+		_spec.SetField(post.FieldAddress, field.TypeString, value)
+	}
+	if puo.mutation.AddressCleared() {
+		_spec.ClearField(post.FieldAddress, field.TypeString)
+	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.ClearField(post.FieldDeletedAt, field.TypeTime)
+	}
 	if puo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1090,6 +1822,35 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.AcceptedSolutionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.AcceptedSolutionTable,
+			Columns: []string{post.AcceptedSolutionColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.AcceptedSolutionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.AcceptedSolutionTable,
+			Columns: []string{post.AcceptedSolutionColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if puo.mutation.VotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1135,6 +1896,142 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.StatusChangesTable,
+			Columns: []string{post.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !puo.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.StatusChangesTable,
+			Columns: []string{post.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.StatusChangesTable,
+			Columns: []string{post.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !puo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.AttachmentsTable,
+			Columns: []string{post.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !puo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.AttachmentsTable,
+			Columns: []string{post.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   post.AttachmentsTable,
+			Columns: []string{post.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(puo.modifiers...)
 	_node = &Post{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
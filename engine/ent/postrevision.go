@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/postrevision"
+	"fixit/engine/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// PostRevision is the model entity for the PostRevision schema.
+type PostRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PostRevisionQuery when eager-loading is set.
+	Edges              PostRevisionEdges `json:"edges"`
+	post_revision_post *uuid.UUID
+	post_revision_user *uuid.UUID
+	selectValues       sql.SelectValues
+}
+
+// PostRevisionEdges holds the relations/edges for other nodes in the graph.
+type PostRevisionEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostRevisionEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostRevisionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PostRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case postrevision.FieldTitle, postrevision.FieldBody:
+			values[i] = new(sql.NullString)
+		case postrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case postrevision.FieldID:
+			values[i] = new(uuid.UUID)
+		case postrevision.ForeignKeys[0]: // post_revision_post
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case postrevision.ForeignKeys[1]: // post_revision_user
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PostRevision fields.
+func (pr *PostRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case postrevision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pr.ID = *value
+			}
+		case postrevision.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				pr.Title = value.String
+			}
+		case postrevision.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				pr.Body = value.String
+			}
+		case postrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case postrevision.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field post_revision_post", values[i])
+			} else if value.Valid {
+				pr.post_revision_post = new(uuid.UUID)
+				*pr.post_revision_post = *value.S.(*uuid.UUID)
+			}
+		case postrevision.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field post_revision_user", values[i])
+			} else if value.Valid {
+				pr.post_revision_user = new(uuid.UUID)
+				*pr.post_revision_user = *value.S.(*uuid.UUID)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PostRevision.
+// This includes values selected through modifiers, order, etc.
+func (pr *PostRevision) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the PostRevision entity.
+func (pr *PostRevision) QueryPost() *PostQuery {
+	return NewPostRevisionClient(pr.config).QueryPost(pr)
+}
+
+// QueryUser queries the "user" edge of the PostRevision entity.
+func (pr *PostRevision) QueryUser() *UserQuery {
+	return NewPostRevisionClient(pr.config).QueryUser(pr)
+}
+
+// Update returns a builder for updating this PostRevision.
+// Note that you need to call PostRevision.Unwrap() before calling this method if this PostRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PostRevision) Update() *PostRevisionUpdateOne {
+	return NewPostRevisionClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PostRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PostRevision) Unwrap() *PostRevision {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PostRevision is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PostRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("PostRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("title=")
+	builder.WriteString(pr.Title)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(pr.Body)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PostRevisions is a parsable slice of PostRevision.
+type PostRevisions []*PostRevision
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/postrevision"
+	"fixit/engine/ent/user"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// PostRevisionCreate is the builder for creating a PostRevision entity.
+type PostRevisionCreate struct {
+	config
+	mutation *PostRevisionMutation
+	hooks    []Hook
+}
+
+// SetTitle sets the "title" field.
+func (prc *PostRevisionCreate) SetTitle(s string) *PostRevisionCreate {
+	prc.mutation.SetTitle(s)
+	return prc
+}
+
+// SetBody sets the "body" field.
+func (prc *PostRevisionCreate) SetBody(s string) *PostRevisionCreate {
+	prc.mutation.SetBody(s)
+	return prc
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (prc *PostRevisionCreate) SetNillableBody(s *string) *PostRevisionCreate {
+	if s != nil {
+		prc.SetBody(*s)
+	}
+	return prc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *PostRevisionCreate) SetCreatedAt(t time.Time) *PostRevisionCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prc *PostRevisionCreate) SetNillableCreatedAt(t *time.Time) *PostRevisionCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// SetID sets the "id" field.
+func (prc *PostRevisionCreate) SetID(u uuid.UUID) *PostRevisionCreate {
+	prc.mutation.SetID(u)
+	return prc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (prc *PostRevisionCreate) SetNillableID(u *uuid.UUID) *PostRevisionCreate {
+	if u != nil {
+		prc.SetID(*u)
+	}
+	return prc
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (prc *PostRevisionCreate) SetPostID(id uuid.UUID) *PostRevisionCreate {
+	prc.mutation.SetPostID(id)
+	return prc
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (prc *PostRevisionCreate) SetPost(p *Post) *PostRevisionCreate {
+	return prc.SetPostID(p.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (prc *PostRevisionCreate) SetUserID(id uuid.UUID) *PostRevisionCreate {
+	prc.mutation.SetUserID(id)
+	return prc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (prc *PostRevisionCreate) SetUser(u *User) *PostRevisionCreate {
+	return prc.SetUserID(u.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (prc *PostRevisionCreate) Mutation() *PostRevisionMutation {
+	return prc.mutation
+}
+
+// Save creates the PostRevision in the database.
+func (prc *PostRevisionCreate) Save(ctx context.Context) (*PostRevision, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PostRevisionCreate) SaveX(ctx context.Context) *PostRevision {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PostRevisionCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PostRevisionCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *PostRevisionCreate) defaults() {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := postrevision.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prc.mutation.ID(); !ok {
+		v := postrevision.DefaultID()
+		prc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PostRevisionCreate) check() error {
+	if _, ok := prc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "PostRevision.title"`)}
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostRevision.created_at"`)}
+	}
+	if len(prc.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "PostRevision.post"`)}
+	}
+	if len(prc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PostRevision.user"`)}
+	}
+	return nil
+}
+
+func (prc *PostRevisionCreate) sqlSave(ctx context.Context) (*PostRevision, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *PostRevisionCreate) createSpec() (*PostRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostRevision{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(postrevision.Table, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID))
+	)
+	if id, ok := prc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := prc.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := prc.mutation.Body(); ok {
+		_spec.SetField(postrevision.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := prc.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_revision_post = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := prc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postrevision.UserTable,
+			Columns: []string{postrevision.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_revision_user = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PostRevisionCreateBulk is the builder for creating many PostRevision entities in bulk.
+type PostRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*PostRevisionCreate
+}
+
+// Save creates the PostRevision entities in the database.
+func (prcb *PostRevisionCreateBulk) Save(ctx context.Context) ([]*PostRevision, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PostRevision, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PostRevisionCreateBulk) SaveX(ctx context.Context) []*PostRevision {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PostRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PostRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,713 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/postrevision"
+	"fixit/engine/ent/predicate"
+	"fixit/engine/ent/user"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// PostRevisionQuery is the builder for querying PostRevision entities.
+type PostRevisionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []postrevision.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PostRevision
+	withPost   *PostQuery
+	withUser   *UserQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PostRevisionQuery builder.
+func (prq *PostRevisionQuery) Where(ps ...predicate.PostRevision) *PostRevisionQuery {
+	prq.predicates = append(prq.predicates, ps...)
+	return prq
+}
+
+// Limit the number of records to be returned by this query.
+func (prq *PostRevisionQuery) Limit(limit int) *PostRevisionQuery {
+	prq.ctx.Limit = &limit
+	return prq
+}
+
+// Offset to start from.
+func (prq *PostRevisionQuery) Offset(offset int) *PostRevisionQuery {
+	prq.ctx.Offset = &offset
+	return prq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (prq *PostRevisionQuery) Unique(unique bool) *PostRevisionQuery {
+	prq.ctx.Unique = &unique
+	return prq
+}
+
+// Order specifies how the records should be ordered.
+func (prq *PostRevisionQuery) Order(o ...postrevision.OrderOption) *PostRevisionQuery {
+	prq.order = append(prq.order, o...)
+	return prq
+}
+
+// QueryPost chains the current query on the "post" edge.
+func (prq *PostRevisionQuery) QueryPost() *PostQuery {
+	query := (&PostClient{config: prq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(postrevision.Table, postrevision.FieldID, selector),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, postrevision.PostTable, postrevision.PostColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (prq *PostRevisionQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: prq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(postrevision.Table, postrevision.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, postrevision.UserTable, postrevision.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PostRevision entity from the query.
+// Returns a *NotFoundError when no PostRevision was found.
+func (prq *PostRevisionQuery) First(ctx context.Context) (*PostRevision, error) {
+	nodes, err := prq.Limit(1).All(setContextOp(ctx, prq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{postrevision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (prq *PostRevisionQuery) FirstX(ctx context.Context) *PostRevision {
+	node, err := prq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PostRevision ID from the query.
+// Returns a *NotFoundError when no PostRevision ID was found.
+func (prq *PostRevisionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = prq.Limit(1).IDs(setContextOp(ctx, prq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{postrevision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (prq *PostRevisionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := prq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PostRevision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PostRevision entity is found.
+// Returns a *NotFoundError when no PostRevision entities are found.
+func (prq *PostRevisionQuery) Only(ctx context.Context) (*PostRevision, error) {
+	nodes, err := prq.Limit(2).All(setContextOp(ctx, prq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{postrevision.Label}
+	default:
+		return nil, &NotSingularError{postrevision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (prq *PostRevisionQuery) OnlyX(ctx context.Context) *PostRevision {
+	node, err := prq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PostRevision ID in the query.
+// Returns a *NotSingularError when more than one PostRevision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (prq *PostRevisionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = prq.Limit(2).IDs(setContextOp(ctx, prq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{postrevision.Label}
+	default:
+		err = &NotSingularError{postrevision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (prq *PostRevisionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := prq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PostRevisions.
+func (prq *PostRevisionQuery) All(ctx context.Context) ([]*PostRevision, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryAll)
+	if err := prq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PostRevision, *PostRevisionQuery]()
+	return withInterceptors[[]*PostRevision](ctx, prq, qr, prq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (prq *PostRevisionQuery) AllX(ctx context.Context) []*PostRevision {
+	nodes, err := prq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PostRevision IDs.
+func (prq *PostRevisionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if prq.ctx.Unique == nil && prq.path != nil {
+		prq.Unique(true)
+	}
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryIDs)
+	if err = prq.Select(postrevision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (prq *PostRevisionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := prq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (prq *PostRevisionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryCount)
+	if err := prq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, prq, querierCount[*PostRevisionQuery](), prq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (prq *PostRevisionQuery) CountX(ctx context.Context) int {
+	count, err := prq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (prq *PostRevisionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryExist)
+	switch _, err := prq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (prq *PostRevisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := prq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PostRevisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (prq *PostRevisionQuery) Clone() *PostRevisionQuery {
+	if prq == nil {
+		return nil
+	}
+	return &PostRevisionQuery{
+		config:     prq.config,
+		ctx:        prq.ctx.Clone(),
+		order:      append([]postrevision.OrderOption{}, prq.order...),
+		inters:     append([]Interceptor{}, prq.inters...),
+		predicates: append([]predicate.PostRevision{}, prq.predicates...),
+		withPost:   prq.withPost.Clone(),
+		withUser:   prq.withUser.Clone(),
+		// clone intermediate query.
+		sql:       prq.sql.Clone(),
+		path:      prq.path,
+		modifiers: append([]func(*sql.Selector){}, prq.modifiers...),
+	}
+}
+
+// WithPost tells the query-builder to eager-load the nodes that are connected to
+// the "post" edge. The optional arguments are used to configure the query builder of the edge.
+func (prq *PostRevisionQuery) WithPost(opts ...func(*PostQuery)) *PostRevisionQuery {
+	query := (&PostClient{config: prq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	prq.withPost = query
+	return prq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (prq *PostRevisionQuery) WithUser(opts ...func(*UserQuery)) *PostRevisionQuery {
+	query := (&UserClient{config: prq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	prq.withUser = query
+	return prq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Title string `json:"title,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PostRevision.Query().
+//		GroupBy(postrevision.FieldTitle).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (prq *PostRevisionQuery) GroupBy(field string, fields ...string) *PostRevisionGroupBy {
+	prq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PostRevisionGroupBy{build: prq}
+	grbuild.flds = &prq.ctx.Fields
+	grbuild.label = postrevision.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Title string `json:"title,omitempty"`
+//	}
+//
+//	client.PostRevision.Query().
+//		Select(postrevision.FieldTitle).
+//		Scan(ctx, &v)
+func (prq *PostRevisionQuery) Select(fields ...string) *PostRevisionSelect {
+	prq.ctx.Fields = append(prq.ctx.Fields, fields...)
+	sbuild := &PostRevisionSelect{PostRevisionQuery: prq}
+	sbuild.label = postrevision.Label
+	sbuild.flds, sbuild.scan = &prq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PostRevisionSelect configured with the given aggregations.
+func (prq *PostRevisionQuery) Aggregate(fns ...AggregateFunc) *PostRevisionSelect {
+	return prq.Select().Aggregate(fns...)
+}
+
+func (prq *PostRevisionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range prq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, prq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range prq.ctx.Fields {
+		if !postrevision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if prq.path != nil {
+		prev, err := prq.path(ctx)
+		if err != nil {
+			return err
+		}
+		prq.sql = prev
+	}
+	return nil
+}
+
+func (prq *PostRevisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PostRevision, error) {
+	var (
+		nodes       = []*PostRevision{}
+		withFKs     = prq.withFKs
+		_spec       = prq.querySpec()
+		loadedTypes = [2]bool{
+			prq.withPost != nil,
+			prq.withUser != nil,
+		}
+	)
+	if prq.withPost != nil || prq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, postrevision.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PostRevision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PostRevision{config: prq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(prq.modifiers) > 0 {
+		_spec.Modifiers = prq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, prq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := prq.withPost; query != nil {
+		if err := prq.loadPost(ctx, query, nodes, nil,
+			func(n *PostRevision, e *Post) { n.Edges.Post = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := prq.withUser; query != nil {
+		if err := prq.loadUser(ctx, query, nodes, nil,
+			func(n *PostRevision, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (prq *PostRevisionQuery) loadPost(ctx context.Context, query *PostQuery, nodes []*PostRevision, init func(*PostRevision), assign func(*PostRevision, *Post)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PostRevision)
+	for i := range nodes {
+		if nodes[i].post_revision_post == nil {
+			continue
+		}
+		fk := *nodes[i].post_revision_post
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(post.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "post_revision_post" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (prq *PostRevisionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*PostRevision, init func(*PostRevision), assign func(*PostRevision, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PostRevision)
+	for i := range nodes {
+		if nodes[i].post_revision_user == nil {
+			continue
+		}
+		fk := *nodes[i].post_revision_user
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "post_revision_user" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (prq *PostRevisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := prq.querySpec()
+	if len(prq.modifiers) > 0 {
+		_spec.Modifiers = prq.modifiers
+	}
+	_spec.Node.Columns = prq.ctx.Fields
+	if len(prq.ctx.Fields) > 0 {
+		_spec.Unique = prq.ctx.Unique != nil && *prq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, prq.driver, _spec)
+}
+
+func (prq *PostRevisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID))
+	_spec.From = prq.sql
+	if unique := prq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if prq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := prq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postrevision.FieldID)
+		for i := range fields {
+			if fields[i] != postrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := prq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := prq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := prq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := prq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (prq *PostRevisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(prq.driver.Dialect())
+	t1 := builder.Table(postrevision.Table)
+	columns := prq.ctx.Fields
+	if len(columns) == 0 {
+		columns = postrevision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if prq.sql != nil {
+		selector = prq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if prq.ctx.Unique != nil && *prq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range prq.modifiers {
+		m(selector)
+	}
+	for _, p := range prq.predicates {
+		p(selector)
+	}
+	for _, p := range prq.order {
+		p(selector)
+	}
+	if offset := prq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := prq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (prq *PostRevisionQuery) Modify(modifiers ...func(s *sql.Selector)) *PostRevisionSelect {
+	prq.modifiers = append(prq.modifiers, modifiers...)
+	return prq.Select()
+}
+
+// PostRevisionGroupBy is the group-by builder for PostRevision entities.
+type PostRevisionGroupBy struct {
+	selector
+	build *PostRevisionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (prgb *PostRevisionGroupBy) Aggregate(fns ...AggregateFunc) *PostRevisionGroupBy {
+	prgb.fns = append(prgb.fns, fns...)
+	return prgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prgb *PostRevisionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, prgb.build.ctx, ent.OpQueryGroupBy)
+	if err := prgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PostRevisionQuery, *PostRevisionGroupBy](ctx, prgb.build, prgb, prgb.build.inters, v)
+}
+
+func (prgb *PostRevisionGroupBy) sqlScan(ctx context.Context, root *PostRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(prgb.fns))
+	for _, fn := range prgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*prgb.flds)+len(prgb.fns))
+		for _, f := range *prgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*prgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PostRevisionSelect is the builder for selecting fields of PostRevision entities.
+type PostRevisionSelect struct {
+	*PostRevisionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (prs *PostRevisionSelect) Aggregate(fns ...AggregateFunc) *PostRevisionSelect {
+	prs.fns = append(prs.fns, fns...)
+	return prs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prs *PostRevisionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, prs.ctx, ent.OpQuerySelect)
+	if err := prs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PostRevisionQuery, *PostRevisionSelect](ctx, prs.PostRevisionQuery, prs, prs.inters, v)
+}
+
+func (prs *PostRevisionSelect) sqlScan(ctx context.Context, root *PostRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(prs.fns))
+	for _, fn := range prs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*prs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (prs *PostRevisionSelect) Modify(modifiers ...func(s *sql.Selector)) *PostRevisionSelect {
+	prs.modifiers = append(prs.modifiers, modifiers...)
+	return prs
+}
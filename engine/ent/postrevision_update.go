@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/postrevision"
+	"fixit/engine/ent/predicate"
+	"fixit/engine/ent/user"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// PostRevisionUpdate is the builder for updating PostRevision entities.
+type PostRevisionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PostRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (pru *PostRevisionUpdate) Where(ps ...predicate.PostRevision) *PostRevisionUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetTitle sets the "title" field.
+func (pru *PostRevisionUpdate) SetTitle(s string) *PostRevisionUpdate {
+	pru.mutation.SetTitle(s)
+	return pru
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (pru *PostRevisionUpdate) SetNillableTitle(s *string) *PostRevisionUpdate {
+	if s != nil {
+		pru.SetTitle(*s)
+	}
+	return pru
+}
+
+// SetBody sets the "body" field.
+func (pru *PostRevisionUpdate) SetBody(s string) *PostRevisionUpdate {
+	pru.mutation.SetBody(s)
+	return pru
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (pru *PostRevisionUpdate) SetNillableBody(s *string) *PostRevisionUpdate {
+	if s != nil {
+		pru.SetBody(*s)
+	}
+	return pru
+}
+
+// ClearBody clears the value of the "body" field.
+func (pru *PostRevisionUpdate) ClearBody() *PostRevisionUpdate {
+	pru.mutation.ClearBody()
+	return pru
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pru *PostRevisionUpdate) SetPostID(id uuid.UUID) *PostRevisionUpdate {
+	pru.mutation.SetPostID(id)
+	return pru
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pru *PostRevisionUpdate) SetPost(p *Post) *PostRevisionUpdate {
+	return pru.SetPostID(p.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pru *PostRevisionUpdate) SetUserID(id uuid.UUID) *PostRevisionUpdate {
+	pru.mutation.SetUserID(id)
+	return pru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pru *PostRevisionUpdate) SetUser(u *User) *PostRevisionUpdate {
+	return pru.SetUserID(u.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (pru *PostRevisionUpdate) Mutation() *PostRevisionMutation {
+	return pru.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (pru *PostRevisionUpdate) ClearPost() *PostRevisionUpdate {
+	pru.mutation.ClearPost()
+	return pru
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pru *PostRevisionUpdate) ClearUser() *PostRevisionUpdate {
+	pru.mutation.ClearUser()
+	return pru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PostRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PostRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PostRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PostRevisionUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PostRevisionUpdate) check() error {
+	if pru.mutation.PostCleared() && len(pru.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	if pru.mutation.UserCleared() && len(pru.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pru *PostRevisionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostRevisionUpdate {
+	pru.modifiers = append(pru.modifiers, modifiers...)
+	return pru
+}
+
+func (pru *PostRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.Body(); ok {
+		_spec.SetField(postrevision.FieldBody, field.TypeString, value)
+	}
+	if pru.mutation.BodyCleared() {
+		_spec.ClearField(postrevision.FieldBody, field.TypeString)
+	}
+	if pru.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postrevision.UserTable,
+			Columns: []string{postrevision.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postrevision.UserTable,
+			Columns: []string{postrevision.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PostRevisionUpdateOne is the builder for updating a single PostRevision entity.
+type PostRevisionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PostRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTitle sets the "title" field.
+func (pruo *PostRevisionUpdateOne) SetTitle(s string) *PostRevisionUpdateOne {
+	pruo.mutation.SetTitle(s)
+	return pruo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (pruo *PostRevisionUpdateOne) SetNillableTitle(s *string) *PostRevisionUpdateOne {
+	if s != nil {
+		pruo.SetTitle(*s)
+	}
+	return pruo
+}
+
+// SetBody sets the "body" field.
+func (pruo *PostRevisionUpdateOne) SetBody(s string) *PostRevisionUpdateOne {
+	pruo.mutation.SetBody(s)
+	return pruo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (pruo *PostRevisionUpdateOne) SetNillableBody(s *string) *PostRevisionUpdateOne {
+	if s != nil {
+		pruo.SetBody(*s)
+	}
+	return pruo
+}
+
+// ClearBody clears the value of the "body" field.
+func (pruo *PostRevisionUpdateOne) ClearBody() *PostRevisionUpdateOne {
+	pruo.mutation.ClearBody()
+	return pruo
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pruo *PostRevisionUpdateOne) SetPostID(id uuid.UUID) *PostRevisionUpdateOne {
+	pruo.mutation.SetPostID(id)
+	return pruo
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pruo *PostRevisionUpdateOne) SetPost(p *Post) *PostRevisionUpdateOne {
+	return pruo.SetPostID(p.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pruo *PostRevisionUpdateOne) SetUserID(id uuid.UUID) *PostRevisionUpdateOne {
+	pruo.mutation.SetUserID(id)
+	return pruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pruo *PostRevisionUpdateOne) SetUser(u *User) *PostRevisionUpdateOne {
+	return pruo.SetUserID(u.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (pruo *PostRevisionUpdateOne) Mutation() *PostRevisionMutation {
+	return pruo.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (pruo *PostRevisionUpdateOne) ClearPost() *PostRevisionUpdateOne {
+	pruo.mutation.ClearPost()
+	return pruo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pruo *PostRevisionUpdateOne) ClearUser() *PostRevisionUpdateOne {
+	pruo.mutation.ClearUser()
+	return pruo
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (pruo *PostRevisionUpdateOne) Where(ps ...predicate.PostRevision) *PostRevisionUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PostRevisionUpdateOne) Select(field string, fields ...string) *PostRevisionUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PostRevision entity.
+func (pruo *PostRevisionUpdateOne) Save(ctx context.Context) (*PostRevision, error) {
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PostRevisionUpdateOne) SaveX(ctx context.Context) *PostRevision {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PostRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PostRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PostRevisionUpdateOne) check() error {
+	if pruo.mutation.PostCleared() && len(pruo.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	if pruo.mutation.UserCleared() && len(pruo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pruo *PostRevisionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostRevisionUpdateOne {
+	pruo.modifiers = append(pruo.modifiers, modifiers...)
+	return pruo
+}
+
+func (pruo *PostRevisionUpdateOne) sqlSave(ctx context.Context) (_node *PostRevision, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postrevision.FieldID)
+		for _, f := range fields {
+			if !postrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.Body(); ok {
+		_spec.SetField(postrevision.FieldBody, field.TypeString, value)
+	}
+	if pruo.mutation.BodyCleared() {
+		_spec.ClearField(postrevision.FieldBody, field.TypeString)
+	}
+	if pruo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postrevision.UserTable,
+			Columns: []string{postrevision.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   postrevision.UserTable,
+			Columns: []string{postrevision.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pruo.modifiers...)
+	_node = &PostRevision{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
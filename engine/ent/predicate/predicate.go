@@ -6,12 +6,39 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Attachment is the predicate function for attachment builders.
+type Attachment func(*sql.Selector)
+
+// Blob is the predicate function for blob builders.
+type Blob func(*sql.Selector)
+
 // Community is the predicate function for community builders.
 type Community func(*sql.Selector)
 
+// File is the predicate function for file builders.
+type File func(*sql.Selector)
+
+// Identity is the predicate function for identity builders.
+type Identity func(*sql.Selector)
+
+// Membership is the predicate function for membership builders.
+type Membership func(*sql.Selector)
+
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
+// PostRevision is the predicate function for postrevision builders.
+type PostRevision func(*sql.Selector)
+
+// RememberToken is the predicate function for remembertoken builders.
+type RememberToken func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// StatusChange is the predicate function for statuschange builders.
+type StatusChange func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
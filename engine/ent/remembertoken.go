@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fixit/engine/ent/remembertoken"
+	"fixit/engine/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// RememberToken is the model entity for the RememberToken schema.
+type RememberToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RememberTokenQuery when eager-loading is set.
+	Edges        RememberTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RememberTokenEdges holds the relations/edges for other nodes in the graph.
+type RememberTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RememberTokenEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RememberToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case remembertoken.FieldToken:
+			values[i] = new(sql.NullString)
+		case remembertoken.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case remembertoken.FieldID, remembertoken.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RememberToken fields.
+func (rt *RememberToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case remembertoken.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rt.ID = *value
+			}
+		case remembertoken.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				rt.UserID = *value
+			}
+		case remembertoken.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				rt.Token = value.String
+			}
+		case remembertoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rt.CreatedAt = value.Time
+			}
+		default:
+			rt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RememberToken.
+// This includes values selected through modifiers, order, etc.
+func (rt *RememberToken) Value(name string) (ent.Value, error) {
+	return rt.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the RememberToken entity.
+func (rt *RememberToken) QueryUser() *UserQuery {
+	return NewRememberTokenClient(rt.config).QueryUser(rt)
+}
+
+// Update returns a builder for updating this RememberToken.
+// Note that you need to call RememberToken.Unwrap() before calling this method if this RememberToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rt *RememberToken) Update() *RememberTokenUpdateOne {
+	return NewRememberTokenClient(rt.config).UpdateOne(rt)
+}
+
+// Unwrap unwraps the RememberToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rt *RememberToken) Unwrap() *RememberToken {
+	_tx, ok := rt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RememberToken is not a transactional entity")
+	}
+	rt.config.driver = _tx.drv
+	return rt
+}
+
+// String implements the fmt.Stringer.
+func (rt *RememberToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("RememberToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rt.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rt.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RememberTokens is a parsable slice of RememberToken.
+type RememberTokens []*RememberToken
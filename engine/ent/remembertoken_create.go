@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/remembertoken"
+	"fixit/engine/ent/user"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// RememberTokenCreate is the builder for creating a RememberToken entity.
+type RememberTokenCreate struct {
+	config
+	mutation *RememberTokenMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (rtc *RememberTokenCreate) SetUserID(u uuid.UUID) *RememberTokenCreate {
+	rtc.mutation.SetUserID(u)
+	return rtc
+}
+
+// SetToken sets the "token" field.
+func (rtc *RememberTokenCreate) SetToken(s string) *RememberTokenCreate {
+	rtc.mutation.SetToken(s)
+	return rtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rtc *RememberTokenCreate) SetCreatedAt(t time.Time) *RememberTokenCreate {
+	rtc.mutation.SetCreatedAt(t)
+	return rtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rtc *RememberTokenCreate) SetNillableCreatedAt(t *time.Time) *RememberTokenCreate {
+	if t != nil {
+		rtc.SetCreatedAt(*t)
+	}
+	return rtc
+}
+
+// SetID sets the "id" field.
+func (rtc *RememberTokenCreate) SetID(u uuid.UUID) *RememberTokenCreate {
+	rtc.mutation.SetID(u)
+	return rtc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rtc *RememberTokenCreate) SetNillableID(u *uuid.UUID) *RememberTokenCreate {
+	if u != nil {
+		rtc.SetID(*u)
+	}
+	return rtc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rtc *RememberTokenCreate) SetUser(u *User) *RememberTokenCreate {
+	return rtc.SetUserID(u.ID)
+}
+
+// Mutation returns the RememberTokenMutation object of the builder.
+func (rtc *RememberTokenCreate) Mutation() *RememberTokenMutation {
+	return rtc.mutation
+}
+
+// Save creates the RememberToken in the database.
+func (rtc *RememberTokenCreate) Save(ctx context.Context) (*RememberToken, error) {
+	rtc.defaults()
+	return withHooks(ctx, rtc.sqlSave, rtc.mutation, rtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rtc *RememberTokenCreate) SaveX(ctx context.Context) *RememberToken {
+	v, err := rtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtc *RememberTokenCreate) Exec(ctx context.Context) error {
+	_, err := rtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtc *RememberTokenCreate) ExecX(ctx context.Context) {
+	if err := rtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtc *RememberTokenCreate) defaults() {
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		v := remembertoken.DefaultCreatedAt()
+		rtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rtc.mutation.ID(); !ok {
+		v := remembertoken.DefaultID()
+		rtc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtc *RememberTokenCreate) check() error {
+	if _, ok := rtc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RememberToken.user_id"`)}
+	}
+	if _, ok := rtc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "RememberToken.token"`)}
+	}
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RememberToken.created_at"`)}
+	}
+	if len(rtc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "RememberToken.user"`)}
+	}
+	return nil
+}
+
+func (rtc *RememberTokenCreate) sqlSave(ctx context.Context) (*RememberToken, error) {
+	if err := rtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rtc.mutation.id = &_node.ID
+	rtc.mutation.done = true
+	return _node, nil
+}
+
+func (rtc *RememberTokenCreate) createSpec() (*RememberToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RememberToken{config: rtc.config}
+		_spec = sqlgraph.NewCreateSpec(remembertoken.Table, sqlgraph.NewFieldSpec(remembertoken.FieldID, field.TypeUUID))
+	)
+	if id, ok := rtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rtc.mutation.Token(); ok {
+		_spec.SetField(remembertoken.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := rtc.mutation.CreatedAt(); ok {
+		_spec.SetField(remembertoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rtc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   remembertoken.UserTable,
+			Columns: []string{remembertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RememberTokenCreateBulk is the builder for creating many RememberToken entities in bulk.
+type RememberTokenCreateBulk struct {
+	config
+	err      error
+	builders []*RememberTokenCreate
+}
+
+// Save creates the RememberToken entities in the database.
+func (rtcb *RememberTokenCreateBulk) Save(ctx context.Context) ([]*RememberToken, error) {
+	if rtcb.err != nil {
+		return nil, rtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rtcb.builders))
+	nodes := make([]*RememberToken, len(rtcb.builders))
+	mutators := make([]Mutator, len(rtcb.builders))
+	for i := range rtcb.builders {
+		func(i int, root context.Context) {
+			builder := rtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RememberTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtcb *RememberTokenCreateBulk) SaveX(ctx context.Context) []*RememberToken {
+	v, err := rtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtcb *RememberTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := rtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtcb *RememberTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := rtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fixit/engine/ent/predicate"
+	"fixit/engine/ent/remembertoken"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RememberTokenDelete is the builder for deleting a RememberToken entity.
+type RememberTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *RememberTokenMutation
+}
+
+// Where appends a list predicates to the RememberTokenDelete builder.
+func (rtd *RememberTokenDelete) Where(ps ...predicate.RememberToken) *RememberTokenDelete {
+	rtd.mutation.Where(ps...)
+	return rtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rtd *RememberTokenDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rtd.sqlExec, rtd.mutation, rtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtd *RememberTokenDelete) ExecX(ctx context.Context) int {
+	n, err := rtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rtd *RememberTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(remembertoken.Table, sqlgraph.NewFieldSpec(remembertoken.FieldID, field.TypeUUID))
+	if ps := rtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rtd.mutation.done = true
+	return affected, err
+}
+
+// RememberTokenDeleteOne is the builder for deleting a single RememberToken entity.
+type RememberTokenDeleteOne struct {
+	rtd *RememberTokenDelete
+}
+
+// Where appends a list predicates to the RememberTokenDelete builder.
+func (rtdo *RememberTokenDeleteOne) Where(ps ...predicate.RememberToken) *RememberTokenDeleteOne {
+	rtdo.rtd.mutation.Where(ps...)
+	return rtdo
+}
+
+// Exec executes the deletion query.
+func (rtdo *RememberTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := rtdo.rtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{remembertoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtdo *RememberTokenDeleteOne) ExecX(ctx context.Context) {
+	if err := rtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
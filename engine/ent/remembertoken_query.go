@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fixit/engine/ent/predicate"
+	"fixit/engine/ent/remembertoken"
+	"fixit/engine/ent/user"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// RememberTokenQuery is the builder for querying RememberToken entities.
+type RememberTokenQuery struct {
+	config
+	ctx        *QueryContext
+	order      []remembertoken.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RememberToken
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RememberTokenQuery builder.
+func (rtq *RememberTokenQuery) Where(ps ...predicate.RememberToken) *RememberTokenQuery {
+	rtq.predicates = append(rtq.predicates, ps...)
+	return rtq
+}
+
+// Limit the number of records to be returned by this query.
+func (rtq *RememberTokenQuery) Limit(limit int) *RememberTokenQuery {
+	rtq.ctx.Limit = &limit
+	return rtq
+}
+
+// Offset to start from.
+func (rtq *RememberTokenQuery) Offset(offset int) *RememberTokenQuery {
+	rtq.ctx.Offset = &offset
+	return rtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rtq *RememberTokenQuery) Unique(unique bool) *RememberTokenQuery {
+	rtq.ctx.Unique = &unique
+	return rtq
+}
+
+// Order specifies how the records should be ordered.
+func (rtq *RememberTokenQuery) Order(o ...remembertoken.OrderOption) *RememberTokenQuery {
+	rtq.order = append(rtq.order, o...)
+	return rtq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (rtq *RememberTokenQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: rtq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rtq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(remembertoken.Table, remembertoken.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, remembertoken.UserTable, remembertoken.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RememberToken entity from the query.
+// Returns a *NotFoundError when no RememberToken was found.
+func (rtq *RememberTokenQuery) First(ctx context.Context) (*RememberToken, error) {
+	nodes, err := rtq.Limit(1).All(setContextOp(ctx, rtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{remembertoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rtq *RememberTokenQuery) FirstX(ctx context.Context) *RememberToken {
+	node, err := rtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RememberToken ID from the query.
+// Returns a *NotFoundError when no RememberToken ID was found.
+func (rtq *RememberTokenQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rtq.Limit(1).IDs(setContextOp(ctx, rtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{remembertoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rtq *RememberTokenQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RememberToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RememberToken entity is found.
+// Returns a *NotFoundError when no RememberToken entities are found.
+func (rtq *RememberTokenQuery) Only(ctx context.Context) (*RememberToken, error) {
+	nodes, err := rtq.Limit(2).All(setContextOp(ctx, rtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{remembertoken.Label}
+	default:
+		return nil, &NotSingularError{remembertoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rtq *RememberTokenQuery) OnlyX(ctx context.Context) *RememberToken {
+	node, err := rtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RememberToken ID in the query.
+// Returns a *NotSingularError when more than one RememberToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rtq *RememberTokenQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rtq.Limit(2).IDs(setContextOp(ctx, rtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{remembertoken.Label}
+	default:
+		err = &NotSingularError{remembertoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rtq *RememberTokenQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RememberTokens.
+func (rtq *RememberTokenQuery) All(ctx context.Context) ([]*RememberToken, error) {
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryAll)
+	if err := rtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RememberToken, *RememberTokenQuery]()
+	return withInterceptors[[]*RememberToken](ctx, rtq, qr, rtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rtq *RememberTokenQuery) AllX(ctx context.Context) []*RememberToken {
+	nodes, err := rtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RememberToken IDs.
+func (rtq *RememberTokenQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if rtq.ctx.Unique == nil && rtq.path != nil {
+		rtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryIDs)
+	if err = rtq.Select(remembertoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rtq *RememberTokenQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rtq *RememberTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryCount)
+	if err := rtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rtq, querierCount[*RememberTokenQuery](), rtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rtq *RememberTokenQuery) CountX(ctx context.Context) int {
+	count, err := rtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rtq *RememberTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryExist)
+	switch _, err := rtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rtq *RememberTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := rtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RememberTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rtq *RememberTokenQuery) Clone() *RememberTokenQuery {
+	if rtq == nil {
+		return nil
+	}
+	return &RememberTokenQuery{
+		config:     rtq.config,
+		ctx:        rtq.ctx.Clone(),
+		order:      append([]remembertoken.OrderOption{}, rtq.order...),
+		inters:     append([]Interceptor{}, rtq.inters...),
+		predicates: append([]predicate.RememberToken{}, rtq.predicates...),
+		withUser:   rtq.withUser.Clone(),
+		// clone intermediate query.
+		sql:       rtq.sql.Clone(),
+		path:      rtq.path,
+		modifiers: append([]func(*sql.Selector){}, rtq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (rtq *RememberTokenQuery) WithUser(opts ...func(*UserQuery)) *RememberTokenQuery {
+	query := (&UserClient{config: rtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rtq.withUser = query
+	return rtq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RememberToken.Query().
+//		GroupBy(remembertoken.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rtq *RememberTokenQuery) GroupBy(field string, fields ...string) *RememberTokenGroupBy {
+	rtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RememberTokenGroupBy{build: rtq}
+	grbuild.flds = &rtq.ctx.Fields
+	grbuild.label = remembertoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.RememberToken.Query().
+//		Select(remembertoken.FieldUserID).
+//		Scan(ctx, &v)
+func (rtq *RememberTokenQuery) Select(fields ...string) *RememberTokenSelect {
+	rtq.ctx.Fields = append(rtq.ctx.Fields, fields...)
+	sbuild := &RememberTokenSelect{RememberTokenQuery: rtq}
+	sbuild.label = remembertoken.Label
+	sbuild.flds, sbuild.scan = &rtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RememberTokenSelect configured with the given aggregations.
+func (rtq *RememberTokenQuery) Aggregate(fns ...AggregateFunc) *RememberTokenSelect {
+	return rtq.Select().Aggregate(fns...)
+}
+
+func (rtq *RememberTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rtq.ctx.Fields {
+		if !remembertoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rtq.path != nil {
+		prev, err := rtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rtq.sql = prev
+	}
+	return nil
+}
+
+func (rtq *RememberTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RememberToken, error) {
+	var (
+		nodes       = []*RememberToken{}
+		_spec       = rtq.querySpec()
+		loadedTypes = [1]bool{
+			rtq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RememberToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RememberToken{config: rtq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rtq.modifiers) > 0 {
+		_spec.Modifiers = rtq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rtq.withUser; query != nil {
+		if err := rtq.loadUser(ctx, query, nodes, nil,
+			func(n *RememberToken, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rtq *RememberTokenQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*RememberToken, init func(*RememberToken), assign func(*RememberToken, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*RememberToken)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rtq *RememberTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rtq.querySpec()
+	if len(rtq.modifiers) > 0 {
+		_spec.Modifiers = rtq.modifiers
+	}
+	_spec.Node.Columns = rtq.ctx.Fields
+	if len(rtq.ctx.Fields) > 0 {
+		_spec.Unique = rtq.ctx.Unique != nil && *rtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rtq.driver, _spec)
+}
+
+func (rtq *RememberTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(remembertoken.Table, remembertoken.Columns, sqlgraph.NewFieldSpec(remembertoken.FieldID, field.TypeUUID))
+	_spec.From = rtq.sql
+	if unique := rtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, remembertoken.FieldID)
+		for i := range fields {
+			if fields[i] != remembertoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rtq.withUser != nil {
+			_spec.Node.AddColumnOnce(remembertoken.FieldUserID)
+		}
+	}
+	if ps := rtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rtq *RememberTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rtq.driver.Dialect())
+	t1 := builder.Table(remembertoken.Table)
+	columns := rtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = remembertoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rtq.sql != nil {
+		selector = rtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rtq.ctx.Unique != nil && *rtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rtq.modifiers {
+		m(selector)
+	}
+	for _, p := range rtq.predicates {
+		p(selector)
+	}
+	for _, p := range rtq.order {
+		p(selector)
+	}
+	if offset := rtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rtq *RememberTokenQuery) Modify(modifiers ...func(s *sql.Selector)) *RememberTokenSelect {
+	rtq.modifiers = append(rtq.modifiers, modifiers...)
+	return rtq.Select()
+}
+
+// RememberTokenGroupBy is the group-by builder for RememberToken entities.
+type RememberTokenGroupBy struct {
+	selector
+	build *RememberTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rtgb *RememberTokenGroupBy) Aggregate(fns ...AggregateFunc) *RememberTokenGroupBy {
+	rtgb.fns = append(rtgb.fns, fns...)
+	return rtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rtgb *RememberTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RememberTokenQuery, *RememberTokenGroupBy](ctx, rtgb.build, rtgb, rtgb.build.inters, v)
+}
+
+func (rtgb *RememberTokenGroupBy) sqlScan(ctx context.Context, root *RememberTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rtgb.fns))
+	for _, fn := range rtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rtgb.flds)+len(rtgb.fns))
+		for _, f := range *rtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RememberTokenSelect is the builder for selecting fields of RememberToken entities.
+type RememberTokenSelect struct {
+	*RememberTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rts *RememberTokenSelect) Aggregate(fns ...AggregateFunc) *RememberTokenSelect {
+	rts.fns = append(rts.fns, fns...)
+	return rts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rts *RememberTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rts.ctx, ent.OpQuerySelect)
+	if err := rts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RememberTokenQuery, *RememberTokenSelect](ctx, rts.RememberTokenQuery, rts, rts.inters, v)
+}
+
+func (rts *RememberTokenSelect) sqlScan(ctx context.Context, root *RememberTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rts.fns))
+	for _, fn := range rts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rts *RememberTokenSelect) Modify(modifiers ...func(s *sql.Selector)) *RememberTokenSelect {
+	rts.modifiers = append(rts.modifiers, modifiers...)
+	return rts
+}
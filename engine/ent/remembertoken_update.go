@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/predicate"
+	"fixit/engine/ent/remembertoken"
+	"fixit/engine/ent/user"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// RememberTokenUpdate is the builder for updating RememberToken entities.
+type RememberTokenUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RememberTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RememberTokenUpdate builder.
+func (rtu *RememberTokenUpdate) Where(ps ...predicate.RememberToken) *RememberTokenUpdate {
+	rtu.mutation.Where(ps...)
+	return rtu
+}
+
+// SetUserID sets the "user_id" field.
+func (rtu *RememberTokenUpdate) SetUserID(u uuid.UUID) *RememberTokenUpdate {
+	rtu.mutation.SetUserID(u)
+	return rtu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rtu *RememberTokenUpdate) SetNillableUserID(u *uuid.UUID) *RememberTokenUpdate {
+	if u != nil {
+		rtu.SetUserID(*u)
+	}
+	return rtu
+}
+
+// SetToken sets the "token" field.
+func (rtu *RememberTokenUpdate) SetToken(s string) *RememberTokenUpdate {
+	rtu.mutation.SetToken(s)
+	return rtu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (rtu *RememberTokenUpdate) SetNillableToken(s *string) *RememberTokenUpdate {
+	if s != nil {
+		rtu.SetToken(*s)
+	}
+	return rtu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rtu *RememberTokenUpdate) SetUser(u *User) *RememberTokenUpdate {
+	return rtu.SetUserID(u.ID)
+}
+
+// Mutation returns the RememberTokenMutation object of the builder.
+func (rtu *RememberTokenUpdate) Mutation() *RememberTokenMutation {
+	return rtu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rtu *RememberTokenUpdate) ClearUser() *RememberTokenUpdate {
+	rtu.mutation.ClearUser()
+	return rtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *RememberTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rtu.sqlSave, rtu.mutation, rtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *RememberTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *RememberTokenUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *RememberTokenUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtu *RememberTokenUpdate) check() error {
+	if rtu.mutation.UserCleared() && len(rtu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RememberToken.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rtu *RememberTokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RememberTokenUpdate {
+	rtu.modifiers = append(rtu.modifiers, modifiers...)
+	return rtu
+}
+
+func (rtu *RememberTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(remembertoken.Table, remembertoken.Columns, sqlgraph.NewFieldSpec(remembertoken.FieldID, field.TypeUUID))
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtu.mutation.Token(); ok {
+		_spec.SetField(remembertoken.FieldToken, field.TypeString, value)
+	}
+	if rtu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   remembertoken.UserTable,
+			Columns: []string{remembertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   remembertoken.UserTable,
+			Columns: []string{remembertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(rtu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{remembertoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rtu.mutation.done = true
+	return n, nil
+}
+
+// RememberTokenUpdateOne is the builder for updating a single RememberToken entity.
+type RememberTokenUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RememberTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (rtuo *RememberTokenUpdateOne) SetUserID(u uuid.UUID) *RememberTokenUpdateOne {
+	rtuo.mutation.SetUserID(u)
+	return rtuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rtuo *RememberTokenUpdateOne) SetNillableUserID(u *uuid.UUID) *RememberTokenUpdateOne {
+	if u != nil {
+		rtuo.SetUserID(*u)
+	}
+	return rtuo
+}
+
+// SetToken sets the "token" field.
+func (rtuo *RememberTokenUpdateOne) SetToken(s string) *RememberTokenUpdateOne {
+	rtuo.mutation.SetToken(s)
+	return rtuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (rtuo *RememberTokenUpdateOne) SetNillableToken(s *string) *RememberTokenUpdateOne {
+	if s != nil {
+		rtuo.SetToken(*s)
+	}
+	return rtuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rtuo *RememberTokenUpdateOne) SetUser(u *User) *RememberTokenUpdateOne {
+	return rtuo.SetUserID(u.ID)
+}
+
+// Mutation returns the RememberTokenMutation object of the builder.
+func (rtuo *RememberTokenUpdateOne) Mutation() *RememberTokenMutation {
+	return rtuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rtuo *RememberTokenUpdateOne) ClearUser() *RememberTokenUpdateOne {
+	rtuo.mutation.ClearUser()
+	return rtuo
+}
+
+// Where appends a list predicates to the RememberTokenUpdate builder.
+func (rtuo *RememberTokenUpdateOne) Where(ps ...predicate.RememberToken) *RememberTokenUpdateOne {
+	rtuo.mutation.Where(ps...)
+	return rtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rtuo *RememberTokenUpdateOne) Select(field string, fields ...string) *RememberTokenUpdateOne {
+	rtuo.fields = append([]string{field}, fields...)
+	return rtuo
+}
+
+// Save executes the query and returns the updated RememberToken entity.
+func (rtuo *RememberTokenUpdateOne) Save(ctx context.Context) (*RememberToken, error) {
+	return withHooks(ctx, rtuo.sqlSave, rtuo.mutation, rtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *RememberTokenUpdateOne) SaveX(ctx context.Context) *RememberToken {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *RememberTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *RememberTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtuo *RememberTokenUpdateOne) check() error {
+	if rtuo.mutation.UserCleared() && len(rtuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RememberToken.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rtuo *RememberTokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RememberTokenUpdateOne {
+	rtuo.modifiers = append(rtuo.modifiers, modifiers...)
+	return rtuo
+}
+
+func (rtuo *RememberTokenUpdateOne) sqlSave(ctx context.Context) (_node *RememberToken, err error) {
+	if err := rtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(remembertoken.Table, remembertoken.Columns, sqlgraph.NewFieldSpec(remembertoken.FieldID, field.TypeUUID))
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RememberToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, remembertoken.FieldID)
+		for _, f := range fields {
+			if !remembertoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != remembertoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtuo.mutation.Token(); ok {
+		_spec.SetField(remembertoken.FieldToken, field.TypeString, value)
+	}
+	if rtuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   remembertoken.UserTable,
+			Columns: []string{remembertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   remembertoken.UserTable,
+			Columns: []string{remembertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(rtuo.modifiers...)
+	_node = &RememberToken{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{remembertoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rtuo.mutation.done = true
+	return _node, nil
+}
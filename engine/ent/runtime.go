@@ -3,9 +3,18 @@
 package ent
 
 import (
+	"fixit/engine/ent/attachment"
+	"fixit/engine/ent/blob"
 	"fixit/engine/ent/community"
+	"fixit/engine/ent/file"
+	"fixit/engine/ent/identity"
+	"fixit/engine/ent/membership"
 	"fixit/engine/ent/post"
+	"fixit/engine/ent/postrevision"
+	"fixit/engine/ent/remembertoken"
 	"fixit/engine/ent/schema"
+	"fixit/engine/ent/session"
+	"fixit/engine/ent/statuschange"
 	"fixit/engine/ent/user"
 	"fixit/engine/ent/vote"
 	"time"
@@ -17,6 +26,56 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescCaption is the schema descriptor for caption field.
+	attachmentDescCaption := attachmentFields[3].Descriptor()
+	// attachment.CaptionValidator is a validator for the "caption" field. It is called by the builders before save.
+	attachment.CaptionValidator = attachmentDescCaption.Validators[0].(func(string) error)
+	// attachmentDescPosition is the schema descriptor for position field.
+	attachmentDescPosition := attachmentFields[4].Descriptor()
+	// attachment.DefaultPosition holds the default value on creation for the position field.
+	attachment.DefaultPosition = attachmentDescPosition.Default.(int)
+	// attachment.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	attachment.PositionValidator = attachmentDescPosition.Validators[0].(func(int) error)
+	// attachmentDescCreatedAt is the schema descriptor for created_at field.
+	attachmentDescCreatedAt := attachmentFields[5].Descriptor()
+	// attachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachment.DefaultCreatedAt = attachmentDescCreatedAt.Default.(func() time.Time)
+	// attachmentDescUpdatedAt is the schema descriptor for updated_at field.
+	attachmentDescUpdatedAt := attachmentFields[6].Descriptor()
+	// attachment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	attachment.DefaultUpdatedAt = attachmentDescUpdatedAt.Default.(func() time.Time)
+	// attachment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	attachment.UpdateDefaultUpdatedAt = attachmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// attachmentDescID is the schema descriptor for id field.
+	attachmentDescID := attachmentFields[0].Descriptor()
+	// attachment.DefaultID holds the default value on creation for the id field.
+	attachment.DefaultID = attachmentDescID.Default.(func() uuid.UUID)
+	blobFields := schema.Blob{}.Fields()
+	_ = blobFields
+	// blobDescCreatedAt is the schema descriptor for created_at field.
+	blobDescCreatedAt := blobFields[2].Descriptor()
+	// blob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blob.DefaultCreatedAt = blobDescCreatedAt.Default.(func() time.Time)
+	// blobDescID is the schema descriptor for id field.
+	blobDescID := blobFields[0].Descriptor()
+	// blob.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	blob.IDValidator = func() func(string) error {
+		validators := blobDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	communityFields := schema.Community{}.Fields()
 	_ = communityFields
 	// communityDescName is the schema descriptor for name field.
@@ -55,6 +114,10 @@ func init() {
 			return nil
 		}
 	}()
+	// communityDescGeography is the schema descriptor for geography field.
+	communityDescGeography := communityFields[5].Descriptor()
+	// community.GeographyValidator is a validator for the "geography" field. It is called by the builders before save.
+	community.GeographyValidator = communityDescGeography.Validators[0].(func(string) error)
 	// communityDescCreatedAt is the schema descriptor for created_at field.
 	communityDescCreatedAt := communityFields[6].Descriptor()
 	// community.DefaultCreatedAt holds the default value on creation for the created_at field.
@@ -69,6 +132,126 @@ func init() {
 	communityDescID := communityFields[0].Descriptor()
 	// community.DefaultID holds the default value on creation for the id field.
 	community.DefaultID = communityDescID.Default.(func() uuid.UUID)
+	fileFields := schema.File{}.Fields()
+	_ = fileFields
+	// fileDescFilename is the schema descriptor for filename field.
+	fileDescFilename := fileFields[1].Descriptor()
+	// file.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	file.FilenameValidator = func() func(string) error {
+		validators := fileDescFilename.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(filename string) error {
+			for _, fn := range fns {
+				if err := fn(filename); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fileDescExtension is the schema descriptor for extension field.
+	fileDescExtension := fileFields[2].Descriptor()
+	// file.ExtensionValidator is a validator for the "extension" field. It is called by the builders before save.
+	file.ExtensionValidator = func() func(string) error {
+		validators := fileDescExtension.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(extension string) error {
+			for _, fn := range fns {
+				if err := fn(extension); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fileDescContentType is the schema descriptor for content_type field.
+	fileDescContentType := fileFields[3].Descriptor()
+	// file.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	file.ContentTypeValidator = func() func(string) error {
+		validators := fileDescContentType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(content_type string) error {
+			for _, fn := range fns {
+				if err := fn(content_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fileDescSize is the schema descriptor for size field.
+	fileDescSize := fileFields[4].Descriptor()
+	// file.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	file.SizeValidator = fileDescSize.Validators[0].(func(int64) error)
+	// fileDescWidth is the schema descriptor for width field.
+	fileDescWidth := fileFields[7].Descriptor()
+	// file.DefaultWidth holds the default value on creation for the width field.
+	file.DefaultWidth = fileDescWidth.Default.(int)
+	// file.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	file.WidthValidator = fileDescWidth.Validators[0].(func(int) error)
+	// fileDescHeight is the schema descriptor for height field.
+	fileDescHeight := fileFields[8].Descriptor()
+	// file.DefaultHeight holds the default value on creation for the height field.
+	file.DefaultHeight = fileDescHeight.Default.(int)
+	// file.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	file.HeightValidator = fileDescHeight.Validators[0].(func(int) error)
+	// fileDescCreatedAt is the schema descriptor for created_at field.
+	fileDescCreatedAt := fileFields[9].Descriptor()
+	// file.DefaultCreatedAt holds the default value on creation for the created_at field.
+	file.DefaultCreatedAt = fileDescCreatedAt.Default.(func() time.Time)
+	// fileDescUpdatedAt is the schema descriptor for updated_at field.
+	fileDescUpdatedAt := fileFields[10].Descriptor()
+	// file.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	file.DefaultUpdatedAt = fileDescUpdatedAt.Default.(func() time.Time)
+	// file.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	file.UpdateDefaultUpdatedAt = fileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// fileDescID is the schema descriptor for id field.
+	fileDescID := fileFields[0].Descriptor()
+	// file.DefaultID holds the default value on creation for the id field.
+	file.DefaultID = fileDescID.Default.(func() uuid.UUID)
+	identityFields := schema.Identity{}.Fields()
+	_ = identityFields
+	// identityDescProvider is the schema descriptor for provider field.
+	identityDescProvider := identityFields[2].Descriptor()
+	// identity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	identity.ProviderValidator = identityDescProvider.Validators[0].(func(string) error)
+	// identityDescSubject is the schema descriptor for subject field.
+	identityDescSubject := identityFields[3].Descriptor()
+	// identity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	identity.SubjectValidator = identityDescSubject.Validators[0].(func(string) error)
+	// identityDescCreatedAt is the schema descriptor for created_at field.
+	identityDescCreatedAt := identityFields[5].Descriptor()
+	// identity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identity.DefaultCreatedAt = identityDescCreatedAt.Default.(func() time.Time)
+	// identityDescID is the schema descriptor for id field.
+	identityDescID := identityFields[0].Descriptor()
+	// identity.DefaultID holds the default value on creation for the id field.
+	identity.DefaultID = identityDescID.Default.(func() uuid.UUID)
+	membershipFields := schema.Membership{}.Fields()
+	_ = membershipFields
+	// membershipDescCreatedAt is the schema descriptor for created_at field.
+	membershipDescCreatedAt := membershipFields[2].Descriptor()
+	// membership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	membership.DefaultCreatedAt = membershipDescCreatedAt.Default.(func() time.Time)
+	// membershipDescUpdatedAt is the schema descriptor for updated_at field.
+	membershipDescUpdatedAt := membershipFields[3].Descriptor()
+	// membership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	membership.DefaultUpdatedAt = membershipDescUpdatedAt.Default.(func() time.Time)
+	// membership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	membership.UpdateDefaultUpdatedAt = membershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// membershipDescID is the schema descriptor for id field.
+	membershipDescID := membershipFields[0].Descriptor()
+	// membership.DefaultID holds the default value on creation for the id field.
+	membership.DefaultID = membershipDescID.Default.(func() uuid.UUID)
 	postFields := schema.Post{}.Fields()
 	_ = postFields
 	// postDescTitle is the schema descriptor for title field.
@@ -90,23 +273,75 @@ func init() {
 		}
 	}()
 	// postDescCreatedAt is the schema descriptor for created_at field.
-	postDescCreatedAt := postFields[4].Descriptor()
+	postDescCreatedAt := postFields[6].Descriptor()
 	// post.DefaultCreatedAt holds the default value on creation for the created_at field.
 	post.DefaultCreatedAt = postDescCreatedAt.Default.(func() time.Time)
 	// postDescUpdatedAt is the schema descriptor for updated_at field.
-	postDescUpdatedAt := postFields[5].Descriptor()
+	postDescUpdatedAt := postFields[7].Descriptor()
 	// post.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	post.DefaultUpdatedAt = postDescUpdatedAt.Default.(func() time.Time)
 	// post.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	post.UpdateDefaultUpdatedAt = postDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// postDescTags is the schema descriptor for tags field.
-	postDescTags := postFields[6].Descriptor()
+	postDescTags := postFields[8].Descriptor()
 	// post.DefaultTags holds the default value on creation for the tags field.
 	post.DefaultTags = postDescTags.Default.([]string)
+	// postDescGeography is the schema descriptor for geography field.
+	postDescGeography := postFields[11].Descriptor()
+	// post.GeographyValidator is a validator for the "geography" field. It is called by the builders before save.
+	post.GeographyValidator = postDescGeography.Validators[0].(func(string) error)
+	// postDescAddress is the schema descriptor for address field.
+	postDescAddress := postFields[12].Descriptor()
+	// post.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	post.AddressValidator = postDescAddress.Validators[0].(func(string) error)
 	// postDescID is the schema descriptor for id field.
 	postDescID := postFields[0].Descriptor()
 	// post.DefaultID holds the default value on creation for the id field.
 	post.DefaultID = postDescID.Default.(func() uuid.UUID)
+	postrevisionFields := schema.PostRevision{}.Fields()
+	_ = postrevisionFields
+	// postrevisionDescCreatedAt is the schema descriptor for created_at field.
+	postrevisionDescCreatedAt := postrevisionFields[3].Descriptor()
+	// postrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postrevision.DefaultCreatedAt = postrevisionDescCreatedAt.Default.(func() time.Time)
+	// postrevisionDescID is the schema descriptor for id field.
+	postrevisionDescID := postrevisionFields[0].Descriptor()
+	// postrevision.DefaultID holds the default value on creation for the id field.
+	postrevision.DefaultID = postrevisionDescID.Default.(func() uuid.UUID)
+	remembertokenFields := schema.RememberToken{}.Fields()
+	_ = remembertokenFields
+	// remembertokenDescCreatedAt is the schema descriptor for created_at field.
+	remembertokenDescCreatedAt := remembertokenFields[3].Descriptor()
+	// remembertoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	remembertoken.DefaultCreatedAt = remembertokenDescCreatedAt.Default.(func() time.Time)
+	// remembertokenDescID is the schema descriptor for id field.
+	remembertokenDescID := remembertokenFields[0].Descriptor()
+	// remembertoken.DefaultID holds the default value on creation for the id field.
+	remembertoken.DefaultID = remembertokenDescID.Default.(func() uuid.UUID)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[5].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	sessionDescLastSeenAt := sessionFields[6].Descriptor()
+	// session.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	session.DefaultLastSeenAt = sessionDescLastSeenAt.Default.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
+	statuschangeFields := schema.StatusChange{}.Fields()
+	_ = statuschangeFields
+	// statuschangeDescCreatedAt is the schema descriptor for created_at field.
+	statuschangeDescCreatedAt := statuschangeFields[4].Descriptor()
+	// statuschange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	statuschange.DefaultCreatedAt = statuschangeDescCreatedAt.Default.(func() time.Time)
+	// statuschangeDescID is the schema descriptor for id field.
+	statuschangeDescID := statuschangeFields[0].Descriptor()
+	// statuschange.DefaultID holds the default value on creation for the id field.
+	statuschange.DefaultID = statuschangeDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescUsername is the schema descriptor for username field.
@@ -145,12 +380,24 @@ func init() {
 			return nil
 		}
 	}()
+	// userDescConfirmed is the schema descriptor for confirmed field.
+	userDescConfirmed := userFields[4].Descriptor()
+	// user.DefaultConfirmed holds the default value on creation for the confirmed field.
+	user.DefaultConfirmed = userDescConfirmed.Default.(bool)
+	// userDescSessionVersion is the schema descriptor for session_version field.
+	userDescSessionVersion := userFields[10].Descriptor()
+	// user.DefaultSessionVersion holds the default value on creation for the session_version field.
+	user.DefaultSessionVersion = userDescSessionVersion.Default.(int)
+	// userDescAttemptCount is the schema descriptor for attempt_count field.
+	userDescAttemptCount := userFields[11].Descriptor()
+	// user.DefaultAttemptCount holds the default value on creation for the attempt_count field.
+	user.DefaultAttemptCount = userDescAttemptCount.Default.(int)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[4].Descriptor()
+	userDescCreatedAt := userFields[15].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[5].Descriptor()
+	userDescUpdatedAt := userFields[16].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -3,12 +3,17 @@ package schema
 import (
 	"time"
 
+	"github.com/gofrs/uuid/v5"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
+// Attachment links a File to a Post, with a caption
 type Attachment struct {
 	ent.Schema
 }
@@ -16,11 +21,18 @@ type Attachment struct {
 func (Attachment) Fields() []ent.Field {
 	return []ent.Field{
 		uuidField(),
+		field.UUID("post_id", uuid.UUID{}),
+		field.UUID("file_id", uuid.UUID{}),
 		field.String("caption").
 			Optional().
 			MaxLen(500),
+		// order of the photo within its post
+		field.Int("position").
+			NonNegative().
+			Default(0),
 		field.Time("created_at").
-			Default(time.Now),
+			Default(time.Now).
+			Immutable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
@@ -30,14 +42,22 @@ func (Attachment) Fields() []ent.Field {
 func (Attachment) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("post", Post.Type).
+			Field("post_id").
 			Unique().Required(),
 		edge.To("file", File.Type).
+			Field("file_id").
 			Unique().Required(),
 	}
 }
 
-func (Attachment) Annotations() []entsql.Annotation {
-	return []entsql.Annotation{
-		{Table: "attachment"},
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("post_id", "position"),
+	}
+}
+
+func (Attachment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "attachment"},
 	}
 }
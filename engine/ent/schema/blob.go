@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// Blob holds file contents for the Postgres blob store
+type Blob struct {
+	ent.Schema
+}
+
+func (Blob) Fields() []ent.Field {
+	return []ent.Field{
+		// the blob store key, which is the owning file's ID
+		field.String("id").
+			NotEmpty().
+			MaxLen(255).
+			Immutable(),
+		field.Bytes("data"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (Blob) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "blob"},
+	}
+}
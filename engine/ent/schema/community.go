@@ -9,6 +9,8 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
+
+	"fixit/engine/geo"
 )
 
 // Community holds the schema definition for the Community entity.
@@ -38,8 +40,10 @@ func (Community) Fields() []ent.Field {
 			Optional(),
 		field.String("banner_image_url").
 			Optional(),
+		// WKT point, longitude first, e.g. POINT(-1.7797 51.5558)
 		field.String("geography").
-			Optional(),
+			Optional().
+			Validate(geo.ValidateWKT),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
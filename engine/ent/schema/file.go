@@ -3,9 +3,14 @@ package schema
 import (
 	"time"
 
+	"github.com/gofrs/uuid/v5"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // File holds the schema definition for the File entity.
@@ -22,9 +27,30 @@ func (File) Fields() []ent.Field {
 		field.String("extension").
 			MinLen(1).
 			MaxLen(10),
-		field.Bytes("data"),
+		// sniffed from the data on upload rather than trusting the client
+		field.String("content_type").
+			MinLen(1).
+			MaxLen(128),
+		// the data lives in the configured blob store, keyed by the file ID
+		field.Int64("size").
+			NonNegative(),
+		// derived files are resized copies of an original upload
+		field.Enum("variant").
+			Values("original", "list", "detail").
+			Default("original"),
+		field.UUID("original_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		// pixel dimensions, zero for files uploaded before they were recorded
+		field.Int("width").
+			NonNegative().
+			Default(0),
+		field.Int("height").
+			NonNegative().
+			Default(0),
 		field.Time("created_at").
-			Default(time.Now),
+			Default(time.Now).
+			Immutable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
@@ -32,11 +58,25 @@ func (File) Fields() []ent.Field {
 }
 
 func (File) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("attachments", Attachment.Type).Ref("file"),
+		edge.To("original", File.Type).
+			Field("original_id").
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			From("derived"),
+	}
+}
+
+func (File) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("original_id", "variant").
+			Unique(),
+	}
 }
 
-func (File) Annotations() []entsql.Annotation {
-	return []entsql.Annotation{
-		{Table: "file"},
+func (File) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "file"},
 	}
 }
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Identity links a user to their account with an OpenID Connect provider,
+// so they can log in through it
+type Identity struct {
+	ent.Schema
+}
+
+func (Identity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Table("identity"),
+	}
+}
+
+func (Identity) Fields() []ent.Field {
+	return []ent.Field{
+		uuidField(),
+		field.UUID("user_id", uuid.UUID{}),
+		// the provider's name in the config, like google
+		field.String("provider").
+			NotEmpty(),
+		// the provider's ID for the user, the sub claim
+		field.String("subject").
+			NotEmpty(),
+		// the email the provider had when the identity was linked
+		field.String("email").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (Identity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
+
+func (Identity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique().Required(),
+	}
+}
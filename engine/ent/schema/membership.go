@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Membership is a user belonging to a community, with the role they have
+// in it
+type Membership struct {
+	ent.Schema
+}
+
+func (Membership) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Table("membership"),
+	}
+}
+
+func (Membership) Fields() []ent.Field {
+	return []ent.Field{
+		uuidField(),
+		// owners manage the community and its roles, moderators look after
+		// its posts
+		field.Enum("role").
+			Values("owner", "moderator", "member").
+			Default("member"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (Membership) Indexes() []ent.Index {
+	return []ent.Index{
+		// each user joins a community once
+		index.Edges("community", "user").
+			Unique(),
+	}
+}
+
+func (Membership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("community", Community.Type).Unique().Required(),
+		edge.To("user", User.Type).Unique().Required(),
+	}
+}
@@ -10,12 +10,19 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"fixit/engine/geo"
 )
 
 type Post struct {
 	ent.Schema
 }
 
+// issueStatuses is the lifecycle of an issue: solutions propose a fix,
+// verifications confirm it, and the author can close or reopen it
+var issueStatuses = []string{"open", "proposed", "verified", "closed"}
+
 func (Post) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entsql.Table("post"),
@@ -36,9 +43,16 @@ func (Post) Fields() []ent.Field {
 			MaxLen(128),
 		field.Text("body").
 			Optional(),
+		// body rendered from Markdown, emptied when the body changes so
+		// it's rendered again
+		field.Text("body_html").
+			Optional(),
 		field.Enum("role").
 			Values("issue", "solution", "verification", "chat").
 			Default("issue"),
+		field.Enum("status").
+			Values(issueStatuses...).
+			Default("open"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -53,6 +67,29 @@ func (Post) Fields() []ent.Field {
 			Optional(),
 		field.String("image_url").
 			Optional(),
+		// where an issue is, as a WKT point like the community geography
+		field.String("geography").
+			Optional().
+			Validate(geo.ValidateWKT),
+		field.String("address").
+			Optional().
+			MaxLen(255),
+		// set on issues once the author picks the solution that fixed it
+		field.UUID("accepted_solution_id", uuid.UUID{}).
+			Nillable().
+			Optional(),
+		// set when the post is deleted. It's kept as a tombstone so the
+		// replies below it can still be read.
+		field.Time("deleted_at").
+			Nillable().
+			Optional(),
+	}
+}
+
+func (Post) Indexes() []ent.Index {
+	return []ent.Index{
+		// community lists page through posts of a single community
+		index.Edges("community"),
 	}
 }
 
@@ -65,9 +102,13 @@ func (Post) Edges() []ent.Edge {
 			Field("reply_to").
 			Unique().
 			From("replies"),
+		edge.To("accepted_solution", Post.Type).
+			Field("accepted_solution_id").
+			Unique(),
 		// o2m
 		edge.From("votes", Vote.Type).Ref("post"),
-		// TODO - getting "entc/gen: type "Attachment" does not exist for edge", not required for now
-		//edge.From("attachments", Attachment.Type).Ref("post"),
+		edge.From("status_changes", StatusChange.Type).Ref("post"),
+		edge.From("revisions", PostRevision.Type).Ref("post"),
+		edge.From("attachments", Attachment.Type).Ref("post"),
 	}
 }
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PostRevision keeps a post's title and body as they were before an edit,
+// along with who made the edit
+type PostRevision struct {
+	ent.Schema
+}
+
+func (PostRevision) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Table("post_revision"),
+	}
+}
+
+func (PostRevision) Fields() []ent.Field {
+	return []ent.Field{
+		uuidField(),
+		field.String("title"),
+		field.Text("body").
+			Optional(),
+		// when the edit replaced this revision
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (PostRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("post"),
+	}
+}
+
+func (PostRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("post", Post.Type).Unique().Required(),
+		edge.To("user", User.Type).Unique().Required(),
+	}
+}
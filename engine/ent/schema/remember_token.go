@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RememberToken lets a browser log back in after its session ends. Each is
+// used once and replaced.
+type RememberToken struct {
+	ent.Schema
+}
+
+func (RememberToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Table("remember_token"),
+	}
+}
+
+func (RememberToken) Fields() []ent.Field {
+	return []ent.Field{
+		uuidField(),
+		field.UUID("user_id", uuid.UUID{}),
+		// hash of the token in the cookie, so a leaked table can't be used
+		field.String("token").
+			Unique().
+			Sensitive(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (RememberToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+func (RememberToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique().Required(),
+	}
+}
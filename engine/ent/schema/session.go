@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Session is a browser session kept in the database, when SESSION_STORE is
+// postgres, so users can see and end their sessions
+type Session struct {
+	ent.Schema
+}
+
+func (Session) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Table("session"),
+	}
+}
+
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		uuidField(),
+		// set while someone is logged in
+		field.UUID("user_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.JSON("values", map[string]string{}).
+			Sensitive(),
+		field.String("user_agent").
+			Optional(),
+		field.String("ip").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_seen_at").
+			Default(time.Now),
+		field.Time("expires_at"),
+	}
+}
+
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("expires_at"),
+	}
+}
+
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique(),
+	}
+}
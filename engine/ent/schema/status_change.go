@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StatusChange is the audit trail of an issue moving through its lifecycle
+type StatusChange struct {
+	ent.Schema
+}
+
+func (StatusChange) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Table("status_change"),
+	}
+}
+
+func (StatusChange) Fields() []ent.Field {
+	return []ent.Field{
+		uuidField(),
+		field.Enum("from_status").
+			Values(issueStatuses...),
+		field.Enum("to_status").
+			Values(issueStatuses...),
+		field.Text("reason").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (StatusChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("post"),
+	}
+}
+
+func (StatusChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("post", Post.Type).Unique().Required(),
+		edge.To("user", User.Type).Unique().Required(),
+	}
+}
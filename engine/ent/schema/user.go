@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type User struct {
@@ -39,7 +40,47 @@ func (User) Fields() []ent.Field {
 			MinLen(4).
 			MaxLen(128).
 			Unique(),
-		field.String("password"),
+		field.String("password").
+			Sensitive(),
+		// users confirm their email address before they can log in or post
+		field.Bool("confirmed").
+			Default(false),
+		// the authboss confirm token, split so only the selector is looked up
+		field.String("confirm_selector").
+			Optional().
+			Sensitive(),
+		field.String("confirm_verifier").
+			Optional().
+			Sensitive(),
+		// the authboss password reset token, which stops working at
+		// recover_expiry
+		field.String("recover_selector").
+			Optional().
+			Sensitive(),
+		field.String("recover_verifier").
+			Optional().
+			Sensitive(),
+		field.Time("recover_expiry").
+			Optional().
+			Nillable(),
+		// sessions remember the version at login, and stop working when it's
+		// bumped, as it is when the password changes
+		field.Int("session_version").
+			Default(0),
+		// failed logins in a row, for the authboss lock module. Users with
+		// too many can't log in until locked_until.
+		field.Int("attempt_count").
+			Default(0),
+		field.Time("last_attempt_at").
+			Optional().
+			Nillable(),
+		field.Time("locked_until").
+			Optional().
+			Nillable(),
+		// banned users can't log in, and their sessions stop working
+		field.Time("banned_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -49,6 +90,13 @@ func (User) Fields() []ent.Field {
 	}
 }
 
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("confirm_selector"),
+		index.Fields("recover_selector"),
+	}
+}
+
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("posts", Post.Type).
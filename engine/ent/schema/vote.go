@@ -45,9 +45,9 @@ func (Vote) Fields() []ent.Field {
 func (Vote) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("kind"),
-		// each user can only have one type of each vote
+		// each user can only have one vote of each kind per post
 		index.Fields("kind").
-			Edges("user").
+			Edges("post", "user").
 			Unique(),
 	}
 }
@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/statuschange"
+	"fixit/engine/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// StatusChange is the model entity for the StatusChange schema.
+type StatusChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus statuschange.FromStatus `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus statuschange.ToStatus `json:"to_status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StatusChangeQuery when eager-loading is set.
+	Edges              StatusChangeEdges `json:"edges"`
+	status_change_post *uuid.UUID
+	status_change_user *uuid.UUID
+	selectValues       sql.SelectValues
+}
+
+// StatusChangeEdges holds the relations/edges for other nodes in the graph.
+type StatusChangeEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StatusChangeEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StatusChangeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StatusChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case statuschange.FieldFromStatus, statuschange.FieldToStatus, statuschange.FieldReason:
+			values[i] = new(sql.NullString)
+		case statuschange.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case statuschange.FieldID:
+			values[i] = new(uuid.UUID)
+		case statuschange.ForeignKeys[0]: // status_change_post
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case statuschange.ForeignKeys[1]: // status_change_user
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StatusChange fields.
+func (sc *StatusChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case statuschange.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sc.ID = *value
+			}
+		case statuschange.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				sc.FromStatus = statuschange.FromStatus(value.String)
+			}
+		case statuschange.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				sc.ToStatus = statuschange.ToStatus(value.String)
+			}
+		case statuschange.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				sc.Reason = value.String
+			}
+		case statuschange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sc.CreatedAt = value.Time
+			}
+		case statuschange.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field status_change_post", values[i])
+			} else if value.Valid {
+				sc.status_change_post = new(uuid.UUID)
+				*sc.status_change_post = *value.S.(*uuid.UUID)
+			}
+		case statuschange.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field status_change_user", values[i])
+			} else if value.Valid {
+				sc.status_change_user = new(uuid.UUID)
+				*sc.status_change_user = *value.S.(*uuid.UUID)
+			}
+		default:
+			sc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StatusChange.
+// This includes values selected through modifiers, order, etc.
+func (sc *StatusChange) Value(name string) (ent.Value, error) {
+	return sc.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the StatusChange entity.
+func (sc *StatusChange) QueryPost() *PostQuery {
+	return NewStatusChangeClient(sc.config).QueryPost(sc)
+}
+
+// QueryUser queries the "user" edge of the StatusChange entity.
+func (sc *StatusChange) QueryUser() *UserQuery {
+	return NewStatusChangeClient(sc.config).QueryUser(sc)
+}
+
+// Update returns a builder for updating this StatusChange.
+// Note that you need to call StatusChange.Unwrap() before calling this method if this StatusChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sc *StatusChange) Update() *StatusChangeUpdateOne {
+	return NewStatusChangeClient(sc.config).UpdateOne(sc)
+}
+
+// Unwrap unwraps the StatusChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sc *StatusChange) Unwrap() *StatusChange {
+	_tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StatusChange is not a transactional entity")
+	}
+	sc.config.driver = _tx.drv
+	return sc
+}
+
+// String implements the fmt.Stringer.
+func (sc *StatusChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("StatusChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sc.ID))
+	builder.WriteString("from_status=")
+	builder.WriteString(fmt.Sprintf("%v", sc.FromStatus))
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(fmt.Sprintf("%v", sc.ToStatus))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(sc.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StatusChanges is a parsable slice of StatusChange.
+type StatusChanges []*StatusChange
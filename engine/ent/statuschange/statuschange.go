@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package statuschange
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+const (
+	// Label holds the string label denoting the statuschange type in the database.
+	Label = "status_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgePost holds the string denoting the post edge name in mutations.
+	EdgePost = "post"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the statuschange in the database.
+	Table = "status_change"
+	// PostTable is the table that holds the post relation/edge.
+	PostTable = "status_change"
+	// PostInverseTable is the table name for the Post entity.
+	// It exists in this package in order to avoid circular dependency with the "post" package.
+	PostInverseTable = "post"
+	// PostColumn is the table column denoting the post relation/edge.
+	PostColumn = "status_change_post"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "status_change"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "user"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "status_change_user"
+)
+
+// Columns holds all SQL columns for statuschange fields.
+var Columns = []string{
+	FieldID,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldReason,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "status_change"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"status_change_post",
+	"status_change_user",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// FromStatus defines the type for the "from_status" enum field.
+type FromStatus string
+
+// FromStatus values.
+const (
+	FromStatusOpen     FromStatus = "open"
+	FromStatusProposed FromStatus = "proposed"
+	FromStatusVerified FromStatus = "verified"
+	FromStatusClosed   FromStatus = "closed"
+)
+
+func (fs FromStatus) String() string {
+	return string(fs)
+}
+
+// FromStatusValidator is a validator for the "from_status" field enum values. It is called by the builders before save.
+func FromStatusValidator(fs FromStatus) error {
+	switch fs {
+	case FromStatusOpen, FromStatusProposed, FromStatusVerified, FromStatusClosed:
+		return nil
+	default:
+		return fmt.Errorf("statuschange: invalid enum value for from_status field: %q", fs)
+	}
+}
+
+// ToStatus defines the type for the "to_status" enum field.
+type ToStatus string
+
+// ToStatus values.
+const (
+	ToStatusOpen     ToStatus = "open"
+	ToStatusProposed ToStatus = "proposed"
+	ToStatusVerified ToStatus = "verified"
+	ToStatusClosed   ToStatus = "closed"
+)
+
+func (ts ToStatus) String() string {
+	return string(ts)
+}
+
+// ToStatusValidator is a validator for the "to_status" field enum values. It is called by the builders before save.
+func ToStatusValidator(ts ToStatus) error {
+	switch ts {
+	case ToStatusOpen, ToStatusProposed, ToStatusVerified, ToStatusClosed:
+		return nil
+	default:
+		return fmt.Errorf("statuschange: invalid enum value for to_status field: %q", ts)
+	}
+}
+
+// OrderOption defines the ordering options for the StatusChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFromStatus orders the results by the from_status field.
+func ByFromStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromStatus, opts...).ToFunc()
+}
+
+// ByToStatus orders the results by the to_status field.
+func ByToStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToStatus, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPostField orders the results by post field.
+func ByPostField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPostStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPostStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PostInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PostTable, PostColumn),
+	)
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+	)
+}
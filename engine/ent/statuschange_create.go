@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/statuschange"
+	"fixit/engine/ent/user"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// StatusChangeCreate is the builder for creating a StatusChange entity.
+type StatusChangeCreate struct {
+	config
+	mutation *StatusChangeMutation
+	hooks    []Hook
+}
+
+// SetFromStatus sets the "from_status" field.
+func (scc *StatusChangeCreate) SetFromStatus(ss statuschange.FromStatus) *StatusChangeCreate {
+	scc.mutation.SetFromStatus(ss)
+	return scc
+}
+
+// SetToStatus sets the "to_status" field.
+func (scc *StatusChangeCreate) SetToStatus(ss statuschange.ToStatus) *StatusChangeCreate {
+	scc.mutation.SetToStatus(ss)
+	return scc
+}
+
+// SetReason sets the "reason" field.
+func (scc *StatusChangeCreate) SetReason(s string) *StatusChangeCreate {
+	scc.mutation.SetReason(s)
+	return scc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (scc *StatusChangeCreate) SetNillableReason(s *string) *StatusChangeCreate {
+	if s != nil {
+		scc.SetReason(*s)
+	}
+	return scc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (scc *StatusChangeCreate) SetCreatedAt(t time.Time) *StatusChangeCreate {
+	scc.mutation.SetCreatedAt(t)
+	return scc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (scc *StatusChangeCreate) SetNillableCreatedAt(t *time.Time) *StatusChangeCreate {
+	if t != nil {
+		scc.SetCreatedAt(*t)
+	}
+	return scc
+}
+
+// SetID sets the "id" field.
+func (scc *StatusChangeCreate) SetID(u uuid.UUID) *StatusChangeCreate {
+	scc.mutation.SetID(u)
+	return scc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (scc *StatusChangeCreate) SetNillableID(u *uuid.UUID) *StatusChangeCreate {
+	if u != nil {
+		scc.SetID(*u)
+	}
+	return scc
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (scc *StatusChangeCreate) SetPostID(id uuid.UUID) *StatusChangeCreate {
+	scc.mutation.SetPostID(id)
+	return scc
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (scc *StatusChangeCreate) SetPost(p *Post) *StatusChangeCreate {
+	return scc.SetPostID(p.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (scc *StatusChangeCreate) SetUserID(id uuid.UUID) *StatusChangeCreate {
+	scc.mutation.SetUserID(id)
+	return scc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (scc *StatusChangeCreate) SetUser(u *User) *StatusChangeCreate {
+	return scc.SetUserID(u.ID)
+}
+
+// Mutation returns the StatusChangeMutation object of the builder.
+func (scc *StatusChangeCreate) Mutation() *StatusChangeMutation {
+	return scc.mutation
+}
+
+// Save creates the StatusChange in the database.
+func (scc *StatusChangeCreate) Save(ctx context.Context) (*StatusChange, error) {
+	scc.defaults()
+	return withHooks(ctx, scc.sqlSave, scc.mutation, scc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scc *StatusChangeCreate) SaveX(ctx context.Context) *StatusChange {
+	v, err := scc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scc *StatusChangeCreate) Exec(ctx context.Context) error {
+	_, err := scc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scc *StatusChangeCreate) ExecX(ctx context.Context) {
+	if err := scc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scc *StatusChangeCreate) defaults() {
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		v := statuschange.DefaultCreatedAt()
+		scc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := scc.mutation.ID(); !ok {
+		v := statuschange.DefaultID()
+		scc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scc *StatusChangeCreate) check() error {
+	if _, ok := scc.mutation.FromStatus(); !ok {
+		return &ValidationError{Name: "from_status", err: errors.New(`ent: missing required field "StatusChange.from_status"`)}
+	}
+	if v, ok := scc.mutation.FromStatus(); ok {
+		if err := statuschange.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "StatusChange.from_status": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "StatusChange.to_status"`)}
+	}
+	if v, ok := scc.mutation.ToStatus(); ok {
+		if err := statuschange.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "StatusChange.to_status": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StatusChange.created_at"`)}
+	}
+	if len(scc.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "StatusChange.post"`)}
+	}
+	if len(scc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "StatusChange.user"`)}
+	}
+	return nil
+}
+
+func (scc *StatusChangeCreate) sqlSave(ctx context.Context) (*StatusChange, error) {
+	if err := scc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	scc.mutation.id = &_node.ID
+	scc.mutation.done = true
+	return _node, nil
+}
+
+func (scc *StatusChangeCreate) createSpec() (*StatusChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StatusChange{config: scc.config}
+		_spec = sqlgraph.NewCreateSpec(statuschange.Table, sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeUUID))
+	)
+	if id, ok := scc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := scc.mutation.FromStatus(); ok {
+		_spec.SetField(statuschange.FieldFromStatus, field.TypeEnum, value)
+		_node.FromStatus = value
+	}
+	if value, ok := scc.mutation.ToStatus(); ok {
+		_spec.SetField(statuschange.FieldToStatus, field.TypeEnum, value)
+		_node.ToStatus = value
+	}
+	if value, ok := scc.mutation.Reason(); ok {
+		_spec.SetField(statuschange.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := scc.mutation.CreatedAt(); ok {
+		_spec.SetField(statuschange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := scc.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statuschange.PostTable,
+			Columns: []string{statuschange.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.status_change_post = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := scc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statuschange.UserTable,
+			Columns: []string{statuschange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.status_change_user = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StatusChangeCreateBulk is the builder for creating many StatusChange entities in bulk.
+type StatusChangeCreateBulk struct {
+	config
+	err      error
+	builders []*StatusChangeCreate
+}
+
+// Save creates the StatusChange entities in the database.
+func (sccb *StatusChangeCreateBulk) Save(ctx context.Context) ([]*StatusChange, error) {
+	if sccb.err != nil {
+		return nil, sccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sccb.builders))
+	nodes := make([]*StatusChange, len(sccb.builders))
+	mutators := make([]Mutator, len(sccb.builders))
+	for i := range sccb.builders {
+		func(i int, root context.Context) {
+			builder := sccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StatusChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sccb *StatusChangeCreateBulk) SaveX(ctx context.Context) []*StatusChange {
+	v, err := sccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sccb *StatusChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := sccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sccb *StatusChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := sccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,546 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/predicate"
+	"fixit/engine/ent/statuschange"
+	"fixit/engine/ent/user"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// StatusChangeUpdate is the builder for updating StatusChange entities.
+type StatusChangeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *StatusChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the StatusChangeUpdate builder.
+func (scu *StatusChangeUpdate) Where(ps ...predicate.StatusChange) *StatusChangeUpdate {
+	scu.mutation.Where(ps...)
+	return scu
+}
+
+// SetFromStatus sets the "from_status" field.
+func (scu *StatusChangeUpdate) SetFromStatus(ss statuschange.FromStatus) *StatusChangeUpdate {
+	scu.mutation.SetFromStatus(ss)
+	return scu
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (scu *StatusChangeUpdate) SetNillableFromStatus(ss *statuschange.FromStatus) *StatusChangeUpdate {
+	if ss != nil {
+		scu.SetFromStatus(*ss)
+	}
+	return scu
+}
+
+// SetToStatus sets the "to_status" field.
+func (scu *StatusChangeUpdate) SetToStatus(ss statuschange.ToStatus) *StatusChangeUpdate {
+	scu.mutation.SetToStatus(ss)
+	return scu
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (scu *StatusChangeUpdate) SetNillableToStatus(ss *statuschange.ToStatus) *StatusChangeUpdate {
+	if ss != nil {
+		scu.SetToStatus(*ss)
+	}
+	return scu
+}
+
+// SetReason sets the "reason" field.
+func (scu *StatusChangeUpdate) SetReason(s string) *StatusChangeUpdate {
+	scu.mutation.SetReason(s)
+	return scu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (scu *StatusChangeUpdate) SetNillableReason(s *string) *StatusChangeUpdate {
+	if s != nil {
+		scu.SetReason(*s)
+	}
+	return scu
+}
+
+// ClearReason clears the value of the "reason" field.
+func (scu *StatusChangeUpdate) ClearReason() *StatusChangeUpdate {
+	scu.mutation.ClearReason()
+	return scu
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (scu *StatusChangeUpdate) SetPostID(id uuid.UUID) *StatusChangeUpdate {
+	scu.mutation.SetPostID(id)
+	return scu
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (scu *StatusChangeUpdate) SetPost(p *Post) *StatusChangeUpdate {
+	return scu.SetPostID(p.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (scu *StatusChangeUpdate) SetUserID(id uuid.UUID) *StatusChangeUpdate {
+	scu.mutation.SetUserID(id)
+	return scu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (scu *StatusChangeUpdate) SetUser(u *User) *StatusChangeUpdate {
+	return scu.SetUserID(u.ID)
+}
+
+// Mutation returns the StatusChangeMutation object of the builder.
+func (scu *StatusChangeUpdate) Mutation() *StatusChangeMutation {
+	return scu.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (scu *StatusChangeUpdate) ClearPost() *StatusChangeUpdate {
+	scu.mutation.ClearPost()
+	return scu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (scu *StatusChangeUpdate) ClearUser() *StatusChangeUpdate {
+	scu.mutation.ClearUser()
+	return scu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scu *StatusChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, scu.sqlSave, scu.mutation, scu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scu *StatusChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := scu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scu *StatusChangeUpdate) Exec(ctx context.Context) error {
+	_, err := scu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scu *StatusChangeUpdate) ExecX(ctx context.Context) {
+	if err := scu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scu *StatusChangeUpdate) check() error {
+	if v, ok := scu.mutation.FromStatus(); ok {
+		if err := statuschange.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "StatusChange.from_status": %w`, err)}
+		}
+	}
+	if v, ok := scu.mutation.ToStatus(); ok {
+		if err := statuschange.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "StatusChange.to_status": %w`, err)}
+		}
+	}
+	if scu.mutation.PostCleared() && len(scu.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatusChange.post"`)
+	}
+	if scu.mutation.UserCleared() && len(scu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatusChange.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (scu *StatusChangeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StatusChangeUpdate {
+	scu.modifiers = append(scu.modifiers, modifiers...)
+	return scu
+}
+
+func (scu *StatusChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statuschange.Table, statuschange.Columns, sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeUUID))
+	if ps := scu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scu.mutation.FromStatus(); ok {
+		_spec.SetField(statuschange.FieldFromStatus, field.TypeEnum, value)
+	}
+	if value, ok := scu.mutation.ToStatus(); ok {
+		_spec.SetField(statuschange.FieldToStatus, field.TypeEnum, value)
+	}
+	if value, ok := scu.mutation.Reason(); ok {
+		_spec.SetField(statuschange.FieldReason, field.TypeString, value)
+	}
+	if scu.mutation.ReasonCleared() {
+		_spec.ClearField(statuschange.FieldReason, field.TypeString)
+	}
+	if scu.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statuschange.PostTable,
+			Columns: []string{statuschange.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statuschange.PostTable,
+			Columns: []string{statuschange.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statuschange.UserTable,
+			Columns: []string{statuschange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statuschange.UserTable,
+			Columns: []string{statuschange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(scu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scu.mutation.done = true
+	return n, nil
+}
+
+// StatusChangeUpdateOne is the builder for updating a single StatusChange entity.
+type StatusChangeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *StatusChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetFromStatus sets the "from_status" field.
+func (scuo *StatusChangeUpdateOne) SetFromStatus(ss statuschange.FromStatus) *StatusChangeUpdateOne {
+	scuo.mutation.SetFromStatus(ss)
+	return scuo
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (scuo *StatusChangeUpdateOne) SetNillableFromStatus(ss *statuschange.FromStatus) *StatusChangeUpdateOne {
+	if ss != nil {
+		scuo.SetFromStatus(*ss)
+	}
+	return scuo
+}
+
+// SetToStatus sets the "to_status" field.
+func (scuo *StatusChangeUpdateOne) SetToStatus(ss statuschange.ToStatus) *StatusChangeUpdateOne {
+	scuo.mutation.SetToStatus(ss)
+	return scuo
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (scuo *StatusChangeUpdateOne) SetNillableToStatus(ss *statuschange.ToStatus) *StatusChangeUpdateOne {
+	if ss != nil {
+		scuo.SetToStatus(*ss)
+	}
+	return scuo
+}
+
+// SetReason sets the "reason" field.
+func (scuo *StatusChangeUpdateOne) SetReason(s string) *StatusChangeUpdateOne {
+	scuo.mutation.SetReason(s)
+	return scuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (scuo *StatusChangeUpdateOne) SetNillableReason(s *string) *StatusChangeUpdateOne {
+	if s != nil {
+		scuo.SetReason(*s)
+	}
+	return scuo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (scuo *StatusChangeUpdateOne) ClearReason() *StatusChangeUpdateOne {
+	scuo.mutation.ClearReason()
+	return scuo
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (scuo *StatusChangeUpdateOne) SetPostID(id uuid.UUID) *StatusChangeUpdateOne {
+	scuo.mutation.SetPostID(id)
+	return scuo
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (scuo *StatusChangeUpdateOne) SetPost(p *Post) *StatusChangeUpdateOne {
+	return scuo.SetPostID(p.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (scuo *StatusChangeUpdateOne) SetUserID(id uuid.UUID) *StatusChangeUpdateOne {
+	scuo.mutation.SetUserID(id)
+	return scuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (scuo *StatusChangeUpdateOne) SetUser(u *User) *StatusChangeUpdateOne {
+	return scuo.SetUserID(u.ID)
+}
+
+// Mutation returns the StatusChangeMutation object of the builder.
+func (scuo *StatusChangeUpdateOne) Mutation() *StatusChangeMutation {
+	return scuo.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (scuo *StatusChangeUpdateOne) ClearPost() *StatusChangeUpdateOne {
+	scuo.mutation.ClearPost()
+	return scuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (scuo *StatusChangeUpdateOne) ClearUser() *StatusChangeUpdateOne {
+	scuo.mutation.ClearUser()
+	return scuo
+}
+
+// Where appends a list predicates to the StatusChangeUpdate builder.
+func (scuo *StatusChangeUpdateOne) Where(ps ...predicate.StatusChange) *StatusChangeUpdateOne {
+	scuo.mutation.Where(ps...)
+	return scuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scuo *StatusChangeUpdateOne) Select(field string, fields ...string) *StatusChangeUpdateOne {
+	scuo.fields = append([]string{field}, fields...)
+	return scuo
+}
+
+// Save executes the query and returns the updated StatusChange entity.
+func (scuo *StatusChangeUpdateOne) Save(ctx context.Context) (*StatusChange, error) {
+	return withHooks(ctx, scuo.sqlSave, scuo.mutation, scuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scuo *StatusChangeUpdateOne) SaveX(ctx context.Context) *StatusChange {
+	node, err := scuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scuo *StatusChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := scuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scuo *StatusChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := scuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scuo *StatusChangeUpdateOne) check() error {
+	if v, ok := scuo.mutation.FromStatus(); ok {
+		if err := statuschange.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "StatusChange.from_status": %w`, err)}
+		}
+	}
+	if v, ok := scuo.mutation.ToStatus(); ok {
+		if err := statuschange.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "StatusChange.to_status": %w`, err)}
+		}
+	}
+	if scuo.mutation.PostCleared() && len(scuo.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatusChange.post"`)
+	}
+	if scuo.mutation.UserCleared() && len(scuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatusChange.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (scuo *StatusChangeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StatusChangeUpdateOne {
+	scuo.modifiers = append(scuo.modifiers, modifiers...)
+	return scuo
+}
+
+func (scuo *StatusChangeUpdateOne) sqlSave(ctx context.Context) (_node *StatusChange, err error) {
+	if err := scuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statuschange.Table, statuschange.Columns, sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeUUID))
+	id, ok := scuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StatusChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, statuschange.FieldID)
+		for _, f := range fields {
+			if !statuschange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != statuschange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scuo.mutation.FromStatus(); ok {
+		_spec.SetField(statuschange.FieldFromStatus, field.TypeEnum, value)
+	}
+	if value, ok := scuo.mutation.ToStatus(); ok {
+		_spec.SetField(statuschange.FieldToStatus, field.TypeEnum, value)
+	}
+	if value, ok := scuo.mutation.Reason(); ok {
+		_spec.SetField(statuschange.FieldReason, field.TypeString, value)
+	}
+	if scuo.mutation.ReasonCleared() {
+		_spec.ClearField(statuschange.FieldReason, field.TypeString)
+	}
+	if scuo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statuschange.PostTable,
+			Columns: []string{statuschange.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statuschange.PostTable,
+			Columns: []string{statuschange.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statuschange.UserTable,
+			Columns: []string{statuschange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   statuschange.UserTable,
+			Columns: []string{statuschange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(scuo.modifiers...)
+	_node = &StatusChange{config: scuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scuo.mutation.done = true
+	return _node, nil
+}
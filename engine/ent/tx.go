@@ -12,10 +12,28 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
 	// Community is the client for interacting with the Community builders.
 	Community *CommunityClient
+	// File is the client for interacting with the File builders.
+	File *FileClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
+	// Membership is the client for interacting with the Membership builders.
+	Membership *MembershipClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// RememberToken is the client for interacting with the RememberToken builders.
+	RememberToken *RememberTokenClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// StatusChange is the client for interacting with the StatusChange builders.
+	StatusChange *StatusChangeClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// Vote is the client for interacting with the Vote builders.
@@ -151,8 +169,17 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.Blob = NewBlobClient(tx.config)
 	tx.Community = NewCommunityClient(tx.config)
+	tx.File = NewFileClient(tx.config)
+	tx.Identity = NewIdentityClient(tx.config)
+	tx.Membership = NewMembershipClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
+	tx.PostRevision = NewPostRevisionClient(tx.config)
+	tx.RememberToken = NewRememberTokenClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.StatusChange = NewStatusChangeClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.Vote = NewVoteClient(tx.config)
 }
@@ -164,7 +191,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Community.QueryXXX(), the query will be executed
+// applies a query, for example: Attachment.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -23,7 +23,29 @@ type User struct {
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
 	// Password holds the value of the "password" field.
-	Password string `json:"password,omitempty"`
+	Password string `json:"-"`
+	// Confirmed holds the value of the "confirmed" field.
+	Confirmed bool `json:"confirmed,omitempty"`
+	// ConfirmSelector holds the value of the "confirm_selector" field.
+	ConfirmSelector string `json:"-"`
+	// ConfirmVerifier holds the value of the "confirm_verifier" field.
+	ConfirmVerifier string `json:"-"`
+	// RecoverSelector holds the value of the "recover_selector" field.
+	RecoverSelector string `json:"-"`
+	// RecoverVerifier holds the value of the "recover_verifier" field.
+	RecoverVerifier string `json:"-"`
+	// RecoverExpiry holds the value of the "recover_expiry" field.
+	RecoverExpiry *time.Time `json:"recover_expiry,omitempty"`
+	// SessionVersion holds the value of the "session_version" field.
+	SessionVersion int `json:"session_version,omitempty"`
+	// AttemptCount holds the value of the "attempt_count" field.
+	AttemptCount int `json:"attempt_count,omitempty"`
+	// LastAttemptAt holds the value of the "last_attempt_at" field.
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// BannedAt holds the value of the "banned_at" field.
+	BannedAt *time.Time `json:"banned_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -68,9 +90,13 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldUsername, user.FieldEmail, user.FieldPassword:
+		case user.FieldConfirmed:
+			values[i] = new(sql.NullBool)
+		case user.FieldSessionVersion, user.FieldAttemptCount:
+			values[i] = new(sql.NullInt64)
+		case user.FieldUsername, user.FieldEmail, user.FieldPassword, user.FieldConfirmSelector, user.FieldConfirmVerifier, user.FieldRecoverSelector, user.FieldRecoverVerifier:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldRecoverExpiry, user.FieldLastAttemptAt, user.FieldLockedUntil, user.FieldBannedAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -113,6 +139,76 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Password = value.String
 			}
+		case user.FieldConfirmed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field confirmed", values[i])
+			} else if value.Valid {
+				u.Confirmed = value.Bool
+			}
+		case user.FieldConfirmSelector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field confirm_selector", values[i])
+			} else if value.Valid {
+				u.ConfirmSelector = value.String
+			}
+		case user.FieldConfirmVerifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field confirm_verifier", values[i])
+			} else if value.Valid {
+				u.ConfirmVerifier = value.String
+			}
+		case user.FieldRecoverSelector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recover_selector", values[i])
+			} else if value.Valid {
+				u.RecoverSelector = value.String
+			}
+		case user.FieldRecoverVerifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recover_verifier", values[i])
+			} else if value.Valid {
+				u.RecoverVerifier = value.String
+			}
+		case user.FieldRecoverExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field recover_expiry", values[i])
+			} else if value.Valid {
+				u.RecoverExpiry = new(time.Time)
+				*u.RecoverExpiry = value.Time
+			}
+		case user.FieldSessionVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field session_version", values[i])
+			} else if value.Valid {
+				u.SessionVersion = int(value.Int64)
+			}
+		case user.FieldAttemptCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_count", values[i])
+			} else if value.Valid {
+				u.AttemptCount = int(value.Int64)
+			}
+		case user.FieldLastAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_attempt_at", values[i])
+			} else if value.Valid {
+				u.LastAttemptAt = new(time.Time)
+				*u.LastAttemptAt = value.Time
+			}
+		case user.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				u.LockedUntil = new(time.Time)
+				*u.LockedUntil = value.Time
+			}
+		case user.FieldBannedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field banned_at", values[i])
+			} else if value.Valid {
+				u.BannedAt = new(time.Time)
+				*u.BannedAt = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -177,8 +273,44 @@ func (u *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(u.Email)
 	builder.WriteString(", ")
-	builder.WriteString("password=")
-	builder.WriteString(u.Password)
+	builder.WriteString("password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("confirmed=")
+	builder.WriteString(fmt.Sprintf("%v", u.Confirmed))
+	builder.WriteString(", ")
+	builder.WriteString("confirm_selector=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("confirm_verifier=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("recover_selector=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("recover_verifier=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.RecoverExpiry; v != nil {
+		builder.WriteString("recover_expiry=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("session_version=")
+	builder.WriteString(fmt.Sprintf("%v", u.SessionVersion))
+	builder.WriteString(", ")
+	builder.WriteString("attempt_count=")
+	builder.WriteString(fmt.Sprintf("%v", u.AttemptCount))
+	builder.WriteString(", ")
+	if v := u.LastAttemptAt; v != nil {
+		builder.WriteString("last_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.BannedAt; v != nil {
+		builder.WriteString("banned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
@@ -21,6 +21,28 @@ const (
 	FieldEmail = "email"
 	// FieldPassword holds the string denoting the password field in the database.
 	FieldPassword = "password"
+	// FieldConfirmed holds the string denoting the confirmed field in the database.
+	FieldConfirmed = "confirmed"
+	// FieldConfirmSelector holds the string denoting the confirm_selector field in the database.
+	FieldConfirmSelector = "confirm_selector"
+	// FieldConfirmVerifier holds the string denoting the confirm_verifier field in the database.
+	FieldConfirmVerifier = "confirm_verifier"
+	// FieldRecoverSelector holds the string denoting the recover_selector field in the database.
+	FieldRecoverSelector = "recover_selector"
+	// FieldRecoverVerifier holds the string denoting the recover_verifier field in the database.
+	FieldRecoverVerifier = "recover_verifier"
+	// FieldRecoverExpiry holds the string denoting the recover_expiry field in the database.
+	FieldRecoverExpiry = "recover_expiry"
+	// FieldSessionVersion holds the string denoting the session_version field in the database.
+	FieldSessionVersion = "session_version"
+	// FieldAttemptCount holds the string denoting the attempt_count field in the database.
+	FieldAttemptCount = "attempt_count"
+	// FieldLastAttemptAt holds the string denoting the last_attempt_at field in the database.
+	FieldLastAttemptAt = "last_attempt_at"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
+	// FieldBannedAt holds the string denoting the banned_at field in the database.
+	FieldBannedAt = "banned_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -53,6 +75,17 @@ var Columns = []string{
 	FieldUsername,
 	FieldEmail,
 	FieldPassword,
+	FieldConfirmed,
+	FieldConfirmSelector,
+	FieldConfirmVerifier,
+	FieldRecoverSelector,
+	FieldRecoverVerifier,
+	FieldRecoverExpiry,
+	FieldSessionVersion,
+	FieldAttemptCount,
+	FieldLastAttemptAt,
+	FieldLockedUntil,
+	FieldBannedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -72,6 +105,12 @@ var (
 	UsernameValidator func(string) error
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	EmailValidator func(string) error
+	// DefaultConfirmed holds the default value on creation for the "confirmed" field.
+	DefaultConfirmed bool
+	// DefaultSessionVersion holds the default value on creation for the "session_version" field.
+	DefaultSessionVersion int
+	// DefaultAttemptCount holds the default value on creation for the "attempt_count" field.
+	DefaultAttemptCount int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -105,6 +144,61 @@ func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPassword, opts...).ToFunc()
 }
 
+// ByConfirmed orders the results by the confirmed field.
+func ByConfirmed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfirmed, opts...).ToFunc()
+}
+
+// ByConfirmSelector orders the results by the confirm_selector field.
+func ByConfirmSelector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfirmSelector, opts...).ToFunc()
+}
+
+// ByConfirmVerifier orders the results by the confirm_verifier field.
+func ByConfirmVerifier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfirmVerifier, opts...).ToFunc()
+}
+
+// ByRecoverSelector orders the results by the recover_selector field.
+func ByRecoverSelector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecoverSelector, opts...).ToFunc()
+}
+
+// ByRecoverVerifier orders the results by the recover_verifier field.
+func ByRecoverVerifier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecoverVerifier, opts...).ToFunc()
+}
+
+// ByRecoverExpiry orders the results by the recover_expiry field.
+func ByRecoverExpiry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecoverExpiry, opts...).ToFunc()
+}
+
+// BySessionVersion orders the results by the session_version field.
+func BySessionVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionVersion, opts...).ToFunc()
+}
+
+// ByAttemptCount orders the results by the attempt_count field.
+func ByAttemptCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptCount, opts...).ToFunc()
+}
+
+// ByLastAttemptAt orders the results by the last_attempt_at field.
+func ByLastAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastAttemptAt, opts...).ToFunc()
+}
+
+// ByLockedUntil orders the results by the locked_until field.
+func ByLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedUntil, opts...).ToFunc()
+}
+
+// ByBannedAt orders the results by the banned_at field.
+func ByBannedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBannedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
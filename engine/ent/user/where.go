@@ -71,6 +71,61 @@ func Password(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPassword, v))
 }
 
+// Confirmed applies equality check predicate on the "confirmed" field. It's identical to ConfirmedEQ.
+func Confirmed(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldConfirmed, v))
+}
+
+// ConfirmSelector applies equality check predicate on the "confirm_selector" field. It's identical to ConfirmSelectorEQ.
+func ConfirmSelector(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldConfirmSelector, v))
+}
+
+// ConfirmVerifier applies equality check predicate on the "confirm_verifier" field. It's identical to ConfirmVerifierEQ.
+func ConfirmVerifier(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldConfirmVerifier, v))
+}
+
+// RecoverSelector applies equality check predicate on the "recover_selector" field. It's identical to RecoverSelectorEQ.
+func RecoverSelector(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRecoverSelector, v))
+}
+
+// RecoverVerifier applies equality check predicate on the "recover_verifier" field. It's identical to RecoverVerifierEQ.
+func RecoverVerifier(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRecoverVerifier, v))
+}
+
+// RecoverExpiry applies equality check predicate on the "recover_expiry" field. It's identical to RecoverExpiryEQ.
+func RecoverExpiry(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRecoverExpiry, v))
+}
+
+// SessionVersion applies equality check predicate on the "session_version" field. It's identical to SessionVersionEQ.
+func SessionVersion(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSessionVersion, v))
+}
+
+// AttemptCount applies equality check predicate on the "attempt_count" field. It's identical to AttemptCountEQ.
+func AttemptCount(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAttemptCount, v))
+}
+
+// LastAttemptAt applies equality check predicate on the "last_attempt_at" field. It's identical to LastAttemptAtEQ.
+func LastAttemptAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLastAttemptAt, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// BannedAt applies equality check predicate on the "banned_at" field. It's identical to BannedAtEQ.
+func BannedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBannedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -276,6 +331,596 @@ func PasswordContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPassword, v))
 }
 
+// ConfirmedEQ applies the EQ predicate on the "confirmed" field.
+func ConfirmedEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldConfirmed, v))
+}
+
+// ConfirmedNEQ applies the NEQ predicate on the "confirmed" field.
+func ConfirmedNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldConfirmed, v))
+}
+
+// ConfirmSelectorEQ applies the EQ predicate on the "confirm_selector" field.
+func ConfirmSelectorEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldConfirmSelector, v))
+}
+
+// ConfirmSelectorNEQ applies the NEQ predicate on the "confirm_selector" field.
+func ConfirmSelectorNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldConfirmSelector, v))
+}
+
+// ConfirmSelectorIn applies the In predicate on the "confirm_selector" field.
+func ConfirmSelectorIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldConfirmSelector, vs...))
+}
+
+// ConfirmSelectorNotIn applies the NotIn predicate on the "confirm_selector" field.
+func ConfirmSelectorNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldConfirmSelector, vs...))
+}
+
+// ConfirmSelectorGT applies the GT predicate on the "confirm_selector" field.
+func ConfirmSelectorGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldConfirmSelector, v))
+}
+
+// ConfirmSelectorGTE applies the GTE predicate on the "confirm_selector" field.
+func ConfirmSelectorGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldConfirmSelector, v))
+}
+
+// ConfirmSelectorLT applies the LT predicate on the "confirm_selector" field.
+func ConfirmSelectorLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldConfirmSelector, v))
+}
+
+// ConfirmSelectorLTE applies the LTE predicate on the "confirm_selector" field.
+func ConfirmSelectorLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldConfirmSelector, v))
+}
+
+// ConfirmSelectorContains applies the Contains predicate on the "confirm_selector" field.
+func ConfirmSelectorContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldConfirmSelector, v))
+}
+
+// ConfirmSelectorHasPrefix applies the HasPrefix predicate on the "confirm_selector" field.
+func ConfirmSelectorHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldConfirmSelector, v))
+}
+
+// ConfirmSelectorHasSuffix applies the HasSuffix predicate on the "confirm_selector" field.
+func ConfirmSelectorHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldConfirmSelector, v))
+}
+
+// ConfirmSelectorIsNil applies the IsNil predicate on the "confirm_selector" field.
+func ConfirmSelectorIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldConfirmSelector))
+}
+
+// ConfirmSelectorNotNil applies the NotNil predicate on the "confirm_selector" field.
+func ConfirmSelectorNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldConfirmSelector))
+}
+
+// ConfirmSelectorEqualFold applies the EqualFold predicate on the "confirm_selector" field.
+func ConfirmSelectorEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldConfirmSelector, v))
+}
+
+// ConfirmSelectorContainsFold applies the ContainsFold predicate on the "confirm_selector" field.
+func ConfirmSelectorContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldConfirmSelector, v))
+}
+
+// ConfirmVerifierEQ applies the EQ predicate on the "confirm_verifier" field.
+func ConfirmVerifierEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldConfirmVerifier, v))
+}
+
+// ConfirmVerifierNEQ applies the NEQ predicate on the "confirm_verifier" field.
+func ConfirmVerifierNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldConfirmVerifier, v))
+}
+
+// ConfirmVerifierIn applies the In predicate on the "confirm_verifier" field.
+func ConfirmVerifierIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldConfirmVerifier, vs...))
+}
+
+// ConfirmVerifierNotIn applies the NotIn predicate on the "confirm_verifier" field.
+func ConfirmVerifierNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldConfirmVerifier, vs...))
+}
+
+// ConfirmVerifierGT applies the GT predicate on the "confirm_verifier" field.
+func ConfirmVerifierGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldConfirmVerifier, v))
+}
+
+// ConfirmVerifierGTE applies the GTE predicate on the "confirm_verifier" field.
+func ConfirmVerifierGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldConfirmVerifier, v))
+}
+
+// ConfirmVerifierLT applies the LT predicate on the "confirm_verifier" field.
+func ConfirmVerifierLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldConfirmVerifier, v))
+}
+
+// ConfirmVerifierLTE applies the LTE predicate on the "confirm_verifier" field.
+func ConfirmVerifierLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldConfirmVerifier, v))
+}
+
+// ConfirmVerifierContains applies the Contains predicate on the "confirm_verifier" field.
+func ConfirmVerifierContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldConfirmVerifier, v))
+}
+
+// ConfirmVerifierHasPrefix applies the HasPrefix predicate on the "confirm_verifier" field.
+func ConfirmVerifierHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldConfirmVerifier, v))
+}
+
+// ConfirmVerifierHasSuffix applies the HasSuffix predicate on the "confirm_verifier" field.
+func ConfirmVerifierHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldConfirmVerifier, v))
+}
+
+// ConfirmVerifierIsNil applies the IsNil predicate on the "confirm_verifier" field.
+func ConfirmVerifierIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldConfirmVerifier))
+}
+
+// ConfirmVerifierNotNil applies the NotNil predicate on the "confirm_verifier" field.
+func ConfirmVerifierNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldConfirmVerifier))
+}
+
+// ConfirmVerifierEqualFold applies the EqualFold predicate on the "confirm_verifier" field.
+func ConfirmVerifierEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldConfirmVerifier, v))
+}
+
+// ConfirmVerifierContainsFold applies the ContainsFold predicate on the "confirm_verifier" field.
+func ConfirmVerifierContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldConfirmVerifier, v))
+}
+
+// RecoverSelectorEQ applies the EQ predicate on the "recover_selector" field.
+func RecoverSelectorEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRecoverSelector, v))
+}
+
+// RecoverSelectorNEQ applies the NEQ predicate on the "recover_selector" field.
+func RecoverSelectorNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRecoverSelector, v))
+}
+
+// RecoverSelectorIn applies the In predicate on the "recover_selector" field.
+func RecoverSelectorIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRecoverSelector, vs...))
+}
+
+// RecoverSelectorNotIn applies the NotIn predicate on the "recover_selector" field.
+func RecoverSelectorNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRecoverSelector, vs...))
+}
+
+// RecoverSelectorGT applies the GT predicate on the "recover_selector" field.
+func RecoverSelectorGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldRecoverSelector, v))
+}
+
+// RecoverSelectorGTE applies the GTE predicate on the "recover_selector" field.
+func RecoverSelectorGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldRecoverSelector, v))
+}
+
+// RecoverSelectorLT applies the LT predicate on the "recover_selector" field.
+func RecoverSelectorLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldRecoverSelector, v))
+}
+
+// RecoverSelectorLTE applies the LTE predicate on the "recover_selector" field.
+func RecoverSelectorLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldRecoverSelector, v))
+}
+
+// RecoverSelectorContains applies the Contains predicate on the "recover_selector" field.
+func RecoverSelectorContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldRecoverSelector, v))
+}
+
+// RecoverSelectorHasPrefix applies the HasPrefix predicate on the "recover_selector" field.
+func RecoverSelectorHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldRecoverSelector, v))
+}
+
+// RecoverSelectorHasSuffix applies the HasSuffix predicate on the "recover_selector" field.
+func RecoverSelectorHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldRecoverSelector, v))
+}
+
+// RecoverSelectorIsNil applies the IsNil predicate on the "recover_selector" field.
+func RecoverSelectorIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldRecoverSelector))
+}
+
+// RecoverSelectorNotNil applies the NotNil predicate on the "recover_selector" field.
+func RecoverSelectorNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldRecoverSelector))
+}
+
+// RecoverSelectorEqualFold applies the EqualFold predicate on the "recover_selector" field.
+func RecoverSelectorEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldRecoverSelector, v))
+}
+
+// RecoverSelectorContainsFold applies the ContainsFold predicate on the "recover_selector" field.
+func RecoverSelectorContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldRecoverSelector, v))
+}
+
+// RecoverVerifierEQ applies the EQ predicate on the "recover_verifier" field.
+func RecoverVerifierEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRecoverVerifier, v))
+}
+
+// RecoverVerifierNEQ applies the NEQ predicate on the "recover_verifier" field.
+func RecoverVerifierNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRecoverVerifier, v))
+}
+
+// RecoverVerifierIn applies the In predicate on the "recover_verifier" field.
+func RecoverVerifierIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRecoverVerifier, vs...))
+}
+
+// RecoverVerifierNotIn applies the NotIn predicate on the "recover_verifier" field.
+func RecoverVerifierNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRecoverVerifier, vs...))
+}
+
+// RecoverVerifierGT applies the GT predicate on the "recover_verifier" field.
+func RecoverVerifierGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldRecoverVerifier, v))
+}
+
+// RecoverVerifierGTE applies the GTE predicate on the "recover_verifier" field.
+func RecoverVerifierGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldRecoverVerifier, v))
+}
+
+// RecoverVerifierLT applies the LT predicate on the "recover_verifier" field.
+func RecoverVerifierLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldRecoverVerifier, v))
+}
+
+// RecoverVerifierLTE applies the LTE predicate on the "recover_verifier" field.
+func RecoverVerifierLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldRecoverVerifier, v))
+}
+
+// RecoverVerifierContains applies the Contains predicate on the "recover_verifier" field.
+func RecoverVerifierContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldRecoverVerifier, v))
+}
+
+// RecoverVerifierHasPrefix applies the HasPrefix predicate on the "recover_verifier" field.
+func RecoverVerifierHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldRecoverVerifier, v))
+}
+
+// RecoverVerifierHasSuffix applies the HasSuffix predicate on the "recover_verifier" field.
+func RecoverVerifierHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldRecoverVerifier, v))
+}
+
+// RecoverVerifierIsNil applies the IsNil predicate on the "recover_verifier" field.
+func RecoverVerifierIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldRecoverVerifier))
+}
+
+// RecoverVerifierNotNil applies the NotNil predicate on the "recover_verifier" field.
+func RecoverVerifierNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldRecoverVerifier))
+}
+
+// RecoverVerifierEqualFold applies the EqualFold predicate on the "recover_verifier" field.
+func RecoverVerifierEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldRecoverVerifier, v))
+}
+
+// RecoverVerifierContainsFold applies the ContainsFold predicate on the "recover_verifier" field.
+func RecoverVerifierContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldRecoverVerifier, v))
+}
+
+// RecoverExpiryEQ applies the EQ predicate on the "recover_expiry" field.
+func RecoverExpiryEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRecoverExpiry, v))
+}
+
+// RecoverExpiryNEQ applies the NEQ predicate on the "recover_expiry" field.
+func RecoverExpiryNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRecoverExpiry, v))
+}
+
+// RecoverExpiryIn applies the In predicate on the "recover_expiry" field.
+func RecoverExpiryIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRecoverExpiry, vs...))
+}
+
+// RecoverExpiryNotIn applies the NotIn predicate on the "recover_expiry" field.
+func RecoverExpiryNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRecoverExpiry, vs...))
+}
+
+// RecoverExpiryGT applies the GT predicate on the "recover_expiry" field.
+func RecoverExpiryGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldRecoverExpiry, v))
+}
+
+// RecoverExpiryGTE applies the GTE predicate on the "recover_expiry" field.
+func RecoverExpiryGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldRecoverExpiry, v))
+}
+
+// RecoverExpiryLT applies the LT predicate on the "recover_expiry" field.
+func RecoverExpiryLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldRecoverExpiry, v))
+}
+
+// RecoverExpiryLTE applies the LTE predicate on the "recover_expiry" field.
+func RecoverExpiryLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldRecoverExpiry, v))
+}
+
+// RecoverExpiryIsNil applies the IsNil predicate on the "recover_expiry" field.
+func RecoverExpiryIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldRecoverExpiry))
+}
+
+// RecoverExpiryNotNil applies the NotNil predicate on the "recover_expiry" field.
+func RecoverExpiryNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldRecoverExpiry))
+}
+
+// SessionVersionEQ applies the EQ predicate on the "session_version" field.
+func SessionVersionEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSessionVersion, v))
+}
+
+// SessionVersionNEQ applies the NEQ predicate on the "session_version" field.
+func SessionVersionNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSessionVersion, v))
+}
+
+// SessionVersionIn applies the In predicate on the "session_version" field.
+func SessionVersionIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSessionVersion, vs...))
+}
+
+// SessionVersionNotIn applies the NotIn predicate on the "session_version" field.
+func SessionVersionNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSessionVersion, vs...))
+}
+
+// SessionVersionGT applies the GT predicate on the "session_version" field.
+func SessionVersionGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSessionVersion, v))
+}
+
+// SessionVersionGTE applies the GTE predicate on the "session_version" field.
+func SessionVersionGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSessionVersion, v))
+}
+
+// SessionVersionLT applies the LT predicate on the "session_version" field.
+func SessionVersionLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSessionVersion, v))
+}
+
+// SessionVersionLTE applies the LTE predicate on the "session_version" field.
+func SessionVersionLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSessionVersion, v))
+}
+
+// AttemptCountEQ applies the EQ predicate on the "attempt_count" field.
+func AttemptCountEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAttemptCount, v))
+}
+
+// AttemptCountNEQ applies the NEQ predicate on the "attempt_count" field.
+func AttemptCountNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldAttemptCount, v))
+}
+
+// AttemptCountIn applies the In predicate on the "attempt_count" field.
+func AttemptCountIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldAttemptCount, vs...))
+}
+
+// AttemptCountNotIn applies the NotIn predicate on the "attempt_count" field.
+func AttemptCountNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldAttemptCount, vs...))
+}
+
+// AttemptCountGT applies the GT predicate on the "attempt_count" field.
+func AttemptCountGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldAttemptCount, v))
+}
+
+// AttemptCountGTE applies the GTE predicate on the "attempt_count" field.
+func AttemptCountGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldAttemptCount, v))
+}
+
+// AttemptCountLT applies the LT predicate on the "attempt_count" field.
+func AttemptCountLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldAttemptCount, v))
+}
+
+// AttemptCountLTE applies the LTE predicate on the "attempt_count" field.
+func AttemptCountLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldAttemptCount, v))
+}
+
+// LastAttemptAtEQ applies the EQ predicate on the "last_attempt_at" field.
+func LastAttemptAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtNEQ applies the NEQ predicate on the "last_attempt_at" field.
+func LastAttemptAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtIn applies the In predicate on the "last_attempt_at" field.
+func LastAttemptAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLastAttemptAt, vs...))
+}
+
+// LastAttemptAtNotIn applies the NotIn predicate on the "last_attempt_at" field.
+func LastAttemptAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLastAttemptAt, vs...))
+}
+
+// LastAttemptAtGT applies the GT predicate on the "last_attempt_at" field.
+func LastAttemptAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtGTE applies the GTE predicate on the "last_attempt_at" field.
+func LastAttemptAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtLT applies the LT predicate on the "last_attempt_at" field.
+func LastAttemptAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtLTE applies the LTE predicate on the "last_attempt_at" field.
+func LastAttemptAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtIsNil applies the IsNil predicate on the "last_attempt_at" field.
+func LastAttemptAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLastAttemptAt))
+}
+
+// LastAttemptAtNotNil applies the NotNil predicate on the "last_attempt_at" field.
+func LastAttemptAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLastAttemptAt))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// BannedAtEQ applies the EQ predicate on the "banned_at" field.
+func BannedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBannedAt, v))
+}
+
+// BannedAtNEQ applies the NEQ predicate on the "banned_at" field.
+func BannedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldBannedAt, v))
+}
+
+// BannedAtIn applies the In predicate on the "banned_at" field.
+func BannedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldBannedAt, vs...))
+}
+
+// BannedAtNotIn applies the NotIn predicate on the "banned_at" field.
+func BannedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldBannedAt, vs...))
+}
+
+// BannedAtGT applies the GT predicate on the "banned_at" field.
+func BannedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldBannedAt, v))
+}
+
+// BannedAtGTE applies the GTE predicate on the "banned_at" field.
+func BannedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldBannedAt, v))
+}
+
+// BannedAtLT applies the LT predicate on the "banned_at" field.
+func BannedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldBannedAt, v))
+}
+
+// BannedAtLTE applies the LTE predicate on the "banned_at" field.
+func BannedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldBannedAt, v))
+}
+
+// BannedAtIsNil applies the IsNil predicate on the "banned_at" field.
+func BannedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldBannedAt))
+}
+
+// BannedAtNotNil applies the NotNil predicate on the "banned_at" field.
+func BannedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldBannedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -41,6 +41,160 @@ func (uc *UserCreate) SetPassword(s string) *UserCreate {
 	return uc
 }
 
+// SetConfirmed sets the "confirmed" field.
+func (uc *UserCreate) SetConfirmed(b bool) *UserCreate {
+	uc.mutation.SetConfirmed(b)
+	return uc
+}
+
+// SetNillableConfirmed sets the "confirmed" field if the given value is not nil.
+func (uc *UserCreate) SetNillableConfirmed(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetConfirmed(*b)
+	}
+	return uc
+}
+
+// SetConfirmSelector sets the "confirm_selector" field.
+func (uc *UserCreate) SetConfirmSelector(s string) *UserCreate {
+	uc.mutation.SetConfirmSelector(s)
+	return uc
+}
+
+// SetNillableConfirmSelector sets the "confirm_selector" field if the given value is not nil.
+func (uc *UserCreate) SetNillableConfirmSelector(s *string) *UserCreate {
+	if s != nil {
+		uc.SetConfirmSelector(*s)
+	}
+	return uc
+}
+
+// SetConfirmVerifier sets the "confirm_verifier" field.
+func (uc *UserCreate) SetConfirmVerifier(s string) *UserCreate {
+	uc.mutation.SetConfirmVerifier(s)
+	return uc
+}
+
+// SetNillableConfirmVerifier sets the "confirm_verifier" field if the given value is not nil.
+func (uc *UserCreate) SetNillableConfirmVerifier(s *string) *UserCreate {
+	if s != nil {
+		uc.SetConfirmVerifier(*s)
+	}
+	return uc
+}
+
+// SetRecoverSelector sets the "recover_selector" field.
+func (uc *UserCreate) SetRecoverSelector(s string) *UserCreate {
+	uc.mutation.SetRecoverSelector(s)
+	return uc
+}
+
+// SetNillableRecoverSelector sets the "recover_selector" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRecoverSelector(s *string) *UserCreate {
+	if s != nil {
+		uc.SetRecoverSelector(*s)
+	}
+	return uc
+}
+
+// SetRecoverVerifier sets the "recover_verifier" field.
+func (uc *UserCreate) SetRecoverVerifier(s string) *UserCreate {
+	uc.mutation.SetRecoverVerifier(s)
+	return uc
+}
+
+// SetNillableRecoverVerifier sets the "recover_verifier" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRecoverVerifier(s *string) *UserCreate {
+	if s != nil {
+		uc.SetRecoverVerifier(*s)
+	}
+	return uc
+}
+
+// SetRecoverExpiry sets the "recover_expiry" field.
+func (uc *UserCreate) SetRecoverExpiry(t time.Time) *UserCreate {
+	uc.mutation.SetRecoverExpiry(t)
+	return uc
+}
+
+// SetNillableRecoverExpiry sets the "recover_expiry" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRecoverExpiry(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetRecoverExpiry(*t)
+	}
+	return uc
+}
+
+// SetSessionVersion sets the "session_version" field.
+func (uc *UserCreate) SetSessionVersion(i int) *UserCreate {
+	uc.mutation.SetSessionVersion(i)
+	return uc
+}
+
+// SetNillableSessionVersion sets the "session_version" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSessionVersion(i *int) *UserCreate {
+	if i != nil {
+		uc.SetSessionVersion(*i)
+	}
+	return uc
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (uc *UserCreate) SetAttemptCount(i int) *UserCreate {
+	uc.mutation.SetAttemptCount(i)
+	return uc
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (uc *UserCreate) SetNillableAttemptCount(i *int) *UserCreate {
+	if i != nil {
+		uc.SetAttemptCount(*i)
+	}
+	return uc
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (uc *UserCreate) SetLastAttemptAt(t time.Time) *UserCreate {
+	uc.mutation.SetLastAttemptAt(t)
+	return uc
+}
+
+// SetNillableLastAttemptAt sets the "last_attempt_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLastAttemptAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetLastAttemptAt(*t)
+	}
+	return uc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uc *UserCreate) SetLockedUntil(t time.Time) *UserCreate {
+	uc.mutation.SetLockedUntil(t)
+	return uc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLockedUntil(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetLockedUntil(*t)
+	}
+	return uc
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (uc *UserCreate) SetBannedAt(t time.Time) *UserCreate {
+	uc.mutation.SetBannedAt(t)
+	return uc
+}
+
+// SetNillableBannedAt sets the "banned_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableBannedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetBannedAt(*t)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -148,6 +302,18 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (uc *UserCreate) defaults() {
+	if _, ok := uc.mutation.Confirmed(); !ok {
+		v := user.DefaultConfirmed
+		uc.mutation.SetConfirmed(v)
+	}
+	if _, ok := uc.mutation.SessionVersion(); !ok {
+		v := user.DefaultSessionVersion
+		uc.mutation.SetSessionVersion(v)
+	}
+	if _, ok := uc.mutation.AttemptCount(); !ok {
+		v := user.DefaultAttemptCount
+		uc.mutation.SetAttemptCount(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
@@ -183,6 +349,15 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Password(); !ok {
 		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "User.password"`)}
 	}
+	if _, ok := uc.mutation.Confirmed(); !ok {
+		return &ValidationError{Name: "confirmed", err: errors.New(`ent: missing required field "User.confirmed"`)}
+	}
+	if _, ok := uc.mutation.SessionVersion(); !ok {
+		return &ValidationError{Name: "session_version", err: errors.New(`ent: missing required field "User.session_version"`)}
+	}
+	if _, ok := uc.mutation.AttemptCount(); !ok {
+		return &ValidationError{Name: "attempt_count", err: errors.New(`ent: missing required field "User.attempt_count"`)}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -236,6 +411,50 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 		_node.Password = value
 	}
+	if value, ok := uc.mutation.Confirmed(); ok {
+		_spec.SetField(user.FieldConfirmed, field.TypeBool, value)
+		_node.Confirmed = value
+	}
+	if value, ok := uc.mutation.ConfirmSelector(); ok {
+		_spec.SetField(user.FieldConfirmSelector, field.TypeString, value)
+		_node.ConfirmSelector = value
+	}
+	if value, ok := uc.mutation.ConfirmVerifier(); ok {
+		_spec.SetField(user.FieldConfirmVerifier, field.TypeString, value)
+		_node.ConfirmVerifier = value
+	}
+	if value, ok := uc.mutation.RecoverSelector(); ok {
+		_spec.SetField(user.FieldRecoverSelector, field.TypeString, value)
+		_node.RecoverSelector = value
+	}
+	if value, ok := uc.mutation.RecoverVerifier(); ok {
+		_spec.SetField(user.FieldRecoverVerifier, field.TypeString, value)
+		_node.RecoverVerifier = value
+	}
+	if value, ok := uc.mutation.RecoverExpiry(); ok {
+		_spec.SetField(user.FieldRecoverExpiry, field.TypeTime, value)
+		_node.RecoverExpiry = &value
+	}
+	if value, ok := uc.mutation.SessionVersion(); ok {
+		_spec.SetField(user.FieldSessionVersion, field.TypeInt, value)
+		_node.SessionVersion = value
+	}
+	if value, ok := uc.mutation.AttemptCount(); ok {
+		_spec.SetField(user.FieldAttemptCount, field.TypeInt, value)
+		_node.AttemptCount = value
+	}
+	if value, ok := uc.mutation.LastAttemptAt(); ok {
+		_spec.SetField(user.FieldLastAttemptAt, field.TypeTime, value)
+		_node.LastAttemptAt = &value
+	}
+	if value, ok := uc.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := uc.mutation.BannedAt(); ok {
+		_spec.SetField(user.FieldBannedAt, field.TypeTime, value)
+		_node.BannedAt = &value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -21,8 +21,9 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -73,6 +74,222 @@ func (uu *UserUpdate) SetNillablePassword(s *string) *UserUpdate {
 	return uu
 }
 
+// SetConfirmed sets the "confirmed" field.
+func (uu *UserUpdate) SetConfirmed(b bool) *UserUpdate {
+	uu.mutation.SetConfirmed(b)
+	return uu
+}
+
+// SetNillableConfirmed sets the "confirmed" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableConfirmed(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetConfirmed(*b)
+	}
+	return uu
+}
+
+// SetConfirmSelector sets the "confirm_selector" field.
+func (uu *UserUpdate) SetConfirmSelector(s string) *UserUpdate {
+	uu.mutation.SetConfirmSelector(s)
+	return uu
+}
+
+// SetNillableConfirmSelector sets the "confirm_selector" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableConfirmSelector(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetConfirmSelector(*s)
+	}
+	return uu
+}
+
+// ClearConfirmSelector clears the value of the "confirm_selector" field.
+func (uu *UserUpdate) ClearConfirmSelector() *UserUpdate {
+	uu.mutation.ClearConfirmSelector()
+	return uu
+}
+
+// SetConfirmVerifier sets the "confirm_verifier" field.
+func (uu *UserUpdate) SetConfirmVerifier(s string) *UserUpdate {
+	uu.mutation.SetConfirmVerifier(s)
+	return uu
+}
+
+// SetNillableConfirmVerifier sets the "confirm_verifier" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableConfirmVerifier(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetConfirmVerifier(*s)
+	}
+	return uu
+}
+
+// ClearConfirmVerifier clears the value of the "confirm_verifier" field.
+func (uu *UserUpdate) ClearConfirmVerifier() *UserUpdate {
+	uu.mutation.ClearConfirmVerifier()
+	return uu
+}
+
+// SetRecoverSelector sets the "recover_selector" field.
+func (uu *UserUpdate) SetRecoverSelector(s string) *UserUpdate {
+	uu.mutation.SetRecoverSelector(s)
+	return uu
+}
+
+// SetNillableRecoverSelector sets the "recover_selector" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRecoverSelector(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetRecoverSelector(*s)
+	}
+	return uu
+}
+
+// ClearRecoverSelector clears the value of the "recover_selector" field.
+func (uu *UserUpdate) ClearRecoverSelector() *UserUpdate {
+	uu.mutation.ClearRecoverSelector()
+	return uu
+}
+
+// SetRecoverVerifier sets the "recover_verifier" field.
+func (uu *UserUpdate) SetRecoverVerifier(s string) *UserUpdate {
+	uu.mutation.SetRecoverVerifier(s)
+	return uu
+}
+
+// SetNillableRecoverVerifier sets the "recover_verifier" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRecoverVerifier(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetRecoverVerifier(*s)
+	}
+	return uu
+}
+
+// ClearRecoverVerifier clears the value of the "recover_verifier" field.
+func (uu *UserUpdate) ClearRecoverVerifier() *UserUpdate {
+	uu.mutation.ClearRecoverVerifier()
+	return uu
+}
+
+// SetRecoverExpiry sets the "recover_expiry" field.
+func (uu *UserUpdate) SetRecoverExpiry(t time.Time) *UserUpdate {
+	uu.mutation.SetRecoverExpiry(t)
+	return uu
+}
+
+// SetNillableRecoverExpiry sets the "recover_expiry" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRecoverExpiry(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetRecoverExpiry(*t)
+	}
+	return uu
+}
+
+// ClearRecoverExpiry clears the value of the "recover_expiry" field.
+func (uu *UserUpdate) ClearRecoverExpiry() *UserUpdate {
+	uu.mutation.ClearRecoverExpiry()
+	return uu
+}
+
+// SetSessionVersion sets the "session_version" field.
+func (uu *UserUpdate) SetSessionVersion(i int) *UserUpdate {
+	uu.mutation.ResetSessionVersion()
+	uu.mutation.SetSessionVersion(i)
+	return uu
+}
+
+// SetNillableSessionVersion sets the "session_version" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSessionVersion(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetSessionVersion(*i)
+	}
+	return uu
+}
+
+// AddSessionVersion adds i to the "session_version" field.
+func (uu *UserUpdate) AddSessionVersion(i int) *UserUpdate {
+	uu.mutation.AddSessionVersion(i)
+	return uu
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (uu *UserUpdate) SetAttemptCount(i int) *UserUpdate {
+	uu.mutation.ResetAttemptCount()
+	uu.mutation.SetAttemptCount(i)
+	return uu
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAttemptCount(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetAttemptCount(*i)
+	}
+	return uu
+}
+
+// AddAttemptCount adds i to the "attempt_count" field.
+func (uu *UserUpdate) AddAttemptCount(i int) *UserUpdate {
+	uu.mutation.AddAttemptCount(i)
+	return uu
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (uu *UserUpdate) SetLastAttemptAt(t time.Time) *UserUpdate {
+	uu.mutation.SetLastAttemptAt(t)
+	return uu
+}
+
+// SetNillableLastAttemptAt sets the "last_attempt_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLastAttemptAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetLastAttemptAt(*t)
+	}
+	return uu
+}
+
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (uu *UserUpdate) ClearLastAttemptAt() *UserUpdate {
+	uu.mutation.ClearLastAttemptAt()
+	return uu
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uu *UserUpdate) SetLockedUntil(t time.Time) *UserUpdate {
+	uu.mutation.SetLockedUntil(t)
+	return uu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLockedUntil(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetLockedUntil(*t)
+	}
+	return uu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (uu *UserUpdate) ClearLockedUntil() *UserUpdate {
+	uu.mutation.ClearLockedUntil()
+	return uu
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (uu *UserUpdate) SetBannedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetBannedAt(t)
+	return uu
+}
+
+// SetNillableBannedAt sets the "banned_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableBannedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetBannedAt(*t)
+	}
+	return uu
+}
+
+// ClearBannedAt clears the value of the "banned_at" field.
+func (uu *UserUpdate) ClearBannedAt() *UserUpdate {
+	uu.mutation.ClearBannedAt()
+	return uu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uu *UserUpdate) SetUpdatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetUpdatedAt(t)
@@ -207,6 +424,12 @@ func (uu *UserUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uu *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
+	uu.modifiers = append(uu.modifiers, modifiers...)
+	return uu
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := uu.check(); err != nil {
 		return n, err
@@ -228,6 +451,69 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Password(); ok {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.Confirmed(); ok {
+		_spec.SetField(user.FieldConfirmed, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.ConfirmSelector(); ok {
+		_spec.SetField(user.FieldConfirmSelector, field.TypeString, value)
+	}
+	if uu.mutation.ConfirmSelectorCleared() {
+		_spec.ClearField(user.FieldConfirmSelector, field.TypeString)
+	}
+	if value, ok := uu.mutation.ConfirmVerifier(); ok {
+		_spec.SetField(user.FieldConfirmVerifier, field.TypeString, value)
+	}
+	if uu.mutation.ConfirmVerifierCleared() {
+		_spec.ClearField(user.FieldConfirmVerifier, field.TypeString)
+	}
+	if value, ok := uu.mutation.RecoverSelector(); ok {
+		_spec.SetField(user.FieldRecoverSelector, field.TypeString, value)
+	}
+	if uu.mutation.RecoverSelectorCleared() {
+		_spec.ClearField(user.FieldRecoverSelector, field.TypeString)
+	}
+	if value, ok := uu.mutation.RecoverVerifier(); ok {
+		_spec.SetField(user.FieldRecoverVerifier, field.TypeString, value)
+	}
+	if uu.mutation.RecoverVerifierCleared() {
+		_spec.ClearField(user.FieldRecoverVerifier, field.TypeString)
+	}
+	if value, ok := uu.mutation.RecoverExpiry(); ok {
+		_spec.SetField(user.FieldRecoverExpiry, field.TypeTime, value)
+	}
+	if uu.mutation.RecoverExpiryCleared() {
+		_spec.ClearField(user.FieldRecoverExpiry, field.TypeTime)
+	}
+	if value, ok := uu.mutation.SessionVersion(); ok {
+		_spec.SetField(user.FieldSessionVersion, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedSessionVersion(); ok {
+		_spec.AddField(user.FieldSessionVersion, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AttemptCount(); ok {
+		_spec.SetField(user.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(user.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.LastAttemptAt(); ok {
+		_spec.SetField(user.FieldLastAttemptAt, field.TypeTime, value)
+	}
+	if uu.mutation.LastAttemptAtCleared() {
+		_spec.ClearField(user.FieldLastAttemptAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if uu.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := uu.mutation.BannedAt(); ok {
+		_spec.SetField(user.FieldBannedAt, field.TypeTime, value)
+	}
+	if uu.mutation.BannedAtCleared() {
+		_spec.ClearField(user.FieldBannedAt, field.TypeTime)
+	}
 	if value, ok := uu.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -321,6 +607,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(uu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -336,9 +623,10 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUsername sets the "username" field.
@@ -383,6 +671,222 @@ func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// SetConfirmed sets the "confirmed" field.
+func (uuo *UserUpdateOne) SetConfirmed(b bool) *UserUpdateOne {
+	uuo.mutation.SetConfirmed(b)
+	return uuo
+}
+
+// SetNillableConfirmed sets the "confirmed" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableConfirmed(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetConfirmed(*b)
+	}
+	return uuo
+}
+
+// SetConfirmSelector sets the "confirm_selector" field.
+func (uuo *UserUpdateOne) SetConfirmSelector(s string) *UserUpdateOne {
+	uuo.mutation.SetConfirmSelector(s)
+	return uuo
+}
+
+// SetNillableConfirmSelector sets the "confirm_selector" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableConfirmSelector(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetConfirmSelector(*s)
+	}
+	return uuo
+}
+
+// ClearConfirmSelector clears the value of the "confirm_selector" field.
+func (uuo *UserUpdateOne) ClearConfirmSelector() *UserUpdateOne {
+	uuo.mutation.ClearConfirmSelector()
+	return uuo
+}
+
+// SetConfirmVerifier sets the "confirm_verifier" field.
+func (uuo *UserUpdateOne) SetConfirmVerifier(s string) *UserUpdateOne {
+	uuo.mutation.SetConfirmVerifier(s)
+	return uuo
+}
+
+// SetNillableConfirmVerifier sets the "confirm_verifier" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableConfirmVerifier(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetConfirmVerifier(*s)
+	}
+	return uuo
+}
+
+// ClearConfirmVerifier clears the value of the "confirm_verifier" field.
+func (uuo *UserUpdateOne) ClearConfirmVerifier() *UserUpdateOne {
+	uuo.mutation.ClearConfirmVerifier()
+	return uuo
+}
+
+// SetRecoverSelector sets the "recover_selector" field.
+func (uuo *UserUpdateOne) SetRecoverSelector(s string) *UserUpdateOne {
+	uuo.mutation.SetRecoverSelector(s)
+	return uuo
+}
+
+// SetNillableRecoverSelector sets the "recover_selector" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRecoverSelector(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetRecoverSelector(*s)
+	}
+	return uuo
+}
+
+// ClearRecoverSelector clears the value of the "recover_selector" field.
+func (uuo *UserUpdateOne) ClearRecoverSelector() *UserUpdateOne {
+	uuo.mutation.ClearRecoverSelector()
+	return uuo
+}
+
+// SetRecoverVerifier sets the "recover_verifier" field.
+func (uuo *UserUpdateOne) SetRecoverVerifier(s string) *UserUpdateOne {
+	uuo.mutation.SetRecoverVerifier(s)
+	return uuo
+}
+
+// SetNillableRecoverVerifier sets the "recover_verifier" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRecoverVerifier(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetRecoverVerifier(*s)
+	}
+	return uuo
+}
+
+// ClearRecoverVerifier clears the value of the "recover_verifier" field.
+func (uuo *UserUpdateOne) ClearRecoverVerifier() *UserUpdateOne {
+	uuo.mutation.ClearRecoverVerifier()
+	return uuo
+}
+
+// SetRecoverExpiry sets the "recover_expiry" field.
+func (uuo *UserUpdateOne) SetRecoverExpiry(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetRecoverExpiry(t)
+	return uuo
+}
+
+// SetNillableRecoverExpiry sets the "recover_expiry" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRecoverExpiry(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetRecoverExpiry(*t)
+	}
+	return uuo
+}
+
+// ClearRecoverExpiry clears the value of the "recover_expiry" field.
+func (uuo *UserUpdateOne) ClearRecoverExpiry() *UserUpdateOne {
+	uuo.mutation.ClearRecoverExpiry()
+	return uuo
+}
+
+// SetSessionVersion sets the "session_version" field.
+func (uuo *UserUpdateOne) SetSessionVersion(i int) *UserUpdateOne {
+	uuo.mutation.ResetSessionVersion()
+	uuo.mutation.SetSessionVersion(i)
+	return uuo
+}
+
+// SetNillableSessionVersion sets the "session_version" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSessionVersion(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetSessionVersion(*i)
+	}
+	return uuo
+}
+
+// AddSessionVersion adds i to the "session_version" field.
+func (uuo *UserUpdateOne) AddSessionVersion(i int) *UserUpdateOne {
+	uuo.mutation.AddSessionVersion(i)
+	return uuo
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (uuo *UserUpdateOne) SetAttemptCount(i int) *UserUpdateOne {
+	uuo.mutation.ResetAttemptCount()
+	uuo.mutation.SetAttemptCount(i)
+	return uuo
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAttemptCount(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetAttemptCount(*i)
+	}
+	return uuo
+}
+
+// AddAttemptCount adds i to the "attempt_count" field.
+func (uuo *UserUpdateOne) AddAttemptCount(i int) *UserUpdateOne {
+	uuo.mutation.AddAttemptCount(i)
+	return uuo
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (uuo *UserUpdateOne) SetLastAttemptAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLastAttemptAt(t)
+	return uuo
+}
+
+// SetNillableLastAttemptAt sets the "last_attempt_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLastAttemptAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetLastAttemptAt(*t)
+	}
+	return uuo
+}
+
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (uuo *UserUpdateOne) ClearLastAttemptAt() *UserUpdateOne {
+	uuo.mutation.ClearLastAttemptAt()
+	return uuo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uuo *UserUpdateOne) SetLockedUntil(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLockedUntil(t)
+	return uuo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLockedUntil(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetLockedUntil(*t)
+	}
+	return uuo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (uuo *UserUpdateOne) ClearLockedUntil() *UserUpdateOne {
+	uuo.mutation.ClearLockedUntil()
+	return uuo
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (uuo *UserUpdateOne) SetBannedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetBannedAt(t)
+	return uuo
+}
+
+// SetNillableBannedAt sets the "banned_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBannedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetBannedAt(*t)
+	}
+	return uuo
+}
+
+// ClearBannedAt clears the value of the "banned_at" field.
+func (uuo *UserUpdateOne) ClearBannedAt() *UserUpdateOne {
+	uuo.mutation.ClearBannedAt()
+	return uuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetUpdatedAt(t)
@@ -530,6 +1034,12 @@ func (uuo *UserUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uuo *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	uuo.modifiers = append(uuo.modifiers, modifiers...)
+	return uuo
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if err := uuo.check(); err != nil {
 		return _node, err
@@ -568,6 +1078,69 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.Password(); ok {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 	}
+	if value, ok := uuo.mutation.Confirmed(); ok {
+		_spec.SetField(user.FieldConfirmed, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.ConfirmSelector(); ok {
+		_spec.SetField(user.FieldConfirmSelector, field.TypeString, value)
+	}
+	if uuo.mutation.ConfirmSelectorCleared() {
+		_spec.ClearField(user.FieldConfirmSelector, field.TypeString)
+	}
+	if value, ok := uuo.mutation.ConfirmVerifier(); ok {
+		_spec.SetField(user.FieldConfirmVerifier, field.TypeString, value)
+	}
+	if uuo.mutation.ConfirmVerifierCleared() {
+		_spec.ClearField(user.FieldConfirmVerifier, field.TypeString)
+	}
+	if value, ok := uuo.mutation.RecoverSelector(); ok {
+		_spec.SetField(user.FieldRecoverSelector, field.TypeString, value)
+	}
+	if uuo.mutation.RecoverSelectorCleared() {
+		_spec.ClearField(user.FieldRecoverSelector, field.TypeString)
+	}
+	if value, ok := uuo.mutation.RecoverVerifier(); ok {
+		_spec.SetField(user.FieldRecoverVerifier, field.TypeString, value)
+	}
+	if uuo.mutation.RecoverVerifierCleared() {
+		_spec.ClearField(user.FieldRecoverVerifier, field.TypeString)
+	}
+	if value, ok := uuo.mutation.RecoverExpiry(); ok {
+		_spec.SetField(user.FieldRecoverExpiry, field.TypeTime, value)
+	}
+	if uuo.mutation.RecoverExpiryCleared() {
+		_spec.ClearField(user.FieldRecoverExpiry, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.SessionVersion(); ok {
+		_spec.SetField(user.FieldSessionVersion, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedSessionVersion(); ok {
+		_spec.AddField(user.FieldSessionVersion, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AttemptCount(); ok {
+		_spec.SetField(user.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(user.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.LastAttemptAt(); ok {
+		_spec.SetField(user.FieldLastAttemptAt, field.TypeTime, value)
+	}
+	if uuo.mutation.LastAttemptAtCleared() {
+		_spec.ClearField(user.FieldLastAttemptAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if uuo.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.BannedAt(); ok {
+		_spec.SetField(user.FieldBannedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.BannedAtCleared() {
+		_spec.ClearField(user.FieldBannedAt, field.TypeTime)
+	}
 	if value, ok := uuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -661,6 +1234,7 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(uuo.modifiers...)
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
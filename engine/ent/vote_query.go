@@ -28,6 +28,7 @@ type VoteQuery struct {
 	withPost   *PostQuery
 	withUser   *UserQuery
 	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -303,8 +304,9 @@ func (vq *VoteQuery) Clone() *VoteQuery {
 		withPost:   vq.withPost.Clone(),
 		withUser:   vq.withUser.Clone(),
 		// clone intermediate query.
-		sql:  vq.sql.Clone(),
-		path: vq.path,
+		sql:       vq.sql.Clone(),
+		path:      vq.path,
+		modifiers: append([]func(*sql.Selector){}, vq.modifiers...),
 	}
 }
 
@@ -429,6 +431,9 @@ func (vq *VoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vote, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(vq.modifiers) > 0 {
+		_spec.Modifiers = vq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -520,6 +525,9 @@ func (vq *VoteQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Vo
 
 func (vq *VoteQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := vq.querySpec()
+	if len(vq.modifiers) > 0 {
+		_spec.Modifiers = vq.modifiers
+	}
 	_spec.Node.Columns = vq.ctx.Fields
 	if len(vq.ctx.Fields) > 0 {
 		_spec.Unique = vq.ctx.Unique != nil && *vq.ctx.Unique
@@ -582,6 +590,9 @@ func (vq *VoteQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if vq.ctx.Unique != nil && *vq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range vq.modifiers {
+		m(selector)
+	}
 	for _, p := range vq.predicates {
 		p(selector)
 	}
@@ -599,6 +610,12 @@ func (vq *VoteQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vq *VoteQuery) Modify(modifiers ...func(s *sql.Selector)) *VoteSelect {
+	vq.modifiers = append(vq.modifiers, modifiers...)
+	return vq.Select()
+}
+
 // VoteGroupBy is the group-by builder for Vote entities.
 type VoteGroupBy struct {
 	selector
@@ -688,3 +705,9 @@ func (vs *VoteSelect) sqlScan(ctx context.Context, root *VoteQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vs *VoteSelect) Modify(modifiers ...func(s *sql.Selector)) *VoteSelect {
+	vs.modifiers = append(vs.modifiers, modifiers...)
+	return vs
+}
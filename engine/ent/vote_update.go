@@ -21,8 +21,9 @@ import (
 // VoteUpdate is the builder for updating Vote entities.
 type VoteUpdate struct {
 	config
-	hooks    []Hook
-	mutation *VoteMutation
+	hooks     []Hook
+	mutation  *VoteMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the VoteUpdate builder.
@@ -163,6 +164,12 @@ func (vu *VoteUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (vu *VoteUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VoteUpdate {
+	vu.modifiers = append(vu.modifiers, modifiers...)
+	return vu
+}
+
 func (vu *VoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := vu.check(); err != nil {
 		return n, err
@@ -245,6 +252,7 @@ func (vu *VoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(vu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, vu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{vote.Label}
@@ -260,9 +268,10 @@ func (vu *VoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // VoteUpdateOne is the builder for updating a single Vote entity.
 type VoteUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *VoteMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *VoteMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetKind sets the "kind" field.
@@ -410,6 +419,12 @@ func (vuo *VoteUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (vuo *VoteUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VoteUpdateOne {
+	vuo.modifiers = append(vuo.modifiers, modifiers...)
+	return vuo
+}
+
 func (vuo *VoteUpdateOne) sqlSave(ctx context.Context) (_node *Vote, err error) {
 	if err := vuo.check(); err != nil {
 		return _node, err
@@ -509,6 +524,7 @@ func (vuo *VoteUpdateOne) sqlSave(ctx context.Context) (_node *Vote, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(vuo.modifiers...)
 	_node = &Vote{config: vuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
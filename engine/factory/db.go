@@ -0,0 +1,32 @@
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/config"
+	"fixit/engine/ent"
+	"fixit/engine/migration"
+)
+
+// DB opens a client on the test database, applying any pending migrations
+// first just as `fixit migrate up` would. The connection is closed when the
+// test ends.
+func DB(t testing.TB, opts ...ent.Option) *ent.Client {
+	db, err := sql.Open("postgres", config.GetTestDBURL())
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := migration.New(db)
+	require.NoError(t, err)
+	_, err = migrator.Up(context.Background(), 0)
+	require.NoError(t, err)
+
+	return ent.NewClient(append(opts, ent.Driver(entsql.OpenDB(dialect.Postgres, db)))...)
+}
@@ -8,24 +8,40 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 
+	"fixit/engine/auth"
 	"fixit/engine/ent"
 )
 
-// User creates a test user with a unique username
-func User(t *testing.T, client *ent.Client, usernamePattern string) *ent.User {
+// Password is what every test user logs in with
+const Password = "password123"
+
+// Passwords hashes at the lowest cost, to keep tests quick
+func Passwords(t testing.TB) *auth.Passwords {
+	passwords, err := auth.NewPasswords(bcrypt.MinCost)
+	require.NoError(t, err)
+	return passwords
+}
+
+// User creates a confirmed test user with a unique username, who can log
+// in with Password
+func User(t testing.TB, client *ent.Client, usernamePattern string) *ent.User {
 	username := Placeholder(usernamePattern)
+	hash, err := Passwords(t).GenerateHash(Password)
+	require.NoError(t, err)
 	user, err := client.User.Create().
 		SetUsername(username).
 		SetEmail(username + "@example.com").
-		SetPassword("password123").
+		SetPassword(hash).
+		SetConfirmed(true).
 		Save(context.Background())
 	require.NoError(t, err)
 	return user
 }
 
 // Community creates a test community with a unique name
-func Community(t *testing.T, client *ent.Client, namePattern string) *ent.Community {
+func Community(t testing.TB, client *ent.Client, namePattern string) *ent.Community {
 	name := Placeholder(namePattern)
 	community, err := client.Community.Create().
 		SetName(name).
@@ -0,0 +1,210 @@
+package file
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	entFile "fixit/engine/ent/file"
+	"fixit/engine/imaging"
+	"fixit/engine/storage"
+)
+
+// MaxSize is the largest upload accepted, in bytes
+const MaxSize = 10 << 20
+
+var (
+	ErrEmpty           = errors.New("file is empty")
+	ErrTooLarge        = errors.New("file is larger than 10MB")
+	ErrUnsupportedType = errors.New("only JPEG, PNG, GIF and WebP images can be uploaded")
+)
+
+// variantSizes are the derived sizes made of every upload, as the longest
+// side in pixels
+var variantSizes = []struct {
+	variant entFile.Variant
+	size    int
+}{
+	{entFile.VariantList, 480},
+	{entFile.VariantDetail, 1600},
+}
+
+// imageTypes maps the image types we accept to the extension we store
+var imageTypes = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+	"image/webp": "webp",
+}
+
+// Upload is a file as received from a client
+type Upload struct {
+	Filename string
+	Data     []byte
+}
+
+type Repository struct {
+	client *ent.Client
+	blobs  storage.BlobStore
+}
+
+func New(client *ent.Client, blobs storage.BlobStore) *Repository {
+	return &Repository{
+		client: client,
+		blobs:  blobs,
+	}
+}
+
+// Create stores an uploaded image
+func (r *Repository) Create(ctx context.Context, upload Upload) (*ent.File, error) {
+	return Create(ctx, r.client, r.blobs, upload)
+}
+
+// Create stores an uploaded image with the given client, so it can be
+// part of a caller's transaction. The content type is sniffed from the
+// data rather than trusted from the client.
+//
+// The image is normalised by the imaging package, which strips its
+// metadata, and list and detail sized copies are stored as derived files,
+// returned in the file's Derived edge.
+//
+// Data is written to the blob store before each row is inserted, so a
+// file row never points at a missing blob. If the caller's transaction
+// rolls back the blobs are left behind unreferenced.
+func Create(ctx context.Context, client *ent.Client, blobs storage.BlobStore, upload Upload) (_ *ent.File, err error) {
+	if _, _, err := Validate(upload); err != nil {
+		return nil, err
+	}
+
+	img, err := imaging.Decode(upload.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var stored []string
+	defer func() {
+		if err != nil {
+			// Best effort, the original error is the one worth reporting
+			for _, key := range stored {
+				_ = blobs.Delete(ctx, key)
+			}
+		}
+	}()
+
+	create := func(img *imaging.Image, name string, variant entFile.Variant, originalID *uuid.UUID) (*ent.File, error) {
+		encoded, err := img.Encode()
+		if err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if err := blobs.Put(ctx, id.String(), encoded.Data, encoded.ContentType); err != nil {
+			return nil, err
+		}
+		stored = append(stored, id.String())
+
+		f, err := client.File.Create().
+			SetID(id).
+			SetFilename(Filename(name, encoded.Ext)).
+			SetExtension(encoded.Ext).
+			SetContentType(encoded.ContentType).
+			SetSize(int64(len(encoded.Data))).
+			SetWidth(encoded.Width).
+			SetHeight(encoded.Height).
+			SetVariant(variant).
+			SetNillableOriginalID(originalID).
+			Save(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return f, nil
+	}
+
+	original, err := create(img, upload.Filename, entFile.VariantOriginal, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range variantSizes {
+		name := strings.TrimSuffix(original.Filename, "."+original.Extension) + "-" + string(v.variant)
+		derived, err := create(img.Fit(v.size), name, v.variant, &original.ID)
+		if err != nil {
+			return nil, err
+		}
+		original.Edges.Derived = append(original.Edges.Derived, derived)
+	}
+
+	return original, nil
+}
+
+// Validate checks an upload is a supported image of an acceptable size,
+// returning its content type and extension
+func Validate(upload Upload) (contentType string, ext string, err error) {
+	if len(upload.Data) == 0 {
+		return "", "", errors.WithStack(ErrEmpty)
+	}
+	if len(upload.Data) > MaxSize {
+		return "", "", errors.WithStack(ErrTooLarge)
+	}
+
+	contentType = http.DetectContentType(upload.Data)
+	ext, ok := imageTypes[contentType]
+	if !ok {
+		return "", "", errors.WithStack(ErrUnsupportedType)
+	}
+	if err := imaging.Check(upload.Data); err != nil {
+		return "", "", err
+	}
+	return contentType, ext, nil
+}
+
+// Filename cleans a client supplied filename, giving it the extension we
+// stored the file with and falling back to a generic name
+func Filename(name, ext string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		name = "upload"
+	}
+	if current := filepath.Ext(name); !strings.EqualFold(current, "."+ext) {
+		name = strings.TrimSuffix(name, current) + "." + ext
+	}
+	if len(name) > 255 {
+		// Keep the end, which has the extension
+		name = strings.ToValidUTF8(name[len(name)-255:], "")
+	}
+	return name
+}
+
+// Get returns a file's metadata, see Data for its contents
+func (r *Repository) Get(ctx context.Context, id uuid.UUID) (*ent.File, error) {
+	f, err := r.client.File.Get(ctx, id)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return f, nil
+}
+
+// Variant returns the derived file of the given variant when the file's
+// Derived edge was loaded, falling back to the file itself, e.g. for files
+// uploaded before derived sizes were made
+func Variant(f *ent.File, variant entFile.Variant) *ent.File {
+	for _, derived := range f.Edges.Derived {
+		if derived.Variant == variant {
+			return derived
+		}
+	}
+	return f
+}
+
+// Data reads a file's contents from the blob store
+func (r *Repository) Data(ctx context.Context, f *ent.File) ([]byte, error) {
+	return r.blobs.Get(ctx, f.ID.String())
+}
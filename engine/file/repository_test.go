@@ -0,0 +1,120 @@
+package file_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/ent"
+	entFile "fixit/engine/ent/file"
+	"fixit/engine/factory"
+	"fixit/engine/file"
+	"fixit/engine/imaging"
+	"fixit/engine/storage"
+)
+
+func TestValidate(t *testing.T) {
+	contentType, ext, err := file.Validate(file.Upload{Filename: "pothole.png", Data: pngBytes(t)})
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", contentType)
+	assert.Equal(t, "png", ext)
+
+	// Test: The extension comes from the data, not the filename
+	_, ext, err = file.Validate(file.Upload{Filename: "pothole.jpg", Data: pngBytes(t)})
+	require.NoError(t, err)
+	assert.Equal(t, "png", ext)
+
+	_, _, err = file.Validate(file.Upload{Filename: "empty.png"})
+	assert.ErrorIs(t, err, file.ErrEmpty)
+
+	_, _, err = file.Validate(file.Upload{Filename: "notes.png", Data: []byte("just some text")})
+	assert.ErrorIs(t, err, file.ErrUnsupportedType)
+
+	_, _, err = file.Validate(file.Upload{Filename: "page.png", Data: []byte("<html><script>alert(1)</script>")})
+	assert.ErrorIs(t, err, file.ErrUnsupportedType)
+
+	// Test: Data that only looks like an image is rejected before decoding
+	_, _, err = file.Validate(file.Upload{Filename: "broken.png", Data: []byte("\x89PNG\r\n\x1a\nnot really")})
+	assert.ErrorIs(t, err, imaging.ErrUndecodable)
+
+	_, _, err = file.Validate(file.Upload{Filename: "huge.png", Data: append(pngBytes(t), make([]byte, file.MaxSize)...)})
+	assert.ErrorIs(t, err, file.ErrTooLarge)
+}
+
+func TestFilename(t *testing.T) {
+	assert.Equal(t, "pothole.jpg", file.Filename("pothole.jpg", "jpg"))
+	assert.Equal(t, "passwd.jpg", file.Filename("../../etc/passwd", "jpg"))
+	assert.Equal(t, "pothole.jpg", file.Filename("pothole.webp", "jpg"))
+	assert.Equal(t, "pothole.JPG", file.Filename("pothole.JPG", "jpg"))
+	assert.Equal(t, "photo.png", file.Filename(`C:\Users\me\photo.png`, "png"))
+	assert.Equal(t, "upload.jpg", file.Filename("", "jpg"))
+	assert.Equal(t, "upload.jpg", file.Filename("  ", "jpg"))
+
+	long := file.Filename(strings.Repeat("a", 300)+".jpg", "jpg")
+	assert.Len(t, long, 255)
+	assert.True(t, strings.HasSuffix(long, ".jpg"))
+}
+
+func TestRepository_CreateAndGet(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	blobs, err := storage.NewFilesystem(t.TempDir())
+	require.NoError(t, err)
+	repo := file.New(client, blobs)
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 2000, 1000))))
+	created, err := repo.Create(ctx, file.Upload{Filename: "pothole.png", Data: buf.Bytes()})
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", created.ContentType)
+	assert.Equal(t, entFile.VariantOriginal, created.Variant)
+	assert.Equal(t, 2000, created.Width)
+
+	got, err := repo.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "pothole.png", got.Filename)
+
+	stored, err := repo.Data(ctx, got)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(stored)), got.Size)
+	decoded, err := png.Decode(bytes.NewReader(stored))
+	require.NoError(t, err)
+	assert.Equal(t, 2000, decoded.Bounds().Dx())
+
+	// Test: List and detail sizes are made as derived files
+	require.Len(t, created.Edges.Derived, 2)
+	list := file.Variant(created, entFile.VariantList)
+	assert.Equal(t, entFile.VariantList, list.Variant)
+	assert.Equal(t, created.ID, *list.OriginalID)
+	assert.Equal(t, 480, list.Width)
+	assert.Equal(t, 240, list.Height)
+	assert.Equal(t, "pothole-list.png", list.Filename)
+	detail := file.Variant(created, entFile.VariantDetail)
+	assert.Equal(t, 1600, detail.Width)
+
+	derived, err := client.File.Query().
+		Where(entFile.OriginalID(created.ID)).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, derived)
+
+	// Test: Without derived files loaded the original is used
+	assert.Equal(t, got, file.Variant(got, entFile.VariantList))
+}
+
+func pngBytes(t testing.TB) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 2, 2))))
+	return buf.Bytes()
+}
+
+func setupTestDB(t *testing.T) *ent.Client {
+	return factory.DB(t, ent.Log(t.Log))
+}
@@ -0,0 +1,121 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// earthRadiusKm is the mean radius used for great-circle distances
+const earthRadiusKm = 6371.0
+
+var (
+	ErrInvalidCoordinates = errors.New("coordinates must be a latitude between -90 and 90 and a longitude between -180 and 180")
+	ErrInvalidWKT         = errors.New("geography must be a WKT point like POINT(lng lat)")
+)
+
+// Point is a WGS84 position in degrees
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// NewPoint checks the coordinates are finite and in range
+func NewPoint(lat, lng float64) (Point, error) {
+	if math.IsNaN(lat) || math.IsNaN(lng) || math.IsInf(lat, 0) || math.IsInf(lng, 0) {
+		return Point{}, ErrInvalidCoordinates
+	}
+	if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return Point{}, ErrInvalidCoordinates
+	}
+	return Point{Lat: lat, Lng: lng}, nil
+}
+
+// ParsePoint reads a point from user input such as form or query values
+func ParsePoint(lat, lng string) (Point, error) {
+	latF, err := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+	if err != nil {
+		return Point{}, ErrInvalidCoordinates
+	}
+	lngF, err := strconv.ParseFloat(strings.TrimSpace(lng), 64)
+	if err != nil {
+		return Point{}, ErrInvalidCoordinates
+	}
+	return NewPoint(latF, lngF)
+}
+
+// ParseWKT reads a point stored as WKT. Like PostGIS, the longitude comes
+// first, and an SRID=4326 prefix is accepted.
+func ParseWKT(s string) (Point, error) {
+	s = strings.TrimSpace(s)
+	if prefix, rest, found := strings.Cut(s, ";"); found {
+		if !strings.EqualFold(prefix, "SRID=4326") {
+			return Point{}, ErrInvalidWKT
+		}
+		s = rest
+	}
+
+	upper := strings.ToUpper(s)
+	if !strings.HasPrefix(upper, "POINT") {
+		return Point{}, ErrInvalidWKT
+	}
+	body := strings.TrimSpace(s[len("POINT"):])
+	if !strings.HasPrefix(body, "(") || !strings.HasSuffix(body, ")") {
+		return Point{}, ErrInvalidWKT
+	}
+
+	coords := strings.Fields(body[1 : len(body)-1])
+	if len(coords) != 2 {
+		return Point{}, ErrInvalidWKT
+	}
+
+	p, err := ParsePoint(coords[1], coords[0])
+	if err != nil {
+		return Point{}, errors.Wrap(ErrInvalidWKT, err.Error())
+	}
+	return p, nil
+}
+
+// ValidateWKT is an ent validator for geography fields
+func ValidateWKT(s string) error {
+	_, err := ParseWKT(s)
+	return err
+}
+
+// WKT formats the point as it's stored, longitude first
+func (p Point) WKT() string {
+	return fmt.Sprintf("POINT(%s %s)",
+		strconv.FormatFloat(p.Lng, 'f', -1, 64),
+		strconv.FormatFloat(p.Lat, 'f', -1, 64),
+	)
+}
+
+// DistanceKm is the great-circle distance between two points
+func (p Point) DistanceKm(other Point) float64 {
+	lat1 := radians(p.Lat)
+	lat2 := radians(other.Lat)
+	dLat := lat2 - lat1
+	dLng := radians(other.Lng - p.Lng)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// Area is a circle around a point
+type Area struct {
+	Center   Point
+	RadiusKm float64
+}
+
+// Contains is true when p is within the area, including its edge
+func (a Area) Contains(p Point) bool {
+	return a.Center.DistanceKm(p) <= a.RadiusKm
+}
@@ -0,0 +1,114 @@
+package geo_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/geo"
+)
+
+func TestParseWKT(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  geo.Point
+		err   bool
+	}{
+		{name: "point", input: "POINT(-1.7797 51.5558)", want: geo.Point{Lat: 51.5558, Lng: -1.7797}},
+		{name: "with srid", input: "SRID=4326;POINT(-1.7797 51.5558)", want: geo.Point{Lat: 51.5558, Lng: -1.7797}},
+		{name: "lowercase and spacing", input: " point ( 0 0 ) ", want: geo.Point{}},
+		{name: "empty", input: "", err: true},
+		{name: "not a point", input: "LINESTRING(0 0, 1 1)", err: true},
+		{name: "missing coordinate", input: "POINT(-1.7797)", err: true},
+		{name: "extra coordinate", input: "POINT(1 2 3)", err: true},
+		{name: "not numbers", input: "POINT(abc def)", err: true},
+		{name: "latitude out of range", input: "POINT(0 91)", err: true},
+		{name: "longitude out of range", input: "POINT(181 0)", err: true},
+		{name: "other srid", input: "SRID=27700;POINT(0 0)", err: true},
+		{name: "unclosed", input: "POINT(0 0", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := geo.ParseWKT(tt.input)
+			if tt.err {
+				assert.ErrorIs(t, err, geo.ErrInvalidWKT)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParsePoint(t *testing.T) {
+	p, err := geo.ParsePoint("51.5558", " -1.7797")
+	require.NoError(t, err)
+	assert.Equal(t, geo.Point{Lat: 51.5558, Lng: -1.7797}, p)
+
+	for _, input := range [][2]string{
+		{"", ""},
+		{"51.5", ""},
+		{"NaN", "0"},
+		{"Inf", "0"},
+		{"-90.1", "0"},
+		{"0", "180.5"},
+		{"51.5;DROP", "0"},
+	} {
+		_, err := geo.ParsePoint(input[0], input[1])
+		assert.ErrorIs(t, err, geo.ErrInvalidCoordinates, "lat=%q lng=%q", input[0], input[1])
+	}
+}
+
+func TestPoint_WKTRoundTrip(t *testing.T) {
+	p := geo.Point{Lat: 51.5558, Lng: -1.7797}
+	assert.Equal(t, "POINT(-1.7797 51.5558)", p.WKT())
+
+	parsed, err := geo.ParseWKT(p.WKT())
+	require.NoError(t, err)
+	assert.Equal(t, p, parsed)
+}
+
+func TestPoint_DistanceKm(t *testing.T) {
+	swindon := geo.Point{Lat: 51.5558, Lng: -1.7797}
+	bristol := geo.Point{Lat: 51.4545, Lng: -2.5879}
+
+	assert.InDelta(t, 56.9, swindon.DistanceKm(bristol), 1)
+	assert.InDelta(t, swindon.DistanceKm(bristol), bristol.DistanceKm(swindon), 1e-9)
+	assert.Zero(t, swindon.DistanceKm(swindon))
+
+	// Antipodes are half the circumference apart
+	assert.InDelta(t, math.Pi*6371, geo.Point{}.DistanceKm(geo.Point{Lng: 180}), 1e-6)
+}
+
+func TestArea_Contains(t *testing.T) {
+	area := geo.Area{Center: geo.Point{Lat: 51.5558, Lng: -1.7797}, RadiusKm: 25}
+
+	assert.True(t, area.Contains(area.Center))
+	assert.True(t, area.Contains(geo.Point{Lat: 51.6, Lng: -1.8}))
+	assert.False(t, area.Contains(geo.Point{Lat: 51.4545, Lng: -2.5879}))
+}
+
+func TestFeatureCollection_JSON(t *testing.T) {
+	fc := geo.NewFeatureCollection()
+	empty, err := json.Marshal(fc)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"FeatureCollection","features":[]}`, string(empty))
+
+	fc.Add("abc", geo.Point{Lat: 51.5558, Lng: -1.7797}, map[string]any{"title": "Pothole"})
+	out, err := json.Marshal(fc)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "FeatureCollection",
+		"features": [{
+			"type": "Feature",
+			"id": "abc",
+			"geometry": {"type": "Point", "coordinates": [-1.7797, 51.5558]},
+			"properties": {"title": "Pothole"}
+		}]
+	}`, string(out))
+}
@@ -0,0 +1,43 @@
+package geo
+
+// FeatureCollection is a GeoJSON (RFC 7946) collection of point features
+type FeatureCollection struct {
+	Type     string     `json:"type"`
+	Features []*Feature `json:"features"`
+}
+
+type Feature struct {
+	Type       string         `json:"type"`
+	ID         string         `json:"id,omitempty"`
+	Geometry   Geometry       `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+}
+
+type Geometry struct {
+	Type string `json:"type"`
+	// Coordinates are longitude first, as in WKT
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+func NewFeatureCollection() *FeatureCollection {
+	return &FeatureCollection{
+		Type:     "FeatureCollection",
+		Features: []*Feature{},
+	}
+}
+
+// Add appends a feature for a point
+func (c *FeatureCollection) Add(id string, p Point, properties map[string]any) {
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	c.Features = append(c.Features, &Feature{
+		Type: "Feature",
+		ID:   id,
+		Geometry: Geometry{
+			Type:        "Point",
+			Coordinates: [2]float64{p.Lng, p.Lat},
+		},
+		Properties: properties,
+	})
+}
@@ -0,0 +1,146 @@
+// Package imaging normalises uploaded photos. Images are decoded and
+// re-encoded, which drops EXIF and other metadata such as the GPS position
+// phones record, with the EXIF orientation applied to the pixels first.
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/pkg/errors"
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// MaxPixels stops small files that decode to huge images using up memory
+const MaxPixels = 40_000_000
+
+// JPEGQuality is used for every JPEG we encode
+const JPEGQuality = 85
+
+var (
+	ErrUndecodable   = errors.New("image could not be read")
+	ErrTooManyPixels = errors.New("image dimensions are too large")
+)
+
+// Image is a decoded photo, upright and ready to encode
+type Image struct {
+	img image.Image
+	// jpeg or png, the format Encode writes
+	format string
+}
+
+// Encoded is an image ready to store
+type Encoded struct {
+	Data        []byte
+	ContentType string
+	Ext         string
+	Width       int
+	Height      int
+}
+
+// Check reads just enough of an image to know it can be decoded and isn't
+// too large, without decoding the pixels
+func Check(data []byte) error {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return errors.WithStack(ErrUndecodable)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > MaxPixels {
+		return errors.WithStack(ErrTooManyPixels)
+	}
+	return nil
+}
+
+// Decode reads a JPEG, PNG, GIF or WebP image and rotates it upright. JPEGs
+// stay JPEGs and PNGs stay PNGs; other formats become PNG when they have
+// transparency and JPEG otherwise. Only the first frame of a GIF is kept.
+func Decode(data []byte) (*Image, error) {
+	if err := Check(data); err != nil {
+		return nil, err
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.WithStack(ErrUndecodable)
+	}
+
+	switch format {
+	case "jpeg", "png":
+	default:
+		format = "jpeg"
+		if o, ok := img.(interface{ Opaque() bool }); ok && !o.Opaque() {
+			format = "png"
+		}
+	}
+
+	return &Image{
+		img:    orient(img, orientation(data)),
+		format: format,
+	}, nil
+}
+
+// Width is in pixels, after orientation
+func (i *Image) Width() int {
+	return i.img.Bounds().Dx()
+}
+
+// Height is in pixels, after orientation
+func (i *Image) Height() int {
+	return i.img.Bounds().Dy()
+}
+
+// Fit scales the image down to fit within a size by size square, keeping its
+// aspect ratio. Images that already fit are returned as they are.
+func (i *Image) Fit(size int) *Image {
+	w, h := i.Width(), i.Height()
+	if w <= size && h <= size {
+		return i
+	}
+
+	if w >= h {
+		h = max(1, h*size/w)
+		w = size
+	} else {
+		w = max(1, w*size/h)
+		h = size
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), i.img, i.img.Bounds(), draw.Src, nil)
+	return &Image{
+		img:    dst,
+		format: i.format,
+	}
+}
+
+// Encode writes the image without any metadata
+func (i *Image) Encode() (*Encoded, error) {
+	var buf bytes.Buffer
+	encoded := &Encoded{
+		Width:  i.Width(),
+		Height: i.Height(),
+	}
+
+	switch i.format {
+	case "png":
+		if err := png.Encode(&buf, i.img); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		encoded.ContentType = "image/png"
+		encoded.Ext = "png"
+	default:
+		if err := jpeg.Encode(&buf, i.img, &jpeg.Options{Quality: JPEGQuality}); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		encoded.ContentType = "image/jpeg"
+		encoded.Ext = "jpg"
+	}
+
+	encoded.Data = buf.Bytes()
+	return encoded, nil
+}
@@ -0,0 +1,157 @@
+package imaging_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/imaging"
+)
+
+var (
+	red  = color.NRGBA{R: 255, A: 255}
+	blue = color.NRGBA{B: 255, A: 255}
+)
+
+// halves is a landscape image with a red left half and a blue right half
+func halves(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			if x < w/2 {
+				img.Set(x, y, red)
+			} else {
+				img.Set(x, y, blue)
+			}
+		}
+	}
+	return img
+}
+
+// exifSegment is a JPEG APP1 segment with just an orientation tag, and
+// a GPS marker string standing in for the metadata phones add
+func exifSegment(orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+	tiff = append(tiff, []byte("GPS 51.5,-1.78")...)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+func jpegWithOrientation(t *testing.T, img image.Image, orientation uint16) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}))
+	data := buf.Bytes()
+	// Insert the EXIF segment straight after the start of image marker
+	return append(append([]byte{0xFF, 0xD8}, exifSegment(orientation)...), data[2:]...)
+}
+
+func isRed(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	return r > 0xC000 && g < 0x4000 && b < 0x4000
+}
+
+func isBlue(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	return b > 0xC000 && r < 0x4000 && g < 0x4000
+}
+
+func TestDecode_Orientation(t *testing.T) {
+	data := jpegWithOrientation(t, halves(64, 32), 6)
+
+	img, err := imaging.Decode(data)
+	require.NoError(t, err)
+
+	// Test: A photo taken with the phone turned is rotated upright
+	assert.Equal(t, 32, img.Width())
+	assert.Equal(t, 64, img.Height())
+
+	encoded, err := img.Encode()
+	require.NoError(t, err)
+	assert.Equal(t, "image/jpeg", encoded.ContentType)
+	assert.Equal(t, "jpg", encoded.Ext)
+
+	// Test: Turning clockwise puts the left half at the top
+	decoded, err := jpeg.Decode(bytes.NewReader(encoded.Data))
+	require.NoError(t, err)
+	assert.True(t, isRed(decoded.At(16, 8)), "top should be red, got %v", decoded.At(16, 8))
+	assert.True(t, isBlue(decoded.At(16, 56)), "bottom should be blue, got %v", decoded.At(16, 56))
+
+	// Test: EXIF is stripped
+	assert.NotContains(t, string(encoded.Data), "Exif")
+	assert.NotContains(t, string(encoded.Data), "GPS")
+}
+
+func TestDecode_AllOrientations(t *testing.T) {
+	for orientation := uint16(1); orientation <= 8; orientation++ {
+		img, err := imaging.Decode(jpegWithOrientation(t, halves(64, 32), orientation))
+		require.NoError(t, err)
+		if orientation >= 5 {
+			assert.Equal(t, 32, img.Width(), "orientation %d", orientation)
+		} else {
+			assert.Equal(t, 64, img.Width(), "orientation %d", orientation)
+		}
+	}
+}
+
+func TestDecode_Formats(t *testing.T) {
+	// Test: PNGs stay PNGs
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, halves(8, 4)))
+	img, err := imaging.Decode(buf.Bytes())
+	require.NoError(t, err)
+	encoded, err := img.Encode()
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", encoded.ContentType)
+	assert.Equal(t, 8, encoded.Width)
+	assert.Equal(t, 4, encoded.Height)
+
+	// Test: Anything that isn't an image is rejected
+	_, err = imaging.Decode([]byte("\x89PNG\r\n\x1a\nnot really"))
+	assert.ErrorIs(t, err, imaging.ErrUndecodable)
+}
+
+func TestCheck_TooManyPixels(t *testing.T) {
+	// A PNG header claiming to be 10000x10000 without the pixels to match
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))))
+	data := buf.Bytes()
+	binary.BigEndian.PutUint32(data[16:], 10000)
+	binary.BigEndian.PutUint32(data[20:], 10000)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+
+	assert.ErrorIs(t, imaging.Check(data), imaging.ErrTooManyPixels)
+}
+
+func TestFit(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, halves(400, 100)))
+	img, err := imaging.Decode(buf.Bytes())
+	require.NoError(t, err)
+
+	// Test: Large images are scaled down keeping their aspect ratio
+	small := img.Fit(200)
+	assert.Equal(t, 200, small.Width())
+	assert.Equal(t, 50, small.Height())
+
+	// Test: Small images are never enlarged
+	same := img.Fit(1000)
+	assert.Equal(t, 400, same.Width())
+	assert.Equal(t, 100, same.Height())
+}
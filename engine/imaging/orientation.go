@@ -0,0 +1,175 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/draw"
+)
+
+// orientationTag is the EXIF tag saying how the camera was held
+const orientationTag = 0x0112
+
+// orientation returns the EXIF orientation of a JPEG, PNG or WebP image,
+// from 1 (upright) to 8, defaulting to 1 when there isn't one
+func orientation(data []byte) int {
+	var exif []byte
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8}):
+		exif = jpegEXIF(data)
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		exif = pngEXIF(data)
+	case len(data) >= 12 && string(data[0:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		exif = webpEXIF(data)
+	}
+
+	o := tiffOrientation(bytes.TrimPrefix(exif, []byte("Exif\x00\x00")))
+	if o < 1 || o > 8 {
+		return 1
+	}
+	return o
+}
+
+// jpegEXIF finds the APP1 segment holding EXIF data
+func jpegEXIF(data []byte) []byte {
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return nil
+		}
+		marker := data[i+1]
+		// Start of scan, the image data follows so there's no more metadata
+		if marker == 0xDA {
+			return nil
+		}
+		size := int(binary.BigEndian.Uint16(data[i+2:]))
+		if size < 2 || i+2+size > len(data) {
+			return nil
+		}
+		segment := data[i+4 : i+2+size]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment
+		}
+		i += 2 + size
+	}
+	return nil
+}
+
+// pngEXIF finds the eXIf chunk
+func pngEXIF(data []byte) []byte {
+	for i := 8; i+8 <= len(data); {
+		size := int(binary.BigEndian.Uint32(data[i:]))
+		kind := string(data[i+4 : i+8])
+		if size < 0 || i+8+size > len(data) {
+			return nil
+		}
+		if kind == "eXIf" {
+			return data[i+8 : i+8+size]
+		}
+		if kind == "IDAT" {
+			return nil
+		}
+		// length, type, data and CRC
+		i += 12 + size
+	}
+	return nil
+}
+
+// webpEXIF finds the EXIF chunk of an extended WebP
+func webpEXIF(data []byte) []byte {
+	for i := 12; i+8 <= len(data); {
+		kind := string(data[i : i+4])
+		size := int(binary.LittleEndian.Uint32(data[i+4:]))
+		if size < 0 || i+8+size > len(data) {
+			return nil
+		}
+		if kind == "EXIF" {
+			return data[i+8 : i+8+size]
+		}
+		// chunks are padded to an even length
+		i += 8 + size + size%2
+	}
+	return nil
+}
+
+// tiffOrientation reads the orientation tag from the first IFD of EXIF's
+// TIFF structure, returning 0 when it's missing or malformed
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 0
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[0:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+	if order.Uint16(tiff[2:]) != 42 {
+		return 0
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[ifd:]))
+	for n := range count {
+		entry := ifd + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		// a SHORT value is stored inline in the first two bytes
+		if order.Uint16(tiff[entry:]) == orientationTag && order.Uint16(tiff[entry+2:]) == 3 {
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+	return 0
+}
+
+// orient transforms an image so that it's upright given its EXIF orientation
+func orient(img image.Image, o int) image.Image {
+	if o <= 1 || o > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	src := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+	w, h := b.Dx(), b.Dy()
+
+	// Orientations 5 to 8 swap width and height
+	dw, dh := w, h
+	if o >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := range dh {
+		for x := range dw {
+			var sx, sy int
+			switch o {
+			case 2: // mirrored
+				sx, sy = w-1-x, y
+			case 3: // upside down
+				sx, sy = w-1-x, h-1-y
+			case 4: // upside down and mirrored
+				sx, sy = x, h-1-y
+			case 5: // transposed
+				sx, sy = y, x
+			case 6: // needs turning clockwise
+				sx, sy = y, h-1-x
+			case 7: // transversed
+				sx, sy = w-1-y, h-1-x
+			case 8: // needs turning anticlockwise
+				sx, sy = w-1-y, x
+			}
+			si := src.PixOffset(sx, sy)
+			di := dst.PixOffset(x, y)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+	return dst
+}
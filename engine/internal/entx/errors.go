@@ -0,0 +1,15 @@
+package entx
+
+import (
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// IsUniqueViolation is whether err is the named unique index rejecting a
+// duplicate, rather than another constraint like a missing foreign key
+func IsUniqueViolation(err error, index string) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) &&
+		pqErr.Code.Name() == "unique_violation" &&
+		pqErr.Constraint == index
+}
@@ -0,0 +1,27 @@
+// Package entx holds the ent helpers the engine's repositories share
+package entx
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+)
+
+// WithTx runs fn in a transaction, rolling back if it returns an error
+func WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return errors.Wrapf(err, "rolling back transaction: %v", rollbackErr)
+		}
+		return err
+	}
+
+	return errors.WithStack(tx.Commit())
+}
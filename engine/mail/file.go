@@ -0,0 +1,67 @@
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/pkg/errors"
+)
+
+// File writes each email to a .eml file under a directory instead of
+// delivering it, for development and tests. Most mail clients can open them.
+type File struct {
+	dir string
+}
+
+var _ Transport = (*File)(nil)
+
+func NewFile(dir string) (*File, error) {
+	if dir == "" {
+		return nil, errors.New("mail directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &File{
+		dir: dir,
+	}, nil
+}
+
+// Send names files by time so they list in the order they were sent, and
+// writes to a temporary file first so readers never see part of an email
+func (f *File) Send(ctx context.Context, email authboss.Email) error {
+	if err := ctx.Err(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	now := time.Now()
+	message, err := buildMessage(email, now)
+	if err != nil {
+		return err
+	}
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return errors.WithStack(err)
+	}
+	name := now.UTC().Format("20060102T150405.000000000") + "-" + hex.EncodeToString(suffix) + ".eml"
+
+	tmp, err := os.CreateTemp(f.dir, "."+name+".*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(message); err != nil {
+		tmp.Close()
+		return errors.WithStack(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(tmp.Name(), filepath.Join(f.dir, name)))
+}
@@ -0,0 +1,81 @@
+package mail_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	netmail "net/mail"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/mail"
+)
+
+func TestFile_Send(t *testing.T) {
+	dir := t.TempDir()
+	transport, err := mail.NewFile(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, transport.Send(context.Background(), testEmail()))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "only the finished file is left")
+	assert.Equal(t, ".eml", filepath.Ext(entries[0].Name()))
+
+	data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	require.NoError(t, err)
+	msg := readMessage(t, data)
+
+	// Test: Every recipient but Bcc is in the headers
+	to, err := msg.Header.AddressList("To")
+	require.NoError(t, err)
+	assert.Equal(t, []*netmail.Address{
+		{Name: "Alice", Address: "alice@example.com"},
+		{Address: "bob@example.com"},
+	}, to)
+	assert.Equal(t, "<carol@example.com>", msg.Header.Get("Cc"))
+	assert.Empty(t, msg.Header.Get("Bcc"))
+	assert.NotContains(t, string(data), "dave@example.com")
+	assert.Equal(t, `"FixIt" <noreply@fixit.local>`, msg.Header.Get("From"))
+	assert.Equal(t, "Confirm your account", msg.Header.Get("Subject"))
+
+	// Test: Both bodies come through intact
+	bodies := messageBodies(t, msg)
+	assert.Equal(t, testEmail().TextBody, bodies["text/plain"])
+	assert.Equal(t, testEmail().HTMLBody, bodies["text/html"])
+}
+
+func readMessage(t *testing.T, data []byte) *netmail.Message {
+	msg, err := netmail.ReadMessage(bytes.NewReader(data))
+	require.NoError(t, err)
+	return msg
+}
+
+// messageBodies returns the decoded parts of a multipart message by type
+func messageBodies(t *testing.T, msg *netmail.Message) map[string]string {
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+
+	bodies := map[string]string{}
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			return bodies
+		}
+		require.NoError(t, err)
+		partType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+		bodies[partType] = string(body)
+	}
+}
@@ -0,0 +1,23 @@
+package mail
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/aarondl/authboss/v3"
+)
+
+// Log doesn't deliver emails, it logs them so links in them can still be
+// followed in development
+type Log struct{}
+
+var _ Transport = Log{}
+
+func NewLog() Log {
+	return Log{}
+}
+
+func (Log) Send(_ context.Context, email authboss.Email) error {
+	slog.Info("stubbed email", "to", email.To, "cc", email.Cc, "bcc", email.Bcc, "subject", email.Subject, "body", email.TextBody)
+	return nil
+}
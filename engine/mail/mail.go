@@ -0,0 +1,145 @@
+// Package mail delivers email through a pluggable transport: SendGrid, an
+// SMTP server, .eml files on disk or the log.
+package mail
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/pkg/errors"
+)
+
+const (
+	TransportLog      = "log"
+	TransportSendGrid = "sendgrid"
+	TransportSMTP     = "smtp"
+	TransportFile     = "file"
+)
+
+var (
+	ErrUnknownTransport = errors.New("unknown mail transport")
+	ErrNoRecipients     = errors.New("email has no recipients")
+	// ErrRejected marks failures that sending again won't fix, like an
+	// invalid address or API key
+	ErrRejected = errors.New("email rejected")
+)
+
+// Transport hands an email over for delivery
+type Transport interface {
+	Send(ctx context.Context, email authboss.Email) error
+}
+
+// Config chooses and configures the transport
+type Config struct {
+	// Transport defaults to sendgrid when SendGridKey is set, and to log
+	// otherwise
+	Transport    string `env:"MAIL_TRANSPORT"`
+	SendGridKey  string `env:"SENDGRID_API_KEY"`
+	SendGridHost string `env:"SENDGRID_HOST" envDefault:"https://api.sendgrid.com"`
+	// Dir is where the file transport writes .eml files
+	Dir  string `env:"MAIL_DIR" envDefault:"data/mail"`
+	SMTP SMTPConfig
+	// Attempts is how many times a send is tried before giving up, waiting
+	// Backoff after the first failure and twice as long after each one after
+	Attempts int           `env:"MAIL_ATTEMPTS" envDefault:"3"`
+	Backoff  time.Duration `env:"MAIL_BACKOFF" envDefault:"1s"`
+}
+
+// OpenTransport returns the transport chosen by the config
+func OpenTransport(cfg Config) (Transport, error) {
+	switch cfg.Transport {
+	case "":
+		if cfg.SendGridKey != "" {
+			return NewSendGrid(cfg.SendGridKey, cfg.SendGridHost)
+		}
+		return NewLog(), nil
+	case TransportLog:
+		return NewLog(), nil
+	case TransportSendGrid:
+		return NewSendGrid(cfg.SendGridKey, cfg.SendGridHost)
+	case TransportSMTP:
+		return NewSMTP(cfg.SMTP)
+	case TransportFile:
+		return NewFile(cfg.Dir)
+	default:
+		return nil, errors.Wrapf(ErrUnknownTransport, "%q", cfg.Transport)
+	}
+}
+
+// Open returns a Mailer using the transport chosen by the config
+func Open(cfg Config) (*Mailer, error) {
+	transport, err := OpenTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewMailer(transport, cfg.Attempts, cfg.Backoff), nil
+}
+
+// Mailer sends through a transport, trying again with exponential backoff
+// when it fails
+type Mailer struct {
+	transport Transport
+	attempts  int
+	backoff   time.Duration
+}
+
+var _ authboss.Mailer = (*Mailer)(nil)
+
+func NewMailer(transport Transport, attempts int, backoff time.Duration) *Mailer {
+	if attempts < 1 {
+		attempts = 1
+	}
+	return &Mailer{
+		transport: transport,
+		attempts:  attempts,
+		backoff:   backoff,
+	}
+}
+
+// Send gives up early when the context is done or the email is rejected
+func (m *Mailer) Send(ctx context.Context, email authboss.Email) error {
+	if len(email.To)+len(email.Cc)+len(email.Bcc) == 0 {
+		return errors.WithStack(ErrNoRecipients)
+	}
+
+	wait := m.backoff
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return errors.WithStack(err)
+		}
+
+		err := m.transport.Send(ctx, email)
+		if err == nil || errors.Is(err, ErrRejected) || attempt == m.attempts {
+			return err
+		}
+		slog.Warn("failed to send email, trying again", "to", email.To, "attempt", attempt, "wait", wait, "err", err)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrapf(ctx.Err(), "gave up sending email after %d attempts: %v", attempt, err)
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+// contextErr prefers the context's error when it's done, as a cancelled
+// send usually fails with a less helpful one like a closed connection
+func contextErr(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return errors.WithStack(ctxErr)
+	}
+	return errors.WithStack(err)
+}
+
+// addressName returns the name for the address at i, if there is one
+func addressName(names []string, i int) string {
+	if i < len(names) {
+		return names[i]
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package mail_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/mail"
+)
+
+// flakyTransport fails with each of its errors in turn, then succeeds
+type flakyTransport struct {
+	errs  []error
+	calls int
+}
+
+func (f *flakyTransport) Send(context.Context, authboss.Email) error {
+	f.calls++
+	if f.calls <= len(f.errs) {
+		return f.errs[f.calls-1]
+	}
+	return nil
+}
+
+func testEmail() authboss.Email {
+	return authboss.Email{
+		To:       []string{"alice@example.com", "bob@example.com"},
+		ToNames:  []string{"Alice"},
+		Cc:       []string{"carol@example.com"},
+		Bcc:      []string{"dave@example.com"},
+		From:     "noreply@fixit.local",
+		FromName: "FixIt",
+		Subject:  "Confirm your account",
+		TextBody: "Follow http://localhost:8080/auth/confirm?cnf=abc%3D%3D to confirm",
+		HTMLBody: `<p><a href="http://localhost:8080/auth/confirm?cnf=abc%3D%3D">Confirm</a></p>`,
+	}
+}
+
+func TestMailer_Send(t *testing.T) {
+	ctx := context.Background()
+
+	// Test: Failures are tried again
+	transport := &flakyTransport{errs: []error{errors.New("timeout"), errors.New("timeout")}}
+	err := mail.NewMailer(transport, 3, time.Millisecond).Send(ctx, testEmail())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, transport.calls)
+
+	// Test: The last failure is returned once attempts run out
+	transport = &flakyTransport{errs: []error{errors.New("timeout"), errors.New("still down")}}
+	err = mail.NewMailer(transport, 2, time.Millisecond).Send(ctx, testEmail())
+	assert.ErrorContains(t, err, "still down")
+	assert.Equal(t, 2, transport.calls)
+
+	// Test: Rejected emails aren't tried again
+	transport = &flakyTransport{errs: []error{errors.Wrap(mail.ErrRejected, "bad address")}}
+	err = mail.NewMailer(transport, 3, time.Millisecond).Send(ctx, testEmail())
+	assert.ErrorIs(t, err, mail.ErrRejected)
+	assert.Equal(t, 1, transport.calls)
+
+	// Test: Emails need someone to go to
+	err = mail.NewMailer(&flakyTransport{}, 3, time.Millisecond).Send(ctx, authboss.Email{Subject: "Nobody"})
+	assert.ErrorIs(t, err, mail.ErrNoRecipients)
+}
+
+func TestMailer_SendCancelled(t *testing.T) {
+	// Test: Cancelling stops the wait before trying again
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	transport := &flakyTransport{errs: []error{errors.New("timeout"), errors.New("timeout")}}
+	start := time.Now()
+	err := mail.NewMailer(transport, 3, time.Minute).Send(ctx, testEmail())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, transport.calls)
+	assert.Less(t, time.Since(start), time.Minute)
+
+	// Test: Nothing is sent once the context is done
+	transport = &flakyTransport{}
+	err = mail.NewMailer(transport, 3, time.Millisecond).Send(ctx, testEmail())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Zero(t, transport.calls)
+}
+
+func TestOpenTransport(t *testing.T) {
+	transport, err := mail.OpenTransport(mail.Config{})
+	require.NoError(t, err)
+	assert.IsType(t, mail.Log{}, transport)
+
+	// Test: A SendGrid key picks SendGrid unless another transport is chosen
+	transport, err = mail.OpenTransport(mail.Config{SendGridKey: "key"})
+	require.NoError(t, err)
+	assert.IsType(t, &mail.SendGrid{}, transport)
+
+	transport, err = mail.OpenTransport(mail.Config{Transport: mail.TransportFile, SendGridKey: "key", Dir: t.TempDir()})
+	require.NoError(t, err)
+	assert.IsType(t, &mail.File{}, transport)
+
+	_, err = mail.OpenTransport(mail.Config{Transport: mail.TransportSendGrid})
+	assert.Error(t, err)
+
+	_, err = mail.OpenTransport(mail.Config{Transport: mail.TransportSMTP, SMTP: mail.SMTPConfig{Host: "localhost", TLS: "sometimes"}})
+	assert.Error(t, err)
+
+	_, err = mail.OpenTransport(mail.Config{Transport: "pigeon"})
+	assert.ErrorIs(t, err, mail.ErrUnknownTransport)
+}
@@ -0,0 +1,115 @@
+package mail
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/pkg/errors"
+)
+
+// buildMessage formats an email as RFC 5322 text, with both bodies as
+// alternatives when there are two. Bcc recipients are left out of the
+// headers.
+func buildMessage(email authboss.Email, now time.Time) ([]byte, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	domain := "localhost"
+	if at := strings.LastIndex(email.From, "@"); at >= 0 {
+		domain = email.From[at+1:]
+	}
+
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		if value != "" {
+			fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+		}
+	}
+	header("From", addressList([]string{email.From}, []string{email.FromName}))
+	header("To", addressList(email.To, email.ToNames))
+	header("Cc", addressList(email.Cc, email.CcNames))
+	if email.ReplyTo != "" {
+		header("Reply-To", addressList([]string{email.ReplyTo}, []string{email.ReplyToName}))
+	}
+	header("Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", fmt.Sprintf("<%s@%s>", hex.EncodeToString(id), domain))
+	header("MIME-Version", "1.0")
+
+	switch {
+	case email.TextBody != "" && email.HTMLBody != "":
+		w := multipart.NewWriter(&buf)
+		header("Content-Type", mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": w.Boundary()}))
+		buf.WriteString("\r\n")
+		for _, part := range []struct{ contentType, body string }{
+			{"text/plain", email.TextBody},
+			{"text/html", email.HTMLBody},
+		} {
+			pw, err := w.CreatePart(textproto.MIMEHeader{
+				"Content-Type":              {part.contentType + "; charset=utf-8"},
+				"Content-Transfer-Encoding": {"quoted-printable"},
+			})
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			if err := writeQuotedPrintable(pw, part.body); err != nil {
+				return nil, err
+			}
+		}
+		if err := w.Close(); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	case email.HTMLBody != "":
+		header("Content-Type", "text/html; charset=utf-8")
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeQuotedPrintable(&buf, email.HTMLBody); err != nil {
+			return nil, err
+		}
+	default:
+		header("Content-Type", "text/plain; charset=utf-8")
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeQuotedPrintable(&buf, email.TextBody); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func writeQuotedPrintable(w io.Writer, body string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(qp.Close())
+}
+
+// addressList formats addresses with their names for a header
+func addressList(addresses, names []string) string {
+	formatted := make([]string, 0, len(addresses))
+	for i, address := range addresses {
+		formatted = append(formatted, (&netmail.Address{Name: addressName(names, i), Address: address}).String())
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// recipients is everyone an email is delivered to, Bcc included
+func recipients(email authboss.Email) []string {
+	all := make([]string, 0, len(email.To)+len(email.Cc)+len(email.Bcc))
+	all = append(all, email.To...)
+	all = append(all, email.Cc...)
+	return append(all, email.Bcc...)
+}
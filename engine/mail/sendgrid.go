@@ -0,0 +1,79 @@
+package mail
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/pkg/errors"
+	"github.com/sendgrid/sendgrid-go"
+	sgmail "github.com/sendgrid/sendgrid-go/helpers/mail"
+)
+
+// SendGrid delivers through the SendGrid v3 mail send API
+type SendGrid struct {
+	apiKey string
+	host   string
+}
+
+var _ Transport = (*SendGrid)(nil)
+
+func NewSendGrid(apiKey, host string) (*SendGrid, error) {
+	if apiKey == "" {
+		return nil, errors.New("SendGrid API key is required")
+	}
+	if host == "" {
+		host = "https://api.sendgrid.com"
+	}
+	return &SendGrid{
+		apiKey: apiKey,
+		host:   host,
+	}, nil
+}
+
+func (s *SendGrid) Send(ctx context.Context, email authboss.Email) error {
+	message := sgmail.NewV3Mail()
+	message.SetFrom(sgmail.NewEmail(email.FromName, email.From))
+	message.Subject = email.Subject
+	if email.ReplyTo != "" {
+		message.SetReplyTo(sgmail.NewEmail(email.ReplyToName, email.ReplyTo))
+	}
+
+	// One personalization sends a single email everyone can see, as SMTP
+	// does, with the Bcc recipients hidden
+	p := sgmail.NewPersonalization()
+	for i, to := range email.To {
+		p.AddTos(sgmail.NewEmail(addressName(email.ToNames, i), to))
+	}
+	for i, cc := range email.Cc {
+		p.AddCCs(sgmail.NewEmail(addressName(email.CcNames, i), cc))
+	}
+	for i, bcc := range email.Bcc {
+		p.AddBCCs(sgmail.NewEmail(addressName(email.BccNames, i), bcc))
+	}
+	message.AddPersonalizations(p)
+
+	// SendGrid wants plain text before HTML
+	if email.TextBody != "" {
+		message.AddContent(sgmail.NewContent("text/plain", email.TextBody))
+	}
+	if email.HTMLBody != "" {
+		message.AddContent(sgmail.NewContent("text/html", email.HTMLBody))
+	}
+
+	request := sendgrid.GetRequest(s.apiKey, "/v3/mail/send", s.host)
+	request.Method = http.MethodPost
+	request.Body = sgmail.GetRequestBody(message)
+	resp, err := sendgrid.MakeRequestWithContext(ctx, request)
+	if err != nil {
+		return contextErr(ctx, err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return errors.Errorf("sendgrid responded %d: %s", resp.StatusCode, resp.Body)
+	case resp.StatusCode >= 300:
+		return errors.Wrapf(ErrRejected, "sendgrid responded %d: %s", resp.StatusCode, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package mail_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/mail"
+)
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+type sendGridRequest struct {
+	From             sendGridAddress `json:"from"`
+	Subject          string          `json:"subject"`
+	Personalizations []struct {
+		To  []sendGridAddress `json:"to"`
+		CC  []sendGridAddress `json:"cc"`
+		BCC []sendGridAddress `json:"bcc"`
+	} `json:"personalizations"`
+	Content []struct {
+		Type string `json:"type"`
+	} `json:"content"`
+}
+
+func TestSendGrid_Send(t *testing.T) {
+	var received sendGridRequest
+	status := http.StatusAccepted
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/mail/send", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	transport, err := mail.NewSendGrid("test-key", server.URL)
+	require.NoError(t, err)
+	require.NoError(t, transport.Send(context.Background(), testEmail()))
+
+	// Test: Every recipient is sent to, not just the first
+	assert.Equal(t, sendGridAddress{Email: "noreply@fixit.local", Name: "FixIt"}, received.From)
+	require.Len(t, received.Personalizations, 1)
+	p := received.Personalizations[0]
+	assert.Equal(t, []sendGridAddress{{Email: "alice@example.com", Name: "Alice"}, {Email: "bob@example.com"}}, p.To)
+	assert.Equal(t, []sendGridAddress{{Email: "carol@example.com"}}, p.CC)
+	assert.Equal(t, []sendGridAddress{{Email: "dave@example.com"}}, p.BCC)
+	require.Len(t, received.Content, 2)
+	assert.Equal(t, "text/plain", received.Content[0].Type)
+
+	// Test: Rate limits and outages can be tried again, bad requests can't
+	status = http.StatusTooManyRequests
+	err = transport.Send(context.Background(), testEmail())
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, mail.ErrRejected)
+
+	status = http.StatusBadRequest
+	err = transport.Send(context.Background(), testEmail())
+	assert.ErrorIs(t, err, mail.ErrRejected)
+}
@@ -0,0 +1,138 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SMTPStartTLS upgrades the connection when the server offers it
+	SMTPStartTLS = "starttls"
+	// SMTPImplicitTLS connects over TLS from the start, usually on port 465
+	SMTPImplicitTLS = "tls"
+	// SMTPNoTLS never encrypts, for local sinks like Mailpit
+	SMTPNoTLS = "none"
+)
+
+// SMTPConfig points at a mail server. The defaults match the Mailpit sink
+// in docker-compose.yml.
+type SMTPConfig struct {
+	Host     string `env:"SMTP_HOST" envDefault:"localhost"`
+	Port     int    `env:"SMTP_PORT" envDefault:"1025"`
+	Username string `env:"SMTP_USERNAME"`
+	Password string `env:"SMTP_PASSWORD"`
+	TLS      string `env:"SMTP_TLS" envDefault:"starttls"`
+}
+
+// SMTP delivers to a mail server, one connection per email
+type SMTP struct {
+	cfg SMTPConfig
+}
+
+var _ Transport = (*SMTP)(nil)
+
+func NewSMTP(cfg SMTPConfig) (*SMTP, error) {
+	if cfg.Host == "" {
+		return nil, errors.New("SMTP host is required")
+	}
+	switch cfg.TLS {
+	case "":
+		cfg.TLS = SMTPStartTLS
+	case SMTPStartTLS, SMTPImplicitTLS, SMTPNoTLS:
+	default:
+		return nil, errors.Errorf("SMTP TLS must be %q, %q or %q, not %q", SMTPStartTLS, SMTPImplicitTLS, SMTPNoTLS, cfg.TLS)
+	}
+	return &SMTP{
+		cfg: cfg,
+	}, nil
+}
+
+func (s *SMTP) Send(ctx context.Context, email authboss.Email) error {
+	message, err := buildMessage(email, time.Now())
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+	tlsConfig := &tls.Config{ServerName: s.cfg.Host}
+	var conn net.Conn
+	if s.cfg.TLS == SMTPImplicitTLS {
+		conn, err = (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return contextErr(ctx, err)
+	}
+	// Closing the connection unblocks whatever the client is waiting on
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return s.classify(ctx, err)
+	}
+	defer client.Close()
+
+	if s.cfg.TLS == SMTPStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(tlsConfig); err != nil {
+				return s.classify(ctx, err)
+			}
+		}
+	}
+	// PlainAuth refuses to send the password unencrypted, except to localhost
+	if s.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+			return s.classify(ctx, err)
+		}
+	}
+
+	if err := client.Mail(email.From); err != nil {
+		return s.classify(ctx, err)
+	}
+	for _, rcpt := range recipients(email) {
+		if err := client.Rcpt(rcpt); err != nil {
+			return s.classify(ctx, err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return s.classify(ctx, err)
+	}
+	if _, err := w.Write(message); err != nil {
+		return s.classify(ctx, err)
+	}
+	if err := w.Close(); err != nil {
+		return s.classify(ctx, err)
+	}
+	// The server has accepted the email, so failing to say goodbye mustn't
+	// get it sent again
+	if err := client.Quit(); err != nil {
+		slog.Warn("email sent but SMTP QUIT failed", "to", email.To, "err", err)
+	}
+	return nil
+}
+
+// classify marks permanent (5xx) replies as rejected, so they aren't tried
+// again
+func (s *SMTP) classify(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	var reply *textproto.Error
+	if errors.As(err, &reply) && reply.Code >= 500 {
+		return errors.Wrapf(ErrRejected, "SMTP server replied %d %s", reply.Code, reply.Msg)
+	}
+	return contextErr(ctx, err)
+}
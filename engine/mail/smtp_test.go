@@ -0,0 +1,190 @@
+package mail_test
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/mail"
+)
+
+// smtpSink is a local SMTP server that keeps what it's sent, speaking just
+// enough of the protocol for net/smtp
+type smtpSink struct {
+	listener net.Listener
+	// reject is refused as a recipient with a permanent error
+	reject string
+	// stall greets clients and then never answers
+	stall bool
+	// hangUp drops the connection instead of answering QUIT
+	hangUp bool
+
+	mu       sync.Mutex
+	from     string
+	rcpts    []string
+	messages [][]byte
+}
+
+// startSMTPSink listens on a free local port
+func startSMTPSink(t *testing.T, s *smtpSink) *smtpSink {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s.listener = listener
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpSink) config() mail.SMTPConfig {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return mail.SMTPConfig{
+		Host: "127.0.0.1",
+		Port: addr.Port,
+		TLS:  mail.SMTPNoTLS,
+	}
+}
+
+func (s *smtpSink) serve(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	reply := func(line string) { tp.PrintfLine("%s", line) }
+
+	reply("220 sink ESMTP")
+	if s.stall {
+		for {
+			if _, err := tp.ReadLine(); err != nil {
+				return
+			}
+		}
+	}
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			reply("250 sink")
+		case "MAIL":
+			s.mu.Lock()
+			s.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			s.mu.Unlock()
+			reply("250 OK")
+		case "RCPT":
+			rcpt := strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>")
+			if rcpt == s.reject {
+				reply("550 no such user")
+				continue
+			}
+			s.mu.Lock()
+			s.rcpts = append(s.rcpts, rcpt)
+			s.mu.Unlock()
+			reply("250 OK")
+		case "DATA":
+			reply("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, data)
+			s.mu.Unlock()
+			reply("250 OK")
+		case "QUIT":
+			if s.hangUp {
+				return
+			}
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestSMTP_Send(t *testing.T) {
+	sink := startSMTPSink(t, &smtpSink{})
+	transport, err := mail.NewSMTP(sink.config())
+	require.NoError(t, err)
+
+	require.NoError(t, transport.Send(context.Background(), testEmail()))
+
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	assert.Equal(t, "noreply@fixit.local", sink.from)
+	// Test: Everyone gets it, Bcc included
+	assert.Equal(t, []string{"alice@example.com", "bob@example.com", "carol@example.com", "dave@example.com"}, sink.rcpts)
+	require.Len(t, sink.messages, 1)
+
+	msg := readMessage(t, sink.messages[0])
+	assert.Equal(t, "Confirm your account", msg.Header.Get("Subject"))
+	assert.Empty(t, msg.Header.Get("Bcc"))
+	assert.Equal(t, testEmail().TextBody, messageBodies(t, msg)["text/plain"])
+}
+
+func TestSMTP_SendRejected(t *testing.T) {
+	sink := startSMTPSink(t, &smtpSink{reject: "bob@example.com"})
+	transport, err := mail.NewSMTP(sink.config())
+	require.NoError(t, err)
+
+	err = transport.Send(context.Background(), testEmail())
+	assert.ErrorIs(t, err, mail.ErrRejected)
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	assert.Empty(t, sink.messages)
+}
+
+func TestSMTP_SendQuitFails(t *testing.T) {
+	sink := startSMTPSink(t, &smtpSink{hangUp: true})
+	transport, err := mail.NewSMTP(sink.config())
+	require.NoError(t, err)
+
+	// Test: Once the server accepts the email it's sent, so isn't tried again
+	require.NoError(t, transport.Send(context.Background(), testEmail()))
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	assert.Len(t, sink.messages, 1)
+}
+
+func TestSMTP_SendCancelled(t *testing.T) {
+	sink := startSMTPSink(t, &smtpSink{stall: true})
+	transport, err := mail.NewSMTP(sink.config())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = transport.Send(ctx, testEmail())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSMTP_SendUnreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	transport, err := mail.NewSMTP(mail.SMTPConfig{Host: "127.0.0.1", Port: port, TLS: mail.SMTPNoTLS})
+	require.NoError(t, err)
+
+	// Test: Connection failures can be tried again
+	err = transport.Send(context.Background(), testEmail())
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, mail.ErrRejected)
+}
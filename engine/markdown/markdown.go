@@ -0,0 +1,162 @@
+// Package markdown renders what users write in Markdown as HTML that's safe
+// to put on a page
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/pkg/errors"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// TagURL is where a #tag links to
+type TagURL func(tag string) string
+
+var tagURLKey = parser.NewContextKey()
+
+var md = goldmark.New(
+	// GFM links bare URLs, and adds tables, strikethrough and task lists
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithParserOptions(
+		parser.WithASTTransformers(util.Prioritized(referenceTransformer{}, 500)),
+	),
+	goldmark.WithRendererOptions(
+		// people expect a new line to stay one
+		html.WithHardWraps(),
+		renderer.WithNodeRenderers(util.Prioritized(referenceRenderer{}, 500)),
+	),
+)
+
+// policy allows the HTML Markdown makes, which already leaves out raw HTML,
+// in case a document gets something dangerous past it
+var policy = func() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^(tag|mention)$`)).OnElements("a", "span")
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}()
+
+// Render turns Markdown into HTML. URLs become links, #tags link to where
+// tagURL says and @mentions are marked out.
+func Render(source string, tagURL TagURL) (string, error) {
+	pc := parser.NewContext()
+	pc.Set(tagURLKey, tagURL)
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(source), &buf, parser.WithContext(pc)); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return string(policy.SanitizeBytes(buf.Bytes())), nil
+}
+
+var kindReference = ast.NewNodeKind("Reference")
+
+// reference is a #tag or an @mention
+type reference struct {
+	ast.BaseInline
+	Sigil byte
+	Name  string
+	// URL is where a tag links to
+	URL string
+}
+
+func (n *reference) Kind() ast.NodeKind {
+	return kindReference
+}
+
+func (n *reference) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Name": n.Name}, nil)
+}
+
+// referencePattern matches a #tag or an @mention. Tags start with a letter so "issue #3" isn't one.
+var referencePattern = regexp.MustCompile(`(?:#[A-Za-z]|@[A-Za-z0-9])[A-Za-z0-9_-]*`)
+
+// referenceTransformer finds tags and mentions in the text Markdown leaves,
+// so code, links and entities like &#39; are passed over
+type referenceTransformer struct{}
+
+func (referenceTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	tagURL, _ := pc.Get(tagURLKey).(TagURL)
+
+	var texts []*ast.Text
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n := n.(type) {
+		case *ast.CodeSpan, *ast.Link, *ast.AutoLink, *ast.Image, *ast.RawHTML:
+			return ast.WalkSkipChildren, nil
+		case *ast.Text:
+			// Delimiters that weren't emphasis, like the _ in @alice_b, split text
+			for next, ok := n.NextSibling().(*ast.Text); ok && n.Merge(next, source); next, ok = n.NextSibling().(*ast.Text) {
+				n.Parent().RemoveChild(n.Parent(), next)
+			}
+			texts = append(texts, n)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, t := range texts {
+		offset := t.Segment.Start
+		for _, match := range referencePattern.FindAllIndex(source[t.Segment.Start:t.Segment.Stop], -1) {
+			start, stop := offset+match[0], offset+match[1]
+			// Skips email addresses, URL fragments, escapes and entities
+			if start > 0 && isAfterName(source[start-1]) {
+				continue
+			}
+
+			ref := &reference{Sigil: source[start], Name: string(source[start+1 : stop])}
+			if ref.Sigil == '#' && tagURL != nil {
+				ref.URL = tagURL(ref.Name)
+			}
+			parent := t.Parent()
+			parent.InsertBefore(parent, t, ast.NewTextSegment(t.Segment.WithStop(start)))
+			parent.InsertBefore(parent, t, ref)
+			t.Segment = t.Segment.WithStart(stop)
+		}
+	}
+}
+
+func isAfterName(c byte) bool {
+	return c == '-' || c == '&' || c == '/' || c == '\\' ||
+		('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+type referenceRenderer struct{}
+
+func (referenceRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindReference, renderReference)
+}
+
+func renderReference(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	ref := node.(*reference)
+	switch {
+	case ref.Sigil == '@':
+		w.WriteString(`<span class="mention">@`)
+		w.Write(util.EscapeHTML([]byte(ref.Name)))
+		w.WriteString(`</span>`)
+	case ref.URL != "":
+		w.WriteString(`<a class="tag" href="`)
+		w.Write(util.EscapeHTML(util.URLEscape([]byte(ref.URL), true)))
+		w.WriteString(`">#`)
+		w.Write(util.EscapeHTML([]byte(ref.Name)))
+		w.WriteString(`</a>`)
+	default:
+		w.WriteString("#")
+		w.Write(util.EscapeHTML([]byte(ref.Name)))
+	}
+	return ast.WalkSkipChildren, nil
+}
@@ -0,0 +1,63 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/markdown"
+)
+
+func TestRender(t *testing.T) {
+	tagURL := func(tag string) string {
+		return "/c/swindon?q=" + tag
+	}
+	render := func(source string) string {
+		html, err := markdown.Render(source, tagURL)
+		require.NoError(t, err)
+		return html
+	}
+
+	t.Run("Markdown", func(t *testing.T) {
+		assert.Equal(t, "<p><strong>Deep</strong> pothole<br>\nnear the <em>school</em></p>\n",
+			render("**Deep** pothole\nnear the *school*"))
+		assert.Contains(t, render("- one\n- two"), "<li>one</li>")
+	})
+
+	t.Run("Bare URLs become links", func(t *testing.T) {
+		assert.Equal(t,
+			`<p>See <a href="https://council.example/report" rel="nofollow noopener" target="_blank">https://council.example/report</a></p>`+"\n",
+			render("See https://council.example/report"))
+	})
+
+	t.Run("Tags and mentions", func(t *testing.T) {
+		assert.Equal(t,
+			`<p>Ask <span class="mention">@alice_b</span> about <a class="tag" href="/c/swindon?q=potholes" rel="nofollow">#potholes</a></p>`+"\n",
+			render("Ask @alice_b about #potholes"))
+
+		// Test: Things that only look like them are left alone
+		assert.Equal(t, `<p>Mail <a href="mailto:bob@example.com" rel="nofollow">bob@example.com</a> about issue #3</p>`+"\n",
+			render("Mail bob@example.com about issue #3"))
+		assert.Equal(t, "<p>Don&#39;t</p>\n", render("Don&#39;t"))
+		assert.Equal(t, "<p><code>#not-a-tag</code></p>\n", render("`#not-a-tag`"))
+		assert.Contains(t, render("# Heading"), "<h1>Heading</h1>")
+		assert.Equal(t, `<p><em><span class="mention">@bob</span></em></p>`+"\n", render("_@bob_"))
+
+		// Test: Without a URL tags are plain text
+		html, err := markdown.Render("#potholes", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "<p>#potholes</p>\n", html)
+	})
+
+	t.Run("Dangerous HTML is removed", func(t *testing.T) {
+		html := render(`<script>alert(1)</script><img src=x onerror=alert(1)> [click](javascript:alert(1))`)
+		assert.NotContains(t, html, "<script")
+		assert.NotContains(t, html, "onerror")
+		assert.NotContains(t, html, "javascript:")
+
+		html = render(`<span class="tag" style="color:red">styled</span>`)
+		assert.NotContains(t, html, "style=")
+		assert.NotContains(t, html, "<span")
+	})
+}
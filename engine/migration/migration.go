@@ -0,0 +1,317 @@
+// Package migration applies the versioned SQL migrations in
+// engine/ent/migrate/migrations, recording what's been applied in the
+// schema_migration table.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"path"
+	"slices"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent/migrate/migrations"
+)
+
+// lockID is the Postgres advisory lock held while migrating, so several
+// machines starting at once take turns
+const lockID = 0x66697869
+
+var (
+	ErrSchemaBehind    = errors.New("database schema is behind, run `fixit migrate up`")
+	ErrNoDownMigration = errors.New("migration can't be reverted")
+	ErrUnknownVersion  = errors.New("no migration with that version")
+)
+
+// Migration is one versioned migration file
+type Migration struct {
+	Version     string
+	Description string
+	// AppliedAt is nil until the migration is applied
+	AppliedAt *time.Time
+
+	file migrate.File
+	down migrate.File
+}
+
+// Reversible is whether the migration has a down file
+func (m Migration) Reversible() bool {
+	return m.down != nil
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []*Migration
+}
+
+// New loads the embedded migrations, checking them against atlas.sum
+func New(db *sql.DB) (*Migrator, error) {
+	return newMigrator(db, migrations.FS)
+}
+
+func newMigrator(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	dir := &migrate.MemDir{}
+	downs := map[string]migrate.File{}
+
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		if path.Dir(name) == "down" {
+			downs[path.Base(name)] = migrate.NewLocalFile(path.Base(name), data)
+			return nil
+		}
+		return dir.WriteFile(name, data)
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := migrate.Validate(dir); err != nil {
+		return nil, errors.Wrap(err, "migrations don't match atlas.sum")
+	}
+
+	files, err := dir.Files()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	m := &Migrator{db: db}
+	for _, f := range files {
+		m.migrations = append(m.migrations, &Migration{
+			Version:     f.Version(),
+			Description: f.Desc(),
+			file:        f,
+			down:        downs[f.Name()],
+		})
+	}
+	return m, nil
+}
+
+// Status returns every migration, oldest first, with when it was applied
+func (m *Migrator) Status(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]Migration, len(m.migrations))
+	for i, migration := range m.migrations {
+		status[i] = *migration
+		if at, ok := applied[migration.Version]; ok {
+			status[i].AppliedAt = &at
+		}
+	}
+	return status, nil
+}
+
+// Check returns ErrSchemaBehind when there are migrations to apply. A
+// database ahead of this binary is fine, as during a rolling deploy.
+func (m *Migrator) Check(ctx context.Context) error {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pending []string
+	for _, migration := range status {
+		if migration.AppliedAt == nil {
+			pending = append(pending, migration.Version)
+		}
+	}
+	if len(pending) > 0 {
+		return errors.Wrapf(ErrSchemaBehind, "%d pending from %s", len(pending), pending[0])
+	}
+	return nil
+}
+
+// Up applies up to n pending migrations, or all of them when n is zero,
+// each in its own transaction. It returns those applied.
+func (m *Migrator) Up(ctx context.Context, n int) ([]Migration, error) {
+	var done []Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if n > 0 && len(done) == n {
+				break
+			}
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			err := m.apply(ctx, conn, migration.file, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx,
+					`INSERT INTO "schema_migration" ("version", "description") VALUES ($1, $2)`,
+					migration.Version, migration.Description)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			done = append(done, *migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down reverts the n most recently applied migrations, newest first. It
+// returns those reverted.
+func (m *Migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	var done []Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range slices.Backward(m.migrations) {
+			if len(done) == n {
+				break
+			}
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+			if migration.down == nil {
+				return errors.Wrapf(ErrNoDownMigration, "%s", migration.file.Name())
+			}
+			err := m.apply(ctx, conn, migration.down, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx,
+					`DELETE FROM "schema_migration" WHERE "version" = $1`,
+					migration.Version)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			done = append(done, *migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Baseline marks every migration up to and including version as applied
+// without running it, for databases created before migrations were
+// versioned
+func (m *Migrator) Baseline(ctx context.Context, version string) error {
+	i := slices.IndexFunc(m.migrations, func(migration *Migration) bool {
+		return migration.Version == version
+	})
+	if i < 0 {
+		return errors.Wrapf(ErrUnknownVersion, "%s", version)
+	}
+
+	return m.locked(ctx, func(conn *sql.Conn) error {
+		for _, migration := range m.migrations[:i+1] {
+			_, err := conn.ExecContext(ctx,
+				`INSERT INTO "schema_migration" ("version", "description") VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+				migration.Version, migration.Description)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+		return nil
+	})
+}
+
+// apply runs a migration file and records it in one transaction
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, f migrate.File, record func(*sql.Tx) error) error {
+	stmts, err := f.Stmts()
+	if err != nil {
+		return errors.Wrapf(err, "%s", f.Name())
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return errors.Wrapf(err, "%s: %s", f.Name(), stmt)
+		}
+	}
+	if err := record(tx); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(tx.Commit())
+}
+
+// locked runs fn on one connection while holding the migration lock
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockID); err != nil {
+		return errors.WithStack(err)
+	}
+	defer conn.ExecContext(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1)`, lockID)
+
+	if err := m.createTable(ctx, conn); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+type execQueryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func (m *Migrator) createTable(ctx context.Context, db execQueryer) error {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS "schema_migration" (
+  "version" character varying NOT NULL,
+  "description" character varying NOT NULL,
+  "applied_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("version")
+)`)
+	return errors.WithStack(err)
+}
+
+// applied returns when each applied migration was applied, by version.
+// Nothing has been applied when there's no schema_migration table yet.
+func (m *Migrator) applied(ctx context.Context, db execQueryer) (map[string]time.Time, error) {
+	applied := map[string]time.Time{}
+
+	var exists bool
+	err := db.QueryRowContext(ctx, `SELECT to_regclass('"schema_migration"') IS NOT NULL`).Scan(&exists)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !exists {
+		return applied, nil
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT "version", "applied_at" FROM "schema_migration"`)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version string
+		var at time.Time
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		applied[version] = at
+	}
+	return applied, errors.WithStack(rows.Err())
+}
@@ -0,0 +1,161 @@
+package migration_test
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"strings"
+	"testing"
+
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/config"
+	"fixit/engine/ent"
+	"fixit/engine/factory"
+	"fixit/engine/migration"
+)
+
+func TestNew(t *testing.T) {
+	// Test: The embedded migrations match atlas.sum
+	_, err := migration.New(nil)
+	require.NoError(t, err)
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	db := setupEmptySchema(t)
+	migrator, err := migration.New(db)
+	require.NoError(t, err)
+
+	// Test: An empty database is behind
+	status, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	for _, m := range status {
+		assert.Nil(t, m.AppliedAt)
+		assert.True(t, m.Reversible(), "%s_%s has no down file", m.Version, m.Description)
+	}
+	assert.ErrorIs(t, migrator.Check(ctx), migration.ErrSchemaBehind)
+
+	// Test: Up applies n migrations, then the rest
+	applied, err := migrator.Up(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	assert.Equal(t, status[0].Version, applied[0].Version)
+	assert.ErrorIs(t, migrator.Check(ctx), migration.ErrSchemaBehind)
+
+	applied, err = migrator.Up(ctx, 0)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(status)-1)
+	require.NoError(t, migrator.Check(ctx))
+
+	// Test: Up again has nothing to do
+	applied, err = migrator.Up(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+
+	// Test: The migrated schema is the one ent expects
+	assertMatchesEntSchema(t, db)
+
+	// Test: Every migration can be reverted and applied again
+	reverted, err := migrator.Down(ctx, len(status))
+	require.NoError(t, err)
+	require.Len(t, reverted, len(status))
+	assert.Equal(t, status[len(status)-1].Version, reverted[0].Version)
+	assert.ErrorIs(t, migrator.Check(ctx), migration.ErrSchemaBehind)
+
+	_, err = migrator.Up(ctx, 0)
+	require.NoError(t, err)
+	require.NoError(t, migrator.Check(ctx))
+}
+
+func TestMigrator_Baseline(t *testing.T) {
+	ctx := context.Background()
+	db := setupEmptySchema(t)
+	migrator, err := migration.New(db)
+	require.NoError(t, err)
+
+	status, err := migrator.Status(ctx)
+	require.NoError(t, err)
+
+	// Test: Baselined migrations are marked applied without running
+	require.NoError(t, migrator.Baseline(ctx, status[0].Version))
+	status, err = migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, status[0].AppliedAt)
+	assert.Nil(t, status[1].AppliedAt)
+
+	var tables int
+	require.NoError(t, db.QueryRowContext(ctx,
+		`SELECT count(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = 'user'`,
+	).Scan(&tables))
+	assert.Zero(t, tables)
+
+	assert.ErrorIs(t, migrator.Baseline(ctx, "19990101000000"), migration.ErrUnknownVersion)
+}
+
+// assertMatchesEntSchema asks ent what it would change to reach its schema.
+// Dropping things ent doesn't know about, like the search index, is fine.
+func assertMatchesEntSchema(t *testing.T, db *sql.DB) {
+	var pending []atlas.Change
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
+	err := client.Schema.Create(context.Background(),
+		schema.WithDiffHook(func(next schema.Differ) schema.Differ {
+			return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+				changes, err := next.Diff(current, desired)
+				if err != nil {
+					return nil, err
+				}
+				for _, change := range changes {
+					if modify, ok := change.(*atlas.ModifyTable); ok {
+						for _, c := range modify.Changes {
+							switch c.(type) {
+							case *atlas.DropIndex, *atlas.DropColumn, *atlas.DropForeignKey:
+							default:
+								pending = append(pending, c)
+							}
+						}
+						continue
+					}
+					pending = append(pending, change)
+				}
+				// Apply nothing, this is only a comparison
+				return nil, nil
+			})
+		}),
+	)
+	require.NoError(t, err)
+	assert.Empty(t, pending, "migrations are out of step with the ent schema")
+}
+
+// setupEmptySchema returns a connection to a new, empty Postgres schema,
+// dropped when the test ends
+func setupEmptySchema(t *testing.T) *sql.DB {
+	ctx := context.Background()
+	name := strings.ToLower(strings.ReplaceAll(factory.Placeholder("migration_test_*"), "-", "_"))
+
+	admin, err := sql.Open("postgres", config.GetTestDBURL())
+	require.NoError(t, err)
+	t.Cleanup(func() { admin.Close() })
+	_, err = admin.ExecContext(ctx, `CREATE SCHEMA "`+name+`"`)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = admin.ExecContext(ctx, `DROP SCHEMA "`+name+`" CASCADE`)
+	})
+
+	u, err := url.Parse(config.GetTestDBURL())
+	require.NoError(t, err)
+	q := u.Query()
+	q.Set("search_path", name)
+	u.RawQuery = q.Encode()
+
+	db, err := sql.Open("postgres", u.String())
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
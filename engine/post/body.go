@@ -0,0 +1,61 @@
+package post
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	"fixit/engine/ent/post"
+	"fixit/engine/markdown"
+)
+
+// RenderBody turns a post body written in Markdown into safe HTML, with
+// #tags searching the post's community
+func RenderBody(body, communitySlug string) (string, error) {
+	return markdown.Render(body, func(tag string) string {
+		return "/c/" + communitySlug + "?" + url.Values{"q": {tag}}.Encode()
+	})
+}
+
+// setBody changes a post's body, emptying the HTML rendered from the old one
+func setBody(m *ent.PostMutation, body string) {
+	m.SetBody(body)
+	m.ClearBodyHTML()
+}
+
+// BodyHTML is the post's body rendered as HTML. It's rendered the first time
+// it's needed after the body changes, then kept with the post.
+func (r *Repository) BodyHTML(ctx context.Context, p *ent.Post) (string, error) {
+	if p.BodyHTML != "" || p.Body == "" {
+		return p.BodyHTML, nil
+	}
+
+	comm := p.Edges.Community
+	if comm == nil {
+		var err error
+		comm, err = p.QueryCommunity().Only(ctx)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+	}
+
+	html, err := RenderBody(p.Body, comm.Name)
+	if err != nil {
+		return "", err
+	}
+
+	// Only kept while the body is the one rendered, and keeping it isn't an
+	// update to the post
+	err = r.client.Post.Update().
+		Where(post.ID(p.ID), post.Body(p.Body)).
+		SetBodyHTML(html).
+		SetUpdatedAt(p.UpdatedAt).
+		Exec(ctx)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	p.BodyHTML = html
+	return html, nil
+}
@@ -2,26 +2,70 @@ package post
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aarondl/authboss/v3"
 	"github.com/gofrs/uuid/v5"
 	"github.com/pkg/errors"
 
+	"fixit/engine/community"
 	"fixit/engine/ent"
+	"fixit/engine/ent/attachment"
+	entFile "fixit/engine/ent/file"
 	"fixit/engine/ent/post"
+	"fixit/engine/ent/postrevision"
+	"fixit/engine/ent/statuschange"
+	"fixit/engine/ent/vote"
+	"fixit/engine/file"
+	"fixit/engine/geo"
+	"fixit/engine/internal/entx"
+	"fixit/engine/storage"
 )
 
+var (
+	ErrSolutionNotFound  = errors.New("solution not found")
+	ErrNotSolution       = errors.New("only solution posts can be accepted")
+	ErrNoParentIssue     = errors.New("solution has no parent issue")
+	ErrNotIssueAuthor    = errors.New("only the issue author or a moderator can make this change")
+	ErrNotIssue          = errors.New("only issue posts have a status")
+	ErrReasonRequired    = errors.New("a reason is required to close or reopen an issue")
+	ErrInvalidTransition = errors.New("invalid status transition")
+	ErrStatusConflict    = errors.New("issue status was changed by someone else")
+	ErrLocationNotIssue  = errors.New("only issues can have a location")
+	ErrOutsideCommunity  = errors.New("location is outside the community's area")
+	ErrTooManyPhotos     = errors.New("a post can have at most 10 photos")
+	ErrCaptionTooLong    = errors.New("photo captions can be at most 500 characters")
+	ErrUnconfirmed       = errors.New("confirm your email address before posting")
+	ErrPostNotFound      = errors.New("post not found")
+	ErrNotAuthor         = errors.New("only the author or a moderator can change this post")
+	ErrDeleted           = errors.New("the post has been deleted")
+	ErrEditConflict      = errors.New("post was edited by someone else")
+)
+
+// MaxPhotos is how many photos can be attached to one post
+const MaxPhotos = 10
+
+// Photo is an image uploaded with a post
+type Photo struct {
+	Upload  file.Upload
+	Caption string
+}
+
 type Repository struct {
-	client *ent.Client
-	ab     *authboss.Authboss
+	client      *ent.Client
+	blobs       storage.BlobStore
+	communities *community.Repository
+	ab          *authboss.Authboss
 }
 
 type Auth interface {
 }
 
-func New(client *ent.Client) *Repository {
+func New(client *ent.Client, blobs storage.BlobStore) *Repository {
 	return &Repository{
-		client: client,
+		client:      client,
+		blobs:       blobs,
+		communities: community.NewRepository(client),
 	}
 }
 
@@ -34,9 +78,16 @@ type PostCreateFields struct {
 	UserEmail   string     `json:"userEmail,omitempty"`
 	CommunityID uuid.UUID  `json:"communityID,omitempty"`
 	ImageURL    string     `json:"imageURL,omitempty"`
+	Point       *geo.Point `json:"point,omitempty"`
+	Address     string     `json:"address,omitempty"`
+	Photos      []Photo    `json:"-"`
 }
 
 func (r *Repository) Create(ctx context.Context, fields PostCreateFields, user *ent.User) (*ent.Post, error) {
+	if !user.Confirmed {
+		return nil, errors.WithStack(ErrUnconfirmed)
+	}
+
 	// Create fields with user ID for validation
 	validationFields := PostCreateFields{
 		Title:       fields.Title,
@@ -47,6 +98,13 @@ func (r *Repository) Create(ctx context.Context, fields PostCreateFields, user *
 		UserEmail:   fields.UserEmail,
 		CommunityID: fields.CommunityID,
 		ImageURL:    fields.ImageURL,
+		Point:       fields.Point,
+		Address:     fields.Address,
+		Photos:      fields.Photos,
+	}
+
+	if err := r.validateParent(ctx, validationFields); err != nil {
+		return nil, err
 	}
 
 	// Validate role-specific requirements
@@ -54,14 +112,39 @@ func (r *Repository) Create(ctx context.Context, fields PostCreateFields, user *
 		return nil, errors.WithStack(err)
 	}
 
-	builder := r.client.Post.Create().
+	if err := r.validateLocation(ctx, validationFields); err != nil {
+		return nil, err
+	}
+
+	if err := validatePhotos(validationFields.Photos); err != nil {
+		return nil, err
+	}
+
+	var created *ent.Post
+	err := entx.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		created, err = createPost(ctx, tx.Client(), r.blobs, fields, user)
+		if err != nil {
+			return err
+		}
+		return advanceIssue(ctx, tx.Client(), created, user.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func createPost(ctx context.Context, client *ent.Client, blobs storage.BlobStore, fields PostCreateFields, user *ent.User) (*ent.Post, error) {
+	builder := client.Post.Create().
 		SetTitle(fields.Title).
 		SetRole(fields.Role).
 		SetUserID(user.ID).
 		SetCommunityID(fields.CommunityID)
 
 	if fields.Body != "" {
-		builder.SetBody(fields.Body)
+		setBody(builder.Mutation(), fields.Body)
 	}
 
 	if fields.Tags != nil {
@@ -76,19 +159,68 @@ func (r *Repository) Create(ctx context.Context, fields PostCreateFields, user *
 		builder.SetImageURL(fields.ImageURL)
 	}
 
+	if fields.Point != nil {
+		builder.SetGeography(fields.Point.WKT())
+	}
+
+	if fields.Address != "" {
+		builder.SetAddress(fields.Address)
+	}
+
 	post, err := builder.Save(ctx)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
+	for i, photo := range fields.Photos {
+		f, err := file.Create(ctx, client, blobs, photo.Upload)
+		if err != nil {
+			return nil, err
+		}
+		attachment := client.Attachment.Create().
+			SetPostID(post.ID).
+			SetFileID(f.ID).
+			SetPosition(i)
+		if caption := strings.TrimSpace(photo.Caption); caption != "" {
+			attachment.SetCaption(caption)
+		}
+		if _, err := attachment.Save(ctx); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
 	return post, nil
 }
 
+func validatePhotos(photos []Photo) error {
+	if len(photos) > MaxPhotos {
+		return errors.WithStack(ErrTooManyPhotos)
+	}
+	for _, photo := range photos {
+		if len(strings.TrimSpace(photo.Caption)) > 500 {
+			return errors.WithStack(ErrCaptionTooLong)
+		}
+		if _, _, err := file.Validate(photo.Upload); err != nil {
+			return errors.Wrapf(err, "%s", photo.Upload.Filename)
+		}
+	}
+	return nil
+}
+
 func (r *Repository) GetByIDWithReplies(ctx context.Context, id uuid.UUID) (*ent.Post, error) {
 	post, err := r.client.Post.Query().
 		Where(post.ID(id)).
 		WithUser().
 		WithCommunity().
+		WithRevisions(func(q *ent.PostRevisionQuery) {
+			q.Select(postrevision.FieldID)
+		}).
+		WithAttachments(func(q *ent.AttachmentQuery) {
+			q.Order(ent.Asc(attachment.FieldPosition)).
+				WithFile(func(q *ent.FileQuery) {
+					q.WithDerived()
+				})
+		}).
 		WithReplies(func(q *ent.PostQuery) {
 			q.WithUser().
 				WithReplies(func(nestedQ *ent.PostQuery) {
@@ -104,6 +236,140 @@ func (r *Repository) GetByIDWithReplies(ctx context.Context, id uuid.UUID) (*ent
 	return post, nil
 }
 
+// AcceptSolution marks a solution as the accepted fix for the issue it
+// replies to. Accepting a different solution later moves the mark to it.
+func (r *Repository) AcceptSolution(ctx context.Context, solutionID uuid.UUID, user *ent.User) (*ent.Post, error) {
+	solution, err := r.client.Post.Query().
+		Where(post.ID(solutionID)).
+		WithParent(func(q *ent.PostQuery) {
+			q.WithUser().WithCommunity()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrSolutionNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	if solution.Role != post.RoleSolution {
+		return nil, ErrNotSolution
+	}
+
+	if solution.DeletedAt != nil {
+		return nil, ErrDeleted
+	}
+
+	issue := solution.Edges.Parent
+	if issue == nil {
+		return nil, ErrNoParentIssue
+	}
+
+	if issue.DeletedAt != nil {
+		return nil, ErrDeleted
+	}
+
+	allowed, err := r.MayChange(ctx, issue, user)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, ErrNotIssueAuthor
+	}
+
+	issue, err = issue.Update().
+		SetAcceptedSolutionID(solution.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return issue, nil
+}
+
+// Close marks an issue as dealt with, recording why
+func (r *Repository) Close(ctx context.Context, issueID uuid.UUID, user *ent.User, reason string) (*ent.Post, error) {
+	return r.changeStatusManually(ctx, issueID, user, post.StatusClosed, reason)
+}
+
+// Reopen puts a closed issue back to open, recording why
+func (r *Repository) Reopen(ctx context.Context, issueID uuid.UUID, user *ent.User, reason string) (*ent.Post, error) {
+	return r.changeStatusManually(ctx, issueID, user, post.StatusOpen, reason)
+}
+
+// StatusHistory lists every transition of an issue, oldest first
+func (r *Repository) StatusHistory(ctx context.Context, issueID uuid.UUID) ([]*ent.StatusChange, error) {
+	changes, err := r.client.StatusChange.Query().
+		Where(statuschange.HasPostWith(post.ID(issueID))).
+		WithUser().
+		Order(ent.Asc(statuschange.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return changes, nil
+}
+
+func (r *Repository) changeStatusManually(ctx context.Context, issueID uuid.UUID, user *ent.User, to post.Status, reason string) (*ent.Post, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	issue, err := r.client.Post.Query().
+		Where(post.ID(issueID)).
+		WithUser().
+		WithCommunity().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("issue not found")
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	allowed, err := r.MayChange(ctx, issue, user)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, ErrNotIssueAuthor
+	}
+
+	if issue.DeletedAt != nil {
+		return nil, ErrDeleted
+	}
+
+	var updated *ent.Post
+	err = entx.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		updated, err = transition(ctx, tx.Client(), issue, to, user.ID, reason)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// validateParent refuses replies to deleted posts, whose threads can still
+// be read but not added to
+func (r *Repository) validateParent(ctx context.Context, fields PostCreateFields) error {
+	if fields.ReplyTo == nil {
+		return nil
+	}
+	deleted, err := r.client.Post.Query().
+		Where(post.ID(*fields.ReplyTo), post.DeletedAtNotNil()).
+		Exist(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if deleted {
+		return errors.WithStack(ErrDeleted)
+	}
+	return nil
+}
+
 func (r *Repository) validateRole(ctx context.Context, fields PostCreateFields, userID uuid.UUID) error {
 	switch fields.Role {
 	case post.RoleSolution:
@@ -115,6 +381,35 @@ func (r *Repository) validateRole(ctx context.Context, fields PostCreateFields,
 	}
 }
 
+// validateLocation checks an issue's point is valid and, when its community
+// has a location, that the point is inside the community's area
+func (r *Repository) validateLocation(ctx context.Context, fields PostCreateFields) error {
+	if fields.Point == nil && fields.Address == "" {
+		return nil
+	}
+	// Role defaults to issue when unset
+	if fields.Role != "" && fields.Role != post.RoleIssue {
+		return errors.WithStack(ErrLocationNotIssue)
+	}
+	if fields.Point == nil {
+		return nil
+	}
+
+	p, err := geo.NewPoint(fields.Point.Lat, fields.Point.Lng)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	comm, err := r.client.Community.Get(ctx, fields.CommunityID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if area, ok := community.Area(comm); ok && !area.Contains(p) {
+		return errors.WithStack(ErrOutsideCommunity)
+	}
+	return nil
+}
+
 func (r *Repository) validateSolutionRole(ctx context.Context, fields PostCreateFields, userID uuid.UUID) error {
 	if fields.ReplyTo == nil {
 		return errors.New("solution posts must reply to an existing post")
@@ -173,3 +468,185 @@ func (r *Repository) validateVerificationRole(ctx context.Context, fields PostCr
 
 	return nil
 }
+
+// transitions lists the statuses an issue may move to from each status
+var transitions = map[post.Status][]post.Status{
+	post.StatusOpen:     {post.StatusProposed, post.StatusClosed},
+	post.StatusProposed: {post.StatusVerified, post.StatusClosed},
+	post.StatusVerified: {post.StatusClosed},
+	post.StatusClosed:   {post.StatusOpen},
+}
+
+func validateTransition(issue *ent.Post, to post.Status) error {
+	if issue.Role != post.RoleIssue {
+		return ErrNotIssue
+	}
+
+	for _, allowed := range transitions[issue.Status] {
+		if allowed == to {
+			return nil
+		}
+	}
+
+	return errors.Wrapf(ErrInvalidTransition, "cannot move from %s to %s", issue.Status, to)
+}
+
+// transition moves an issue to a new status and records it in the audit
+// history. The update is conditional on the status we validated against,
+// so concurrent transitions can't both apply.
+func transition(ctx context.Context, client *ent.Client, issue *ent.Post, to post.Status, userID uuid.UUID, reason string) (*ent.Post, error) {
+	if err := validateTransition(issue, to); err != nil {
+		return nil, err
+	}
+
+	updated, err := client.Post.Update().
+		Where(post.ID(issue.ID), post.StatusEQ(issue.Status)).
+		SetStatus(to).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if updated == 0 {
+		return nil, ErrStatusConflict
+	}
+
+	change := client.StatusChange.Create().
+		SetPostID(issue.ID).
+		SetUserID(userID).
+		SetFromStatus(statuschange.FromStatus(issue.Status)).
+		SetToStatus(statuschange.ToStatus(to))
+	if reason != "" {
+		change.SetReason(reason)
+	}
+	if _, err := change.Save(ctx); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	reloaded, err := client.Post.Get(ctx, issue.ID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return reloaded, nil
+}
+
+// advanceIssue moves the issue a newly created solution or verification
+// belongs to along its lifecycle. Issues that are already further along,
+// or closed, are left as they are.
+func advanceIssue(ctx context.Context, client *ent.Client, created *ent.Post, userID uuid.UUID) error {
+	if created.ReplyTo == nil {
+		return nil
+	}
+
+	var issueID uuid.UUID
+	var to post.Status
+	switch created.Role {
+	case post.RoleSolution:
+		issueID = *created.ReplyTo
+		to = post.StatusProposed
+	case post.RoleVerification:
+		solution, err := client.Post.Get(ctx, *created.ReplyTo)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if solution.ReplyTo == nil {
+			return nil
+		}
+		issueID = *solution.ReplyTo
+		to = post.StatusVerified
+	default:
+		return nil
+	}
+
+	issue, err := client.Post.Get(ctx, issueID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if validateTransition(issue, to) != nil {
+		return nil
+	}
+
+	_, err = transition(ctx, client, issue, to, userID, "")
+	return err
+}
+
+// Delete removes a post along with its replies, votes, status history,
+// revisions and photos, where SoftDelete leaves a tombstone. Blobs are
+// deleted once the rows are gone, so a failure there leaves unreferenced
+// blobs behind rather than files without data.
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	var fileIDs []uuid.UUID
+	err := entx.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		ids, err := subtree(ctx, tx.Client(), id)
+		if err != nil {
+			return err
+		}
+
+		originals, err := tx.Attachment.Query().
+			Where(attachment.PostIDIn(ids...)).
+			QueryFile().
+			IDs(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		derived, err := tx.File.Query().
+			Where(entFile.OriginalIDIn(originals...)).
+			IDs(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		fileIDs = append(originals, derived...)
+
+		if _, err := tx.Vote.Delete().Where(vote.HasPostWith(post.IDIn(ids...))).Exec(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := tx.StatusChange.Delete().Where(statuschange.HasPostWith(post.IDIn(ids...))).Exec(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := tx.PostRevision.Delete().Where(postrevision.HasPostWith(post.IDIn(ids...))).Exec(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := tx.Attachment.Delete().Where(attachment.PostIDIn(ids...)).Exec(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		// derived files go with their original
+		if _, err := tx.File.Delete().Where(entFile.IDIn(originals...)).Exec(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := tx.Post.Delete().Where(post.IDIn(ids...)).Exec(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, fileID := range fileIDs {
+		if err := r.blobs.Delete(ctx, fileID.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// subtree returns a post's ID and those of every reply below it
+func subtree(ctx context.Context, client *ent.Client, id uuid.UUID) ([]uuid.UUID, error) {
+	id, err := client.Post.Query().Where(post.ID(id)).OnlyID(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	ids := []uuid.UUID{id}
+	for level := ids; len(level) > 0; {
+		next, err := client.Post.Query().
+			Where(post.ReplyToIn(level...)).
+			IDs(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		ids = append(ids, next...)
+		level = next
+	}
+	return ids, nil
+}
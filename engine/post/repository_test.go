@@ -1,26 +1,36 @@
 package post_test
 
 import (
+	"bytes"
 	"context"
+	"image"
+	"image/png"
 	"testing"
 
 	"github.com/gofrs/uuid/v5"
 	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"fixit/engine/config"
+	engineCommunity "fixit/engine/community"
+	"fixit/engine/diff"
 	"fixit/engine/ent"
-	"fixit/engine/ent/enttest"
+	"fixit/engine/ent/membership"
 	entPost "fixit/engine/ent/post"
+	"fixit/engine/ent/statuschange"
+	entVote "fixit/engine/ent/vote"
 	"fixit/engine/factory"
+	"fixit/engine/file"
+	"fixit/engine/geo"
 	"fixit/engine/post"
+	"fixit/engine/storage"
 )
 
 func TestRepository_CreatePostGraph(t *testing.T) {
 	client := setupTestDB(t)
 	ctx := context.Background()
-	repo := post.New(client)
+	repo := post.New(client, storage.NewPostgres(client))
 
 	// Create test users
 	issueAuthor := factory.User(t, client, "issue-author-*")
@@ -93,15 +103,24 @@ func TestRepository_CreatePostGraph(t *testing.T) {
 func TestRepository_ValidationErrors(t *testing.T) {
 	client := setupTestDB(t)
 	ctx := context.Background()
-	repo := post.New(client)
+	repo := post.New(client, storage.NewPostgres(client))
 
 	// Create test users and community
 	user := factory.User(t, client, "test-user-*")
 	otherUser := factory.User(t, client, "other-test-user-*")
 	community := factory.Community(t, client, "test-community-*")
 
-	// Test: Solution post without reply_to
+	// Test: Users who haven't confirmed their email can't post
+	unconfirmed := factory.User(t, client, "unconfirmed-user-*").Update().SetConfirmed(false).SaveX(ctx)
 	_, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Unconfirmed Issue",
+		Role:        entPost.RoleIssue,
+		CommunityID: community.ID,
+	}, unconfirmed)
+	assert.ErrorIs(t, err, post.ErrUnconfirmed)
+
+	// Test: Solution post without reply_to
+	_, err = repo.Create(ctx, post.PostCreateFields{
 		Title:       "Invalid Solution",
 		Role:        entPost.RoleSolution,
 		CommunityID: community.ID,
@@ -190,7 +209,7 @@ func TestRepository_ValidationErrors(t *testing.T) {
 func TestRepository_SelfReplyValidation(t *testing.T) {
 	client := setupTestDB(t)
 	ctx := context.Background()
-	repo := post.New(client)
+	repo := post.New(client, storage.NewPostgres(client))
 
 	// Create test users and community
 	user := factory.User(t, client, "self-reply-user-*")
@@ -250,7 +269,7 @@ func TestRepository_SelfReplyValidation(t *testing.T) {
 func TestRepository_GetByIDWithReplies(t *testing.T) {
 	client := setupTestDB(t)
 	ctx := context.Background()
-	repo := post.New(client)
+	repo := post.New(client, storage.NewPostgres(client))
 
 	// Create test data
 	user := factory.User(t, client, "get-by-id-user-*")
@@ -294,15 +313,561 @@ func TestRepository_GetByIDWithReplies(t *testing.T) {
 	assert.NotNil(t, retrievedPost.Edges.Replies[0].Edges.User)
 }
 
-func setupTestDB(t *testing.T) *ent.Client {
-	// Use enttest with migrations - it handles cleaning up for us
-	// The WithMigrateOptions ensures we get a fresh schema each time
-	opts := []enttest.Option{
-		enttest.WithOptions(ent.Log(t.Log)),
-		enttest.WithMigrateOptions(),
+func TestRepository_AcceptSolution(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := post.New(client, storage.NewPostgres(client))
+
+	issueAuthor := factory.User(t, client, "accept-author-*")
+	solver := factory.User(t, client, "accept-solver-*")
+	community := factory.Community(t, client, "accept-community-*")
+
+	issuePost, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Issue needing a fix",
+		Role:        entPost.RoleIssue,
+		CommunityID: community.ID,
+	}, issueAuthor)
+	require.NoError(t, err)
+
+	firstSolution, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "First solution",
+		Role:        entPost.RoleSolution,
+		ReplyTo:     &issuePost.ID,
+		CommunityID: community.ID,
+	}, solver)
+	require.NoError(t, err)
+
+	secondSolution, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Second solution",
+		Role:        entPost.RoleSolution,
+		ReplyTo:     &issuePost.ID,
+		CommunityID: community.ID,
+	}, solver)
+	require.NoError(t, err)
+
+	// Test: Only the issue author can accept
+	_, err = repo.AcceptSolution(ctx, firstSolution.ID, solver)
+	assert.ErrorIs(t, err, post.ErrNotIssueAuthor)
+
+	// Test: Only solutions can be accepted
+	_, err = repo.AcceptSolution(ctx, issuePost.ID, issueAuthor)
+	assert.ErrorIs(t, err, post.ErrNotSolution)
+	_, err = repo.AcceptSolution(ctx, uuid.Must(uuid.NewV7()), issueAuthor)
+	assert.ErrorIs(t, err, post.ErrSolutionNotFound)
+
+	accepted, err := repo.AcceptSolution(ctx, firstSolution.ID, issueAuthor)
+	require.NoError(t, err)
+	require.NotNil(t, accepted.AcceptedSolutionID)
+	assert.Equal(t, firstSolution.ID, *accepted.AcceptedSolutionID)
+
+	// Test: Accepting another solution moves the mark
+	accepted, err = repo.AcceptSolution(ctx, secondSolution.ID, issueAuthor)
+	require.NoError(t, err)
+	require.NotNil(t, accepted.AcceptedSolutionID)
+	assert.Equal(t, secondSolution.ID, *accepted.AcceptedSolutionID)
+}
+
+func TestRepository_IssueLifecycle(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := post.New(client, storage.NewPostgres(client))
+
+	issueAuthor := factory.User(t, client, "lifecycle-author-*")
+	solver := factory.User(t, client, "lifecycle-solver-*")
+	community := factory.Community(t, client, "lifecycle-community-*")
+
+	issuePost, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Broken streetlight",
+		Role:        entPost.RoleIssue,
+		CommunityID: community.ID,
+	}, issueAuthor)
+	require.NoError(t, err)
+	assert.Equal(t, entPost.StatusOpen, issuePost.Status)
+
+	// Test: A solution proposes a fix
+	solutionPost, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Reported to the council",
+		Role:        entPost.RoleSolution,
+		ReplyTo:     &issuePost.ID,
+		CommunityID: community.ID,
+	}, solver)
+	require.NoError(t, err)
+	assert.Equal(t, entPost.StatusProposed, reload(t, client, issuePost.ID).Status)
+
+	// Test: A verification of that solution verifies the issue
+	_, err = repo.Create(ctx, post.PostCreateFields{
+		Title:       "Light is back on",
+		Role:        entPost.RoleVerification,
+		ReplyTo:     &solutionPost.ID,
+		CommunityID: community.ID,
+	}, issueAuthor)
+	require.NoError(t, err)
+	assert.Equal(t, entPost.StatusVerified, reload(t, client, issuePost.ID).Status)
+
+	// Test: Closing needs the author and a reason
+	_, err = repo.Close(ctx, issuePost.ID, solver, "done")
+	assert.ErrorIs(t, err, post.ErrNotIssueAuthor)
+	_, err = repo.Close(ctx, issuePost.ID, issueAuthor, "   ")
+	assert.ErrorIs(t, err, post.ErrReasonRequired)
+
+	closed, err := repo.Close(ctx, issuePost.ID, issueAuthor, "Fixed for good")
+	require.NoError(t, err)
+	assert.Equal(t, entPost.StatusClosed, closed.Status)
+
+	// Test: Only closed issues can be reopened, and only once
+	_, err = repo.Close(ctx, issuePost.ID, issueAuthor, "Again")
+	assert.ErrorIs(t, err, post.ErrInvalidTransition)
+
+	reopened, err := repo.Reopen(ctx, issuePost.ID, issueAuthor, "It's flickering again")
+	require.NoError(t, err)
+	assert.Equal(t, entPost.StatusOpen, reopened.Status)
+
+	_, err = repo.Reopen(ctx, issuePost.ID, issueAuthor, "Still broken")
+	assert.ErrorIs(t, err, post.ErrInvalidTransition)
+
+	// Test: Every transition is recorded in order
+	history, err := repo.StatusHistory(ctx, issuePost.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+
+	var steps [][2]entPost.Status
+	for _, change := range history {
+		steps = append(steps, [2]entPost.Status{
+			entPost.Status(change.FromStatus),
+			entPost.Status(change.ToStatus),
+		})
+	}
+	assert.Equal(t, [][2]entPost.Status{
+		{entPost.StatusOpen, entPost.StatusProposed},
+		{entPost.StatusProposed, entPost.StatusVerified},
+		{entPost.StatusVerified, entPost.StatusClosed},
+		{entPost.StatusClosed, entPost.StatusOpen},
+	}, steps)
+	assert.Equal(t, solver.ID, history[0].Edges.User.ID)
+	assert.Equal(t, "Fixed for good", history[2].Reason)
+	assert.Equal(t, "It's flickering again", history[3].Reason)
+
+	// Test: Only issues have a lifecycle
+	_, err = repo.Close(ctx, solutionPost.ID, solver, "Not an issue")
+	assert.ErrorIs(t, err, post.ErrNotIssue)
+}
+
+func TestRepository_IssueLocation(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := post.New(client, storage.NewPostgres(client))
+
+	user := factory.User(t, client, "location-user-*")
+	anywhere := factory.Community(t, client, "location-anywhere-*")
+	swindon, err := client.Community.Create().
+		SetName(factory.Placeholder("location-swindon-*")).
+		SetTitle("Swindon").
+		SetGeography("POINT(-1.7797 51.5558)").
+		Save(ctx)
+	require.NoError(t, err)
+
+	oldTown := geo.Point{Lat: 51.5502, Lng: -1.7781}
+	bristol := geo.Point{Lat: 51.4545, Lng: -2.5879}
+
+	// Test: Issues store their point and address
+	issue, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Pothole on Wood Street",
+		Role:        entPost.RoleIssue,
+		CommunityID: swindon.ID,
+		Point:       &oldTown,
+		Address:     "Wood Street, Old Town",
+	}, user)
+	require.NoError(t, err)
+	assert.Equal(t, "POINT(-1.7781 51.5502)", issue.Geography)
+	assert.Equal(t, "Wood Street, Old Town", issue.Address)
+
+	// Test: Points outside the community's area are rejected
+	_, err = repo.Create(ctx, post.PostCreateFields{
+		Title:       "Pothole in Bristol",
+		Role:        entPost.RoleIssue,
+		CommunityID: swindon.ID,
+		Point:       &bristol,
+	}, user)
+	assert.ErrorIs(t, err, post.ErrOutsideCommunity)
+
+	// Test: Communities without a location accept any point
+	_, err = repo.Create(ctx, post.PostCreateFields{
+		Title:       "Pothole in Bristol",
+		Role:        entPost.RoleIssue,
+		CommunityID: anywhere.ID,
+		Point:       &bristol,
+	}, user)
+	require.NoError(t, err)
+
+	// Test: Out of range coordinates are rejected
+	_, err = repo.Create(ctx, post.PostCreateFields{
+		Title:       "Pothole off the map",
+		Role:        entPost.RoleIssue,
+		CommunityID: anywhere.ID,
+		Point:       &geo.Point{Lat: 95, Lng: 0},
+	}, user)
+	assert.ErrorIs(t, err, geo.ErrInvalidCoordinates)
+
+	// Test: Only issues can have a location
+	_, err = repo.Create(ctx, post.PostCreateFields{
+		Title:       "Filled it in myself",
+		Role:        entPost.RoleSolution,
+		ReplyTo:     &issue.ID,
+		CommunityID: swindon.ID,
+		Point:       &oldTown,
+	}, user)
+	assert.ErrorIs(t, err, post.ErrLocationNotIssue)
+}
+
+func TestRepository_CreateWithPhotos(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := post.New(client, storage.NewPostgres(client))
+
+	user := factory.User(t, client, "photos-user-*")
+	community := factory.Community(t, client, "photos-community-*")
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 2, 2))))
+	photo := file.Upload{Filename: "pothole.png", Data: buf.Bytes()}
+
+	// Test: Photos are stored in order with their captions
+	created, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Pothole with photos",
+		Role:        entPost.RoleIssue,
+		CommunityID: community.ID,
+		Photos: []post.Photo{
+			{Upload: photo, Caption: "From the pavement"},
+			{Upload: photo},
+		},
+	}, user)
+	require.NoError(t, err)
+
+	loaded, err := repo.GetByIDWithReplies(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, loaded.Edges.Attachments, 2)
+	assert.Equal(t, "From the pavement", loaded.Edges.Attachments[0].Caption)
+	assert.Equal(t, 1, loaded.Edges.Attachments[1].Position)
+
+	stored, err := client.File.Get(ctx, loaded.Edges.Attachments[0].FileID)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", stored.ContentType)
+	data, err := storage.NewPostgres(client).Get(ctx, stored.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, buf.Bytes(), data)
+
+	// Test: Anything but an image is rejected and nothing is created
+	before, err := client.Post.Query().Count(ctx)
+	require.NoError(t, err)
+	_, err = repo.Create(ctx, post.PostCreateFields{
+		Title:       "Pothole with a bad photo",
+		Role:        entPost.RoleIssue,
+		CommunityID: community.ID,
+		Photos:      []post.Photo{{Upload: photo}, {Upload: file.Upload{Filename: "x.png", Data: []byte("not an image")}}},
+	}, user)
+	assert.ErrorIs(t, err, file.ErrUnsupportedType)
+	after, err := client.Post.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+
+	// Test: There's a limit on photos per post
+	_, err = repo.Create(ctx, post.PostCreateFields{
+		Title:       "Pothole with too many photos",
+		Role:        entPost.RoleIssue,
+		CommunityID: community.ID,
+		Photos:      make([]post.Photo, post.MaxPhotos+1),
+	}, user)
+	assert.ErrorIs(t, err, post.ErrTooManyPhotos)
+}
+
+func TestRepository_Delete(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	blobs := storage.NewPostgres(client)
+	repo := post.New(client, blobs)
+
+	user := factory.User(t, client, "delete-user-*")
+	community := factory.Community(t, client, "delete-community-*")
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 2, 2))))
+
+	issue, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Pothole to be deleted",
+		Role:        entPost.RoleIssue,
+		CommunityID: community.ID,
+		Photos:      []post.Photo{{Upload: file.Upload{Filename: "pothole.png", Data: buf.Bytes()}}},
+	}, user)
+	require.NoError(t, err)
+	solution, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Filled it in",
+		Role:        entPost.RoleSolution,
+		CommunityID: community.ID,
+		ReplyTo:     &issue.ID,
+	}, user)
+	require.NoError(t, err)
+	chat, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Thanks for doing this",
+		Role:        entPost.RoleChat,
+		CommunityID: community.ID,
+		ReplyTo:     &solution.ID,
+	}, user)
+	require.NoError(t, err)
+	_, err = client.Vote.Create().
+		SetKind(entVote.KindInteresting).
+		SetValue(1).
+		SetPost(solution).
+		SetUser(user).
+		Save(ctx)
+	require.NoError(t, err)
+	other, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Another pothole",
+		Role:        entPost.RoleIssue,
+		CommunityID: community.ID,
+	}, user)
+	require.NoError(t, err)
+
+	loaded, err := repo.GetByIDWithReplies(ctx, issue.ID)
+	require.NoError(t, err)
+	fileID := loaded.Edges.Attachments[0].FileID
+
+	// Test: The post goes with its replies, votes, status history and photos
+	require.NoError(t, repo.Delete(ctx, issue.ID))
+	for _, id := range []uuid.UUID{issue.ID, solution.ID, chat.ID} {
+		_, err := client.Post.Get(ctx, id)
+		assert.True(t, ent.IsNotFound(err))
 	}
+	remaining, err := client.StatusChange.Query().Where(statuschange.HasPostWith(entPost.ID(issue.ID))).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, remaining)
+	_, err = client.File.Get(ctx, fileID)
+	assert.True(t, ent.IsNotFound(err))
+	_, err = blobs.Get(ctx, fileID.String())
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+
+	// Test: Other posts are untouched
+	assert.Equal(t, other.ID, reload(t, client, other.ID).ID)
+
+	// Test: Deleting an unknown post is not found
+	err = repo.Delete(ctx, uuid.Must(uuid.NewV7()))
+	assert.True(t, ent.IsNotFound(errors.Cause(err)))
+}
+
+func reload(t *testing.T, client *ent.Client, id uuid.UUID) *ent.Post {
+	p, err := client.Post.Get(context.Background(), id)
+	require.NoError(t, err)
+	return p
+}
 
-	client := enttest.Open(t, "postgres", config.GetTestDBURL(), opts...)
+func TestRepository_BodyHTML(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := post.New(client, storage.NewPostgres(client))
+
+	user := factory.User(t, client, "markdown-user-*")
+	community := factory.Community(t, client, "markdown-community-*")
+
+	issue, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Pothole by the school",
+		Body:        "**Deep** pothole near #school<script>alert(1)</script>",
+		Role:        entPost.RoleIssue,
+		CommunityID: community.ID,
+	}, user)
+	require.NoError(t, err)
+	issue, err = client.Post.Get(ctx, issue.ID)
+	require.NoError(t, err)
+	assert.Empty(t, issue.BodyHTML, "rendered when first shown")
+
+	html, err := repo.BodyHTML(ctx, issue)
+	require.NoError(t, err)
+	assert.Contains(t, html, "<strong>Deep</strong>")
+	assert.Contains(t, html, `<a class="tag" href="/c/`+community.Name+`?q=school"`)
+	assert.NotContains(t, html, "<script")
 
-	return client
+	// Test: The HTML is kept without counting as an update
+	stored, err := client.Post.Get(ctx, issue.ID)
+	require.NoError(t, err)
+	assert.Equal(t, html, stored.BodyHTML)
+	assert.True(t, issue.UpdatedAt.Equal(stored.UpdatedAt))
+
+	// Test: HTML rendered from an old body isn't kept
+	stale := *stored
+	stale.Body = "An old body"
+	stale.BodyHTML = ""
+	html, err = repo.BodyHTML(ctx, &stale)
+	require.NoError(t, err)
+	assert.Contains(t, html, "An old body")
+	stored, err = client.Post.Get(ctx, issue.ID)
+	require.NoError(t, err)
+	assert.Contains(t, stored.BodyHTML, "<strong>Deep</strong>")
+}
+
+func TestRepository_EditAndSoftDelete(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := post.New(client, storage.NewPostgres(client))
+
+	author := factory.User(t, client, "edit-author-*")
+	other := factory.User(t, client, "edit-other-*")
+	community := factory.Community(t, client, "edit-community-*")
+
+	issue, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Pothole on Mill Lane",
+		Body:        "It's deep.\nNear the bus stop.",
+		Role:        entPost.RoleIssue,
+		CommunityID: community.ID,
+	}, author)
+	require.NoError(t, err)
+	solution, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Reported it to the council",
+		Role:        entPost.RoleSolution,
+		CommunityID: community.ID,
+		ReplyTo:     &issue.ID,
+	}, other)
+	require.NoError(t, err)
+
+	// Test: Only the author can edit
+	_, err = repo.Edit(ctx, issue.ID, other, post.EditFields{Title: "Not my pothole"})
+	assert.ErrorIs(t, err, post.ErrNotAuthor)
+
+	// Test: Edits keep what the post said before
+	edited, err := repo.Edit(ctx, issue.ID, author, post.EditFields{
+		Title: "Pothole on Mill Lane",
+		Body:  "It's very deep.\nNear the bus stop.",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "It's very deep.\nNear the bus stop.", edited.Body)
+	_, err = repo.Edit(ctx, issue.ID, author, post.EditFields{
+		Title: "Deep pothole on Mill Lane",
+		Body:  edited.Body,
+	})
+	require.NoError(t, err)
+
+	issue, err = repo.Get(ctx, issue.ID)
+	require.NoError(t, err)
+	edits, err := repo.History(ctx, issue)
+	require.NoError(t, err)
+	require.Len(t, edits, 2)
+	assert.Equal(t, "Pothole on Mill Lane", edits[0].TitleBefore)
+	assert.Equal(t, "Deep pothole on Mill Lane", edits[0].TitleAfter)
+	assert.False(t, diff.Changed(edits[0].Body))
+	assert.Equal(t, author.ID, edits[1].User.ID)
+	assert.Equal(t, []diff.Line{
+		{Op: diff.Delete, Text: "It's deep."},
+		{Op: diff.Insert, Text: "It's very deep."},
+		{Op: diff.Equal, Text: "Near the bus stop."},
+	}, edits[1].Body)
+
+	// Test: Saving without changes doesn't add to the history
+	_, err = repo.Edit(ctx, issue.ID, author, post.EditFields{Title: issue.Title, Body: issue.Body})
+	require.NoError(t, err)
+	edits, err = repo.History(ctx, issue)
+	require.NoError(t, err)
+	assert.Len(t, edits, 2)
+
+	// Test: Only the author can delete
+	err = repo.SoftDelete(ctx, issue.ID, other)
+	assert.ErrorIs(t, err, post.ErrNotAuthor)
+
+	// Test: Deleted posts are kept with their replies, but can't be changed
+	// or replied to
+	require.NoError(t, repo.SoftDelete(ctx, issue.ID, author))
+	loaded, err := repo.GetByIDWithReplies(ctx, issue.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, loaded.DeletedAt)
+	require.Len(t, loaded.Edges.Replies, 1)
+	assert.Equal(t, solution.ID, loaded.Edges.Replies[0].ID)
+
+	_, err = repo.Edit(ctx, issue.ID, author, post.EditFields{Title: "Back again"})
+	assert.ErrorIs(t, err, post.ErrDeleted)
+	assert.ErrorIs(t, repo.SoftDelete(ctx, issue.ID, author), post.ErrDeleted)
+	assert.ErrorIs(t, repo.SoftDelete(ctx, uuid.Must(uuid.NewV7()), author), post.ErrPostNotFound)
+	_, err = repo.Create(ctx, post.PostCreateFields{
+		Title:       "Another solution",
+		Role:        entPost.RoleSolution,
+		CommunityID: community.ID,
+		ReplyTo:     &issue.ID,
+	}, other)
+	assert.ErrorIs(t, err, post.ErrDeleted)
+	_, err = repo.AcceptSolution(ctx, solution.ID, author)
+	assert.ErrorIs(t, err, post.ErrDeleted)
+
+	// Test: Unknown posts aren't found
+	_, err = repo.Edit(ctx, uuid.Must(uuid.NewV7()), author, post.EditFields{Title: "Nowhere"})
+	assert.ErrorIs(t, err, post.ErrPostNotFound)
+}
+
+func TestRepository_Moderators(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := post.New(client, storage.NewPostgres(client))
+
+	author := factory.User(t, client, "moderated-author-*")
+	solver := factory.User(t, client, "moderated-solver-*")
+	moderator := factory.User(t, client, "moderator-*")
+	member := factory.User(t, client, "moderated-member-*")
+	comm := factory.Community(t, client, "moderated-community-*")
+
+	communities := engineCommunity.NewRepository(client)
+	require.NoError(t, communities.Assign(ctx, comm.ID, moderator, membership.RoleModerator))
+	require.NoError(t, communities.Join(ctx, comm.ID, member))
+
+	issue, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Fly-tipping by the canal",
+		Role:        entPost.RoleIssue,
+		CommunityID: comm.ID,
+	}, author)
+	require.NoError(t, err)
+	solution, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Council collected it",
+		Role:        entPost.RoleSolution,
+		ReplyTo:     &issue.ID,
+		CommunityID: comm.ID,
+	}, solver)
+	require.NoError(t, err)
+
+	// Test: Members are no different to anyone else
+	may, err := repo.MayChange(ctx, issue, member)
+	require.NoError(t, err)
+	assert.False(t, may)
+	_, err = repo.AcceptSolution(ctx, solution.ID, member)
+	assert.ErrorIs(t, err, post.ErrNotIssueAuthor)
+
+	// Test: Moderators can change anyone's posts in their community
+	may, err = repo.MayChange(ctx, issue, moderator)
+	require.NoError(t, err)
+	assert.True(t, may)
+
+	edited, err := repo.Edit(ctx, issue.ID, moderator, post.EditFields{Title: "Fly-tipping by the canal bridge"})
+	require.NoError(t, err)
+	assert.Equal(t, "Fly-tipping by the canal bridge", edited.Title)
+	edits, err := repo.History(ctx, edited)
+	require.NoError(t, err)
+	require.Len(t, edits, 1)
+	assert.Equal(t, moderator.ID, edits[0].User.ID)
+
+	accepted, err := repo.AcceptSolution(ctx, solution.ID, moderator)
+	require.NoError(t, err)
+	assert.Equal(t, solution.ID, *accepted.AcceptedSolutionID)
+	closed, err := repo.Close(ctx, issue.ID, moderator, "Cleared")
+	require.NoError(t, err)
+	assert.Equal(t, entPost.StatusClosed, closed.Status)
+
+	require.NoError(t, repo.SoftDelete(ctx, solution.ID, moderator))
+	assert.NotNil(t, reload(t, client, solution.ID).DeletedAt)
+
+	// Test: Moderating one community doesn't reach into others
+	elsewhere := factory.Community(t, client, "unmoderated-community-*")
+	other, err := repo.Create(ctx, post.PostCreateFields{
+		Title:       "Pothole somewhere else",
+		Role:        entPost.RoleIssue,
+		CommunityID: elsewhere.ID,
+	}, author)
+	require.NoError(t, err)
+	err = repo.SoftDelete(ctx, other.ID, moderator)
+	assert.ErrorIs(t, err, post.ErrNotAuthor)
+}
+
+func setupTestDB(t *testing.T) *ent.Client {
+	return factory.DB(t, ent.Log(t.Log))
 }
@@ -0,0 +1,203 @@
+package post
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/pkg/errors"
+
+	"fixit/engine/community"
+	"fixit/engine/diff"
+	"fixit/engine/ent"
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/postrevision"
+	"fixit/engine/ent/predicate"
+	"fixit/engine/internal/entx"
+)
+
+// EditFields are the parts of a post that can be changed after it's posted
+type EditFields struct {
+	Title string
+	Body  string
+}
+
+// Edit is one change to a post: who made it, when, and how its title and
+// body changed
+type Edit struct {
+	User        *ent.User
+	CreatedAt   time.Time
+	TitleBefore string
+	TitleAfter  string
+	Body        []diff.Line
+}
+
+// MayChange is whether the user can edit or delete the post, and for an
+// issue accept its solutions and close or reopen it: its author or a
+// moderator of its community
+func (r *Repository) MayChange(ctx context.Context, p *ent.Post, user *ent.User) (bool, error) {
+	if user == nil {
+		return false, nil
+	}
+	if p.Edges.User != nil && p.Edges.User.ID == user.ID {
+		return true, nil
+	}
+	return r.moderates(ctx, p, user)
+}
+
+// moderates is whether the user moderates the post's community
+func (r *Repository) moderates(ctx context.Context, p *ent.Post, user *ent.User) (bool, error) {
+	comm := p.Edges.Community
+	if comm == nil {
+		var err error
+		comm, err = p.QueryCommunity().Only(ctx)
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+	}
+	return r.communities.Can(ctx, comm.ID, user, community.PermissionModerate)
+}
+
+// Get loads a post with its author and community
+func (r *Repository) Get(ctx context.Context, id uuid.UUID) (*ent.Post, error) {
+	p, err := r.client.Post.Query().
+		Where(post.ID(id)).
+		WithUser().
+		WithCommunity().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithStack(ErrPostNotFound)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return p, nil
+}
+
+// Edit changes a post's title and body, keeping what they were as a
+// revision. Nothing is kept when neither changes.
+func (r *Repository) Edit(ctx context.Context, id uuid.UUID, user *ent.User, fields EditFields) (*ent.Post, error) {
+	p, err := r.changeable(ctx, id, user)
+	if err != nil {
+		return nil, err
+	}
+	if p.Title == fields.Title && p.Body == fields.Body {
+		return p, nil
+	}
+
+	var edited *ent.Post
+	err = entx.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		// Conditional on what was read, so two edits can't both keep the
+		// same revision
+		update := tx.Post.Update().
+			Where(post.ID(p.ID), post.Title(p.Title), bodyIs(p.Body), post.DeletedAtIsNil()).
+			SetTitle(fields.Title)
+		setBody(update.Mutation(), fields.Body)
+		updated, err := update.Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if updated == 0 {
+			return errors.WithStack(ErrEditConflict)
+		}
+
+		revision := tx.PostRevision.Create().
+			SetPostID(p.ID).
+			SetUserID(user.ID).
+			SetTitle(p.Title)
+		if p.Body != "" {
+			revision.SetBody(p.Body)
+		}
+		if _, err := revision.Save(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+
+		edited, err = tx.Post.Get(ctx, p.ID)
+		return errors.WithStack(err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return edited, nil
+}
+
+// SoftDelete deletes a post but keeps it as a tombstone, so the replies
+// below it stay in their thread
+func (r *Repository) SoftDelete(ctx context.Context, id uuid.UUID, user *ent.User) error {
+	p, err := r.changeable(ctx, id, user)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := r.client.Post.Update().
+		Where(post.ID(p.ID), post.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// Someone else deleted it first
+	if deleted == 0 {
+		return errors.WithStack(ErrDeleted)
+	}
+	return nil
+}
+
+// History lists the edits made to a post, newest first
+func (r *Repository) History(ctx context.Context, p *ent.Post) ([]Edit, error) {
+	revisions, err := r.client.PostRevision.Query().
+		Where(postrevision.HasPostWith(post.ID(p.ID))).
+		WithUser().
+		Order(ent.Asc(postrevision.FieldCreatedAt), ent.Asc(postrevision.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Each revision was replaced by the next, and the last by the post as
+	// it is now
+	edits := make([]Edit, len(revisions))
+	for i, revision := range revisions {
+		title, body := p.Title, p.Body
+		if i+1 < len(revisions) {
+			title, body = revisions[i+1].Title, revisions[i+1].Body
+		}
+		edits[i] = Edit{
+			User:        revision.Edges.User,
+			CreatedAt:   revision.CreatedAt,
+			TitleBefore: revision.Title,
+			TitleAfter:  title,
+			Body:        diff.Lines(revision.Body, body),
+		}
+	}
+	slices.Reverse(edits)
+	return edits, nil
+}
+
+// changeable loads a post the user may edit or delete
+func (r *Repository) changeable(ctx context.Context, id uuid.UUID, user *ent.User) (*ent.Post, error) {
+	p, err := r.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.DeletedAt != nil {
+		return nil, errors.WithStack(ErrDeleted)
+	}
+	allowed, err := r.MayChange(ctx, p, user)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.WithStack(ErrNotAuthor)
+	}
+	return p, nil
+}
+
+// bodyIs matches posts with the body, which is null when empty
+func bodyIs(body string) predicate.Post {
+	if body == "" {
+		return post.Or(post.BodyIsNil(), post.BodyEQ(""))
+	}
+	return post.Body(body)
+}
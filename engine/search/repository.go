@@ -0,0 +1,275 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gofrs/uuid/v5"
+	"github.com/pkg/errors"
+
+	engineCommunity "fixit/engine/community"
+	"fixit/engine/ent"
+	"fixit/engine/ent/community"
+	"fixit/engine/ent/post"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+
+	// IndexName is the GIN index over Document, created by migration
+	IndexName = "post_search"
+
+	// Document is the text search vector of a post. Titles rank above
+	// bodies, which rank above tags. It must match the expression IndexName
+	// was created with for Postgres to use the index.
+	Document = `setweight(to_tsvector('english', coalesce("title", '')), 'A') || ` +
+		`setweight(to_tsvector('english', coalesce("body", '')), 'B') || ` +
+		`setweight(jsonb_to_tsvector('english', coalesce("tags", '[]'::jsonb), '["string"]'), 'C')`
+
+	// Match delimiters passed to ts_headline. They're private use
+	// characters so they can't clash with post text.
+	startMatch = "\uE000"
+	stopMatch  = "\uE001"
+
+	rankColumn    = "search_rank"
+	titleColumn   = "search_title"
+	snippetColumn = "search_snippet"
+)
+
+var ErrEmptyQuery = errors.New("search query is empty")
+
+// Filter narrows a search. Nil fields match everything.
+type Filter struct {
+	Query       string
+	CommunityID *uuid.UUID
+	Role        *post.Role
+	// Solved matches issues that are, or aren't, solved as
+	// community.Solved decides
+	Solved *bool
+	// Page is 1-based
+	Page  int
+	Limit int
+}
+
+// Fragment is a run of text that either matched the query or didn't
+type Fragment struct {
+	Text  string
+	Match bool
+}
+
+// Highlight is text split into fragments so matches can be marked up
+type Highlight []Fragment
+
+type Result struct {
+	*ent.Post
+	Rank    float64
+	Title   Highlight
+	Snippet Highlight
+	// Solved is for issues, as the Solved filter decides
+	Solved bool
+}
+
+type Results struct {
+	Items   []Result
+	Page    int
+	HasMore bool
+}
+
+type Repository struct {
+	client *ent.Client
+}
+
+func New(client *ent.Client) *Repository {
+	return &Repository{
+		client: client,
+	}
+}
+
+// Search finds posts matching a web-search style query, e.g.
+// `pothole -"main street"`, best matches first
+func (r *Repository) Search(ctx context.Context, filter Filter) (*Results, error) {
+	q := strings.TrimSpace(filter.Query)
+	if q == "" {
+		return nil, ErrEmptyQuery
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limit = min(limit, MaxPageSize)
+
+	page := max(filter.Page, 1)
+
+	query := r.client.Post.Query().
+		Where(matches(q), post.DeletedAtIsNil()).
+		Modify(selectHighlights(q)).
+		Order(byRank(q), post.ByCreatedAt(sql.OrderDesc())).
+		Offset((page - 1) * limit).
+		Limit(limit + 1).
+		WithUser().
+		WithCommunity()
+
+	if filter.CommunityID != nil {
+		query = query.Where(post.HasCommunityWith(community.ID(*filter.CommunityID)))
+	}
+	if filter.Role != nil {
+		query = query.Where(post.RoleEQ(*filter.Role))
+	}
+	if filter.Solved != nil {
+		query = query.Where(post.RoleEQ(post.RoleIssue))
+		if *filter.Solved {
+			query = query.Where(engineCommunity.Solved())
+		} else {
+			query = query.Where(post.Not(engineCommunity.Solved()))
+		}
+	}
+
+	posts, err := query.All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	results := &Results{Page: page}
+	if len(posts) > limit {
+		results.HasMore = true
+		posts = posts[:limit]
+	}
+
+	for _, p := range posts {
+		result, err := toResult(p)
+		if err != nil {
+			return nil, err
+		}
+		results.Items = append(results.Items, result)
+	}
+
+	return results, nil
+}
+
+func tsQuery(b *sql.Builder, q string) {
+	b.WriteString("websearch_to_tsquery('english', ").Arg(q).WriteString(")")
+}
+
+func document(s *sql.Selector) string {
+	// Qualify the columns so the expression can't pick up joined tables
+	doc := Document
+	for _, column := range []string{post.FieldTitle, post.FieldBody, post.FieldTags} {
+		doc = strings.ReplaceAll(doc, `"`+column+`"`, s.C(column))
+	}
+	return doc
+}
+
+func matches(q string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("(" + document(s) + ") @@ ")
+			tsQuery(b, q)
+		}))
+	}
+}
+
+func byRank(q string) post.OrderOption {
+	return func(s *sql.Selector) {
+		s.OrderExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_rank(" + document(s) + ", ")
+			tsQuery(b, q)
+			b.WriteString(") DESC")
+		})
+	}
+}
+
+// selectHighlights adds the rank and highlighted title and snippet of each
+// post to the selected columns
+func selectHighlights(q string) func(*sql.Selector) {
+	options := fmt.Sprintf("StartSel=%s, StopSel=%s", startMatch, stopMatch)
+	return func(s *sql.Selector) {
+		s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_rank(" + document(s) + ", ")
+			tsQuery(b, q)
+			b.WriteString(")")
+		}), rankColumn)
+
+		s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_headline('english', " + s.C(post.FieldTitle) + ", ")
+			tsQuery(b, q)
+			b.WriteString(", ").Arg(options + ", HighlightAll=true").WriteString(")")
+		}), titleColumn)
+
+		s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_headline('english', coalesce(" + s.C(post.FieldBody) + ", ''), ")
+			tsQuery(b, q)
+			b.WriteString(", ").Arg(options + ", MaxWords=35, MinWords=15").WriteString(")")
+		}), snippetColumn)
+	}
+}
+
+func toResult(p *ent.Post) (Result, error) {
+	result := Result{Post: p, Solved: p.Role == post.RoleIssue && engineCommunity.IsSolved(p)}
+
+	rank, err := p.Value(rankColumn)
+	if err != nil {
+		return result, errors.WithStack(err)
+	}
+	if v, ok := rank.(float64); ok {
+		result.Rank = v
+	}
+
+	title, err := stringValue(p, titleColumn)
+	if err != nil {
+		return result, err
+	}
+	result.Title = parseHighlight(title)
+
+	snippet, err := stringValue(p, snippetColumn)
+	if err != nil {
+		return result, err
+	}
+	result.Snippet = parseHighlight(snippet)
+
+	return result, nil
+}
+
+func stringValue(p *ent.Post, column string) (string, error) {
+	v, err := p.Value(column)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case nil:
+		return "", nil
+	default:
+		return "", errors.Errorf("unexpected %s %T", column, v)
+	}
+}
+
+// parseHighlight splits ts_headline output on the match delimiters
+func parseHighlight(s string) Highlight {
+	var fragments Highlight
+	for s != "" {
+		start := strings.Index(s, startMatch)
+		if start < 0 {
+			fragments = append(fragments, Fragment{Text: s})
+			break
+		}
+		if start > 0 {
+			fragments = append(fragments, Fragment{Text: s[:start]})
+		}
+		s = s[start+len(startMatch):]
+
+		stop := strings.Index(s, stopMatch)
+		if stop < 0 {
+			stop = len(s)
+		}
+		fragments = append(fragments, Fragment{Text: s[:stop], Match: true})
+		s = strings.TrimPrefix(s[stop:], stopMatch)
+	}
+	return fragments
+}
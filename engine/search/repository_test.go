@@ -0,0 +1,151 @@
+package search_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/ent"
+	entPost "fixit/engine/ent/post"
+	"fixit/engine/factory"
+	"fixit/engine/search"
+)
+
+func TestRepository_Search(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := search.New(client)
+
+	user := factory.User(t, client, "search-user-*")
+	other := factory.User(t, client, "search-other-*")
+	swindon := factory.Community(t, client, "search-swindon-*")
+	bristol := factory.Community(t, client, "search-bristol-*")
+
+	// Use a made up word so other tests' posts can't match
+	inTitle := createPost(t, client, user, swindon, "Zorblat pothole on Main Street", "Deep and getting worse", nil)
+	inBody := createPost(t, client, user, swindon, "Road surface damage", "There's a zorblat near the bus stop", nil)
+	inTags := createPost(t, client, user, bristol, "Cracked pavement", "", []string{"zorblat"})
+
+	solution, err := client.Post.Create().
+		SetTitle("Council filled the zorblat").
+		SetRole(entPost.RoleSolution).
+		SetReplyTo(inTitle.ID).
+		SetUser(other).
+		SetCommunity(swindon).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = inTitle.Update().SetAcceptedSolutionID(solution.ID).Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("Title matches rank above body and tag matches", func(t *testing.T) {
+		role := entPost.RoleIssue
+		results, err := repo.Search(ctx, search.Filter{Query: "zorblat", Role: &role})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{inTitle.ID, inBody.ID, inTags.ID}, ids(results))
+	})
+
+	t.Run("Matches are highlighted", func(t *testing.T) {
+		results, err := repo.Search(ctx, search.Filter{Query: "zorblats", CommunityID: &swindon.ID})
+		require.NoError(t, err)
+		require.NotEmpty(t, results.Items)
+
+		for _, item := range results.Items {
+			if item.ID != inBody.ID {
+				continue
+			}
+			assert.Contains(t, item.Snippet, search.Fragment{Text: "zorblat", Match: true})
+			assert.Equal(t, search.Highlight{{Text: "Road surface damage"}}, item.Title)
+		}
+	})
+
+	t.Run("Filters by community", func(t *testing.T) {
+		results, err := repo.Search(ctx, search.Filter{Query: "zorblat", CommunityID: &bristol.ID})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{inTags.ID}, ids(results))
+	})
+
+	t.Run("Filters by solved state", func(t *testing.T) {
+		solved := true
+		results, err := repo.Search(ctx, search.Filter{Query: "zorblat", Solved: &solved})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{inTitle.ID}, ids(results))
+
+		solved = false
+		results, err = repo.Search(ctx, search.Filter{Query: "zorblat", Solved: &solved})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{inBody.ID, inTags.ID}, ids(results))
+	})
+
+	t.Run("Closed issues count as solved", func(t *testing.T) {
+		closed := createPost(t, client, user, swindon, "Quorvex fly tipping", "", nil)
+		_, err := closed.Update().SetStatus(entPost.StatusClosed).Save(ctx)
+		require.NoError(t, err)
+
+		solved := true
+		results, err := repo.Search(ctx, search.Filter{Query: "quorvex", Solved: &solved})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{closed.ID}, ids(results))
+		assert.True(t, results.Items[0].Solved)
+
+		solved = false
+		results, err = repo.Search(ctx, search.Filter{Query: "quorvex", Solved: &solved})
+		require.NoError(t, err)
+		assert.Empty(t, results.Items)
+	})
+
+	t.Run("Filters by role", func(t *testing.T) {
+		role := entPost.RoleSolution
+		results, err := repo.Search(ctx, search.Filter{Query: "zorblat", Role: &role})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{solution.ID}, ids(results))
+	})
+
+	t.Run("Pages through results", func(t *testing.T) {
+		results, err := repo.Search(ctx, search.Filter{Query: "zorblat", Limit: 3})
+		require.NoError(t, err)
+		assert.Len(t, results.Items, 3)
+		assert.True(t, results.HasMore)
+
+		results, err = repo.Search(ctx, search.Filter{Query: "zorblat", Limit: 3, Page: 2})
+		require.NoError(t, err)
+		assert.Len(t, results.Items, 1)
+		assert.False(t, results.HasMore)
+	})
+
+	t.Run("Empty queries are rejected", func(t *testing.T) {
+		_, err := repo.Search(ctx, search.Filter{Query: "  "})
+		assert.ErrorIs(t, err, search.ErrEmptyQuery)
+	})
+}
+
+func createPost(t *testing.T, client *ent.Client, user *ent.User, comm *ent.Community, title, body string, tags []string) *ent.Post {
+	builder := client.Post.Create().
+		SetTitle(title).
+		SetUser(user).
+		SetCommunity(comm)
+	if body != "" {
+		builder.SetBody(body)
+	}
+	if tags != nil {
+		builder.SetTags(tags)
+	}
+	p, err := builder.Save(context.Background())
+	require.NoError(t, err)
+	return p
+}
+
+func ids(results *search.Results) []uuid.UUID {
+	var out []uuid.UUID
+	for _, item := range results.Items {
+		out = append(out, item.ID)
+	}
+	return out
+}
+
+func setupTestDB(t *testing.T) *ent.Client {
+	return factory.DB(t, ent.Log(t.Log))
+}
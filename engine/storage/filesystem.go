@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// Filesystem keeps blobs as files under a directory, spread over
+// subdirectories named after the end of the key
+type Filesystem struct {
+	dir string
+}
+
+var _ BlobStore = (*Filesystem)(nil)
+
+func NewFilesystem(dir string) (*Filesystem, error) {
+	if dir == "" {
+		return nil, errors.New("blob directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &Filesystem{
+		dir: dir,
+	}, nil
+}
+
+func (f *Filesystem) path(key string) string {
+	// UUIDv7 keys start with a timestamp, so the end varies more
+	return filepath.Join(f.dir, key[len(key)-2:], key)
+}
+
+// Put writes to a temporary file first so readers never see part of a blob
+func (f *Filesystem) Put(_ context.Context, key string, data []byte, _ string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	path := f.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return errors.WithStack(err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+key+".*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.WithStack(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(tmp.Name(), path))
+}
+
+func (f *Filesystem) Get(_ context.Context, key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(f.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errors.WithStack(ErrNotFound)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return data, nil
+}
+
+func (f *Filesystem) Delete(_ context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	err := os.Remove(f.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return errors.WithStack(err)
+}
@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	"fixit/engine/ent/file"
+)
+
+// MigrateResult counts what Migrate did
+type MigrateResult struct {
+	Copied int
+	// Missing blobs weren't in the source store, so were skipped
+	Missing int
+}
+
+// Object is a blob to migrate
+type Object struct {
+	Key         string
+	ContentType string
+}
+
+// FileObjects returns the blob of every uploaded file
+func FileObjects(ctx context.Context, client *ent.Client) ([]Object, error) {
+	files, err := client.File.Query().
+		Select(file.FieldID, file.FieldContentType).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	objects := make([]Object, len(files))
+	for i, f := range files {
+		objects[i] = Object{Key: f.ID.String(), ContentType: f.ContentType}
+	}
+	return objects, nil
+}
+
+// Migrate copies blobs from one store to another, optionally deleting each
+// from the source once it's copied. It's safe to re-run after a failure as
+// Put overwrites.
+func Migrate(ctx context.Context, from, to BlobStore, objects []Object, deleteSource bool) (MigrateResult, error) {
+	var result MigrateResult
+	for _, object := range objects {
+		key := object.Key
+		if err := ctx.Err(); err != nil {
+			return result, errors.WithStack(err)
+		}
+
+		data, err := from.Get(ctx, key)
+		if errors.Is(err, ErrNotFound) {
+			slog.Warn("blob missing from source store", "key", key)
+			result.Missing++
+			continue
+		}
+		if err != nil {
+			return result, errors.Wrapf(err, "reading %s", key)
+		}
+
+		if err := to.Put(ctx, key, data, object.ContentType); err != nil {
+			return result, errors.Wrapf(err, "writing %s", key)
+		}
+		result.Copied++
+
+		if deleteSource {
+			if err := from.Delete(ctx, key); err != nil {
+				return result, errors.Wrapf(err, "deleting %s", key)
+			}
+		}
+	}
+	return result, nil
+}
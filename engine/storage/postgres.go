@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+)
+
+// Postgres keeps blobs in the blob table
+type Postgres struct {
+	client *ent.Client
+}
+
+var _ BlobStore = (*Postgres)(nil)
+
+func NewPostgres(client *ent.Client) *Postgres {
+	return &Postgres{
+		client: client,
+	}
+}
+
+func (p *Postgres) Put(ctx context.Context, key string, data []byte, _ string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	err := p.client.Blob.Create().
+		SetID(key).
+		SetData(data).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		err = p.client.Blob.UpdateOneID(key).
+			SetData(data).
+			Exec(ctx)
+	}
+	return errors.WithStack(err)
+}
+
+func (p *Postgres) Get(ctx context.Context, key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	blob, err := p.client.Blob.Get(ctx, key)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithStack(ErrNotFound)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return blob.Data, nil
+}
+
+func (p *Postgres) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	err := p.client.Blob.DeleteOneID(key).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	return errors.WithStack(err)
+}
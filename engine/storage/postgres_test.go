@@ -0,0 +1,20 @@
+package storage_test
+
+import (
+	"testing"
+
+	_ "github.com/lib/pq"
+
+	"fixit/engine/ent"
+	"fixit/engine/factory"
+	"fixit/engine/storage"
+)
+
+func TestPostgres(t *testing.T) {
+	client := setupTestDB(t)
+	testBlobStore(t, storage.NewPostgres(client))
+}
+
+func setupTestDB(t *testing.T) *ent.Client {
+	return factory.DB(t, ent.Log(t.Log))
+}
@@ -0,0 +1,119 @@
+package storage_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/storage"
+)
+
+// fakeS3 is a local stand-in for an S3-compatible service, supporting just
+// the object calls the store makes with path-style addressing
+type fakeS3 struct {
+	t       *testing.T
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func newFakeS3(t *testing.T) *fakeS3 {
+	return &fakeS3{
+		t:       t,
+		objects: map[string][]byte{},
+		types:   map[string]string{},
+	}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	require.NoError(f.t, err)
+
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=test-key/") || !strings.Contains(auth, "Signature=") {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+	sum := sha256.Sum256(body)
+	if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+		http.Error(w, "XAmzContentSHA256Mismatch", http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[r.URL.Path] = body
+		f.types[r.URL.Path] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3(t *testing.T) {
+	fake := newFakeS3(t)
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store, err := storage.NewS3(storage.S3Config{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          "fixit",
+		AccessKeyID:     "test-key",
+		SecretAccessKey: "test-secret",
+		Prefix:          "uploads/",
+		PathStyle:       true,
+	})
+	require.NoError(t, err)
+
+	testBlobStore(t, store)
+
+	// Test: Objects are addressed by bucket and prefix, keeping the content type
+	require.NoError(t, store.Put(t.Context(), testKey, []byte("data"), "image/png"))
+	assert.Equal(t, []byte("data"), fake.objects["/fixit/uploads/"+testKey])
+	assert.Equal(t, "image/png", fake.types["/fixit/uploads/"+testKey])
+
+	// Test: Errors from the service are reported
+	bad, err := storage.NewS3(storage.S3Config{
+		Endpoint:        server.URL,
+		Bucket:          "fixit",
+		AccessKeyID:     "wrong-key",
+		SecretAccessKey: "test-secret",
+		PathStyle:       true,
+	})
+	require.NoError(t, err)
+	err = bad.Put(t.Context(), testKey, []byte("data"), "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+}
+
+func TestNewS3_Validation(t *testing.T) {
+	_, err := storage.NewS3(storage.S3Config{Endpoint: "https://s3.amazonaws.com", AccessKeyID: "k", SecretAccessKey: "s"})
+	assert.Error(t, err, "bucket is required")
+
+	_, err = storage.NewS3(storage.S3Config{Endpoint: "https://s3.amazonaws.com", Bucket: "b"})
+	assert.Error(t, err, "credentials are required")
+
+	_, err = storage.NewS3(storage.S3Config{Endpoint: "not a url", Bucket: "b", AccessKeyID: "k", SecretAccessKey: "s"})
+	assert.Error(t, err, "endpoint must be a URL")
+}
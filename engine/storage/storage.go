@@ -0,0 +1,66 @@
+// Package storage keeps the contents of uploaded files in a pluggable
+// blob store: Postgres, the local filesystem or an S3-compatible service.
+package storage
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+)
+
+const (
+	BackendPostgres   = "postgres"
+	BackendFilesystem = "filesystem"
+	BackendS3         = "s3"
+)
+
+var (
+	ErrNotFound       = errors.New("blob not found")
+	ErrInvalidKey     = errors.New("blob keys may only contain letters, numbers, '.', '_' and '-'")
+	ErrUnknownBackend = errors.New("unknown blob store backend")
+)
+
+// BlobStore keeps blobs of data by key
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get returns ErrNotFound when there's no blob with the key
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete succeeds when there's no blob with the key
+	Delete(ctx context.Context, key string) error
+}
+
+// Config chooses and configures the blob store
+type Config struct {
+	Backend string `env:"BLOB_STORE" envDefault:"postgres"`
+	// Dir is where the filesystem backend keeps blobs
+	Dir string `env:"BLOB_DIR" envDefault:"data/blobs"`
+	S3  S3Config
+}
+
+// keyPattern keeps keys safe as both file names and URL paths
+var keyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{1,254}$`)
+
+func validateKey(key string) error {
+	if !keyPattern.MatchString(key) {
+		return errors.WithStack(ErrInvalidKey)
+	}
+	return nil
+}
+
+// Open returns the blob store chosen by the config. Postgres is used when
+// no backend is set.
+func Open(cfg Config, client *ent.Client) (BlobStore, error) {
+	switch cfg.Backend {
+	case "", BackendPostgres:
+		return NewPostgres(client), nil
+	case BackendFilesystem:
+		return NewFilesystem(cfg.Dir)
+	case BackendS3:
+		return NewS3(cfg.S3)
+	default:
+		return nil, errors.Wrapf(ErrUnknownBackend, "%q", cfg.Backend)
+	}
+}
@@ -0,0 +1,112 @@
+package storage_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/storage"
+)
+
+const testKey = "0192a7f4-1c2d-7e3f-8a9b-0c1d2e3f4a5b"
+
+// testBlobStore checks the behaviour every backend must share
+func testBlobStore(t *testing.T, store storage.BlobStore) {
+	ctx := context.Background()
+
+	// Test: A missing blob is ErrNotFound
+	_, err := store.Get(ctx, testKey)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+
+	// Test: Put then Get round trips the data
+	require.NoError(t, store.Put(ctx, testKey, []byte("first"), "image/png"))
+	data, err := store.Get(ctx, testKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("first"), data)
+
+	// Test: Put overwrites
+	require.NoError(t, store.Put(ctx, testKey, []byte("second"), "image/png"))
+	data, err = store.Get(ctx, testKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("second"), data)
+
+	// Test: Delete removes the blob, and deleting again is fine
+	require.NoError(t, store.Delete(ctx, testKey))
+	_, err = store.Get(ctx, testKey)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	require.NoError(t, store.Delete(ctx, testKey))
+
+	// Test: Keys that could escape the store are rejected
+	for _, key := range []string{"", "../etc/passwd", "a/b", ".hidden", "a"} {
+		assert.ErrorIs(t, store.Put(ctx, key, []byte("x"), ""), storage.ErrInvalidKey, key)
+	}
+}
+
+func TestFilesystem(t *testing.T) {
+	dir := t.TempDir()
+	store, err := storage.NewFilesystem(dir)
+	require.NoError(t, err)
+
+	testBlobStore(t, store)
+
+	// Test: Blobs are spread over subdirectories and no temp files are left
+	require.NoError(t, store.Put(context.Background(), testKey, []byte("data"), ""))
+	entries, err := os.ReadDir(filepath.Join(dir, "5b"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, testKey, entries[0].Name())
+}
+
+func TestOpen(t *testing.T) {
+	store, err := storage.Open(storage.Config{Backend: storage.BackendFilesystem, Dir: t.TempDir()}, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &storage.Filesystem{}, store)
+
+	store, err = storage.Open(storage.Config{}, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &storage.Postgres{}, store)
+
+	_, err = storage.Open(storage.Config{Backend: storage.BackendS3}, nil)
+	assert.Error(t, err)
+
+	_, err = storage.Open(storage.Config{Backend: "floppy"}, nil)
+	assert.ErrorIs(t, err, storage.ErrUnknownBackend)
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	from, err := storage.NewFilesystem(t.TempDir())
+	require.NoError(t, err)
+	to, err := storage.NewFilesystem(t.TempDir())
+	require.NoError(t, err)
+
+	const missingKey = "0192a7f4-1c2d-7e3f-8a9b-0c1d2e3f4a5c"
+	require.NoError(t, from.Put(ctx, testKey, []byte("data"), "image/png"))
+	objects := []storage.Object{
+		{Key: testKey, ContentType: "image/png"},
+		{Key: missingKey, ContentType: "image/png"},
+	}
+
+	// Test: Blobs are copied and missing ones are counted, not fatal
+	result, err := storage.Migrate(ctx, from, to, objects, false)
+	require.NoError(t, err)
+	assert.Equal(t, storage.MigrateResult{Copied: 1, Missing: 1}, result)
+	data, err := to.Get(ctx, testKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+	_, err = from.Get(ctx, testKey)
+	require.NoError(t, err)
+
+	// Test: Re-running with deleteSource removes the source copy
+	result, err = storage.Migrate(ctx, from, to, objects[:1], true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Copied)
+	_, err = from.Get(ctx, testKey)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = to.Get(ctx, testKey)
+	require.NoError(t, err)
+}
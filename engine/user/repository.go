@@ -0,0 +1,189 @@
+// Package user manages accounts outside of the authboss sign up and login
+// flows, for admin commands and seeding
+package user
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"fixit/engine/auth"
+	"fixit/engine/ent"
+	"fixit/engine/ent/user"
+)
+
+// MinPasswordLength applies to passwords set here rather than through
+// authboss
+const MinPasswordLength = 8
+
+var (
+	ErrNotFound         = errors.New("user not found")
+	ErrTaken            = errors.New("username or email is already taken")
+	ErrPasswordTooShort = errors.New("password must be at least 8 characters")
+)
+
+type CreateFields struct {
+	Username string
+	Email    string
+	Password string
+}
+
+type Repository struct {
+	client    *ent.Client
+	passwords *auth.Passwords
+	sessions  *auth.Sessions
+}
+
+func New(client *ent.Client, passwords *auth.Passwords) *Repository {
+	return &Repository{
+		client:    client,
+		passwords: passwords,
+		sessions:  auth.NewSessions(client),
+	}
+}
+
+// Create adds a user who can log in with their email and password. There's
+// no confirmation email, the email address is taken on trust.
+func (r *Repository) Create(ctx context.Context, fields CreateFields) (*ent.User, error) {
+	hash, err := r.hashPassword(fields.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := r.client.User.Create().
+		SetUsername(fields.Username).
+		SetEmail(fields.Email).
+		SetPassword(hash).
+		SetConfirmed(true).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, errors.WithStack(ErrTaken)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return u, nil
+}
+
+// List returns every user, oldest first
+func (r *Repository) List(ctx context.Context) ([]*ent.User, error) {
+	users, err := r.client.User.Query().
+		Order(ent.Asc(user.FieldCreatedAt)).
+		All(ctx)
+	return users, errors.WithStack(err)
+}
+
+// Find looks a user up by email when the login contains an @, and by
+// username otherwise
+func (r *Repository) Find(ctx context.Context, login string) (*ent.User, error) {
+	query := r.client.User.Query()
+	if strings.Contains(login, "@") {
+		query.Where(user.EmailEQ(login))
+	} else {
+		query.Where(user.UsernameEQ(login))
+	}
+
+	u, err := query.Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.Wrapf(ErrNotFound, "%s", login)
+	}
+	return u, errors.WithStack(err)
+}
+
+// ResetPassword replaces a user's password, logging them out everywhere
+func (r *Repository) ResetPassword(ctx context.Context, login, password string) (*ent.User, error) {
+	u, err := r.Find(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := r.hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.Update().SetPassword(hash).Exec(ctx); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := r.sessions.EndAll(ctx, u.ID); err != nil {
+		return nil, err
+	}
+	u, err = r.client.User.Get(ctx, u.ID)
+	return u, errors.WithStack(err)
+}
+
+// Ban stops a user logging in, ending any sessions they have. Banning a
+// banned user keeps the original time.
+func (r *Repository) Ban(ctx context.Context, login string) (*ent.User, error) {
+	u, err := r.Find(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+	if u.BannedAt != nil {
+		return u, nil
+	}
+
+	if err := u.Update().SetBannedAt(time.Now()).Exec(ctx); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := r.sessions.EndAll(ctx, u.ID); err != nil {
+		return nil, err
+	}
+	u, err = r.client.User.Get(ctx, u.ID)
+	return u, errors.WithStack(err)
+}
+
+// Unban lets a banned user log in again
+func (r *Repository) Unban(ctx context.Context, login string) (*ent.User, error) {
+	u, err := r.Find(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err = u.Update().ClearBannedAt().Save(ctx)
+	return u, errors.WithStack(err)
+}
+
+// RehashPlaintext finds passwords stored without hashing, as seeding and
+// test users used to, and replaces them with a hash of themselves. It
+// returns the users updated.
+func (r *Repository) RehashPlaintext(ctx context.Context) ([]*ent.User, error) {
+	users, err := r.client.User.Query().
+		Select(user.FieldID, user.FieldUsername, user.FieldPassword).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var rehashed []*ent.User
+	for _, u := range users {
+		if auth.IsHashed(u.Password) {
+			continue
+		}
+		hash, err := r.passwords.GenerateHash(u.Password)
+		if err != nil {
+			return rehashed, err
+		}
+		err = r.client.User.UpdateOneID(u.ID).
+			Where(user.PasswordEQ(u.Password)).
+			SetPassword(hash).
+			Exec(ctx)
+		// changed since it was read, so it's been hashed already
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return rehashed, errors.WithStack(err)
+		}
+		rehashed = append(rehashed, u)
+	}
+	return rehashed, nil
+}
+
+func (r *Repository) hashPassword(password string) (string, error) {
+	if len(password) < MinPasswordLength {
+		return "", errors.WithStack(ErrPasswordTooShort)
+	}
+	return r.passwords.GenerateHash(password)
+}
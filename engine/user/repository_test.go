@@ -0,0 +1,145 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"fixit/engine/auth"
+	"fixit/engine/ent"
+	"fixit/engine/ent/remembertoken"
+	"fixit/engine/factory"
+	"fixit/engine/user"
+)
+
+func TestRepository_Create(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := user.New(client, factory.Passwords(t))
+
+	username := factory.Placeholder("created-*")
+	fields := user.CreateFields{
+		Username: username,
+		Email:    username + "@example.com",
+		Password: "correct horse",
+	}
+
+	// Test: Passwords are stored hashed
+	created, err := repo.Create(ctx, fields)
+	require.NoError(t, err)
+	assert.NotEqual(t, "correct horse", created.Password)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(created.Password), []byte("correct horse")))
+
+	// Test: Users are found by username or email
+	byName, err := repo.Find(ctx, username)
+	require.NoError(t, err)
+	byEmail, err := repo.Find(ctx, fields.Email)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, byName.ID)
+	assert.Equal(t, created.ID, byEmail.ID)
+
+	// Test: Usernames and emails can't be reused
+	_, err = repo.Create(ctx, fields)
+	assert.ErrorIs(t, err, user.ErrTaken)
+
+	// Test: Short passwords are rejected
+	fields.Username = factory.Placeholder("short-*")
+	fields.Email = fields.Username + "@example.com"
+	fields.Password = "short"
+	_, err = repo.Create(ctx, fields)
+	assert.ErrorIs(t, err, user.ErrPasswordTooShort)
+
+	// Test: Unknown users aren't found
+	_, err = repo.Find(ctx, factory.Placeholder("nobody-*"))
+	assert.ErrorIs(t, err, user.ErrNotFound)
+}
+
+func TestRepository_ResetPassword(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := user.New(client, factory.Passwords(t))
+	existing := factory.User(t, client, "reset-*")
+
+	updated, err := repo.ResetPassword(ctx, existing.Username, "a new password")
+	require.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(updated.Password), []byte("a new password")))
+	// Test: Sessions from before the reset end
+	assert.Equal(t, existing.SessionVersion+1, updated.SessionVersion)
+}
+
+func TestRepository_Ban(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := user.New(client, factory.Passwords(t))
+	storer := auth.NewStorer(client)
+	existing := factory.User(t, client, "banned-*")
+	_, err := client.RememberToken.Create().
+		SetUserID(existing.ID).
+		SetToken(factory.Placeholder("banned-token-*")).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Test: Banned users can't be loaded for login or sessions
+	banned, err := repo.Ban(ctx, existing.Email)
+	require.NoError(t, err)
+	require.NotNil(t, banned.BannedAt)
+	_, err = storer.Load(ctx, existing.Email)
+	assert.Error(t, err)
+
+	// Test: Their sessions and remember me tokens end
+	assert.Equal(t, existing.SessionVersion+1, banned.SessionVersion)
+	count, err := client.RememberToken.Query().
+		Where(remembertoken.UserIDEQ(existing.ID)).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// Test: Banning again keeps the original time
+	again, err := repo.Ban(ctx, existing.Email)
+	require.NoError(t, err)
+	assert.True(t, banned.BannedAt.Equal(*again.BannedAt))
+
+	// Test: Lifting the ban lets them back in
+	_, err = repo.Unban(ctx, existing.Email)
+	require.NoError(t, err)
+	_, err = storer.Load(ctx, existing.Email)
+	assert.NoError(t, err)
+}
+
+func TestRepository_RehashPlaintext(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := user.New(client, factory.Passwords(t))
+
+	username := factory.Placeholder("plaintext-*")
+	plaintext, err := client.User.Create().
+		SetUsername(username).
+		SetEmail(username + "@example.com").
+		SetPassword("password123").
+		Save(ctx)
+	require.NoError(t, err)
+	hashed := factory.User(t, client, "hashed-*")
+
+	// Test: Plaintext passwords are hashed, hashes are left alone
+	rehashed, err := repo.RehashPlaintext(ctx)
+	require.NoError(t, err)
+	var ids []string
+	for _, u := range rehashed {
+		ids = append(ids, u.ID.String())
+	}
+	assert.Contains(t, ids, plaintext.ID.String())
+	assert.NotContains(t, ids, hashed.ID.String())
+
+	reloaded, err := client.User.Get(ctx, plaintext.ID)
+	require.NoError(t, err)
+	assert.True(t, auth.IsHashed(reloaded.Password))
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(reloaded.Password), []byte("password123")))
+}
+
+func setupTestDB(t *testing.T) *ent.Client {
+	return factory.DB(t, ent.Log(t.Log))
+}
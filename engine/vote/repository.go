@@ -0,0 +1,219 @@
+package vote
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/pkg/errors"
+
+	"fixit/engine/ent"
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/user"
+	"fixit/engine/ent/vote"
+	"fixit/engine/internal/entx"
+)
+
+var (
+	ErrInvalidValue  = errors.New("vote value must be 1 or -1")
+	ErrAlreadyVoted  = errors.New("user has already voted on this post")
+	ErrVoteNotFound  = errors.New("vote not found")
+	ErrPostNotFound  = errors.New("post not found")
+	ErrUnchangedVote = errors.New("vote already has this value")
+)
+
+// uniqueVoteIndex keeps each user to one vote of each kind per post
+const uniqueVoteIndex = "vote_kind_vote_post_vote_user"
+
+// Score is the net total of votes of each kind on a post
+type Score struct {
+	Interesting int
+	Truthful    int
+}
+
+// Total is the combined score across kinds, used for ranking
+func (s Score) Total() int {
+	return s.Interesting + s.Truthful
+}
+
+type Repository struct {
+	client *ent.Client
+}
+
+func New(client *ent.Client) *Repository {
+	return &Repository{
+		client: client,
+	}
+}
+
+// Cast records a new vote by the user on a post. Each user has at most one
+// vote of each kind per post, so use Change to flip an existing vote.
+func (r *Repository) Cast(ctx context.Context, postID uuid.UUID, userID uuid.UUID, kind vote.Kind, value int) (*ent.Vote, error) {
+	if err := validate(kind, value); err != nil {
+		return nil, err
+	}
+
+	exists, err := r.client.Post.Query().Where(post.ID(postID), post.DeletedAtIsNil()).Exist(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !exists {
+		return nil, ErrPostNotFound
+	}
+
+	v, err := r.client.Vote.Create().
+		SetKind(kind).
+		SetValue(value).
+		SetPostID(postID).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		if entx.IsUniqueViolation(err, uniqueVoteIndex) {
+			return nil, ErrAlreadyVoted
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return v, nil
+}
+
+// Change flips the value of the user's existing vote of this kind
+func (r *Repository) Change(ctx context.Context, postID uuid.UUID, userID uuid.UUID, kind vote.Kind, value int) (*ent.Vote, error) {
+	if err := validate(kind, value); err != nil {
+		return nil, err
+	}
+
+	existing, err := r.find(ctx, postID, userID, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.Value == value {
+		return nil, ErrUnchangedVote
+	}
+
+	v, err := existing.Update().
+		SetValue(value).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return v, nil
+}
+
+// Retract removes the user's vote of this kind from a post
+func (r *Repository) Retract(ctx context.Context, postID uuid.UUID, userID uuid.UUID, kind vote.Kind) error {
+	if err := vote.KindValidator(kind); err != nil {
+		return errors.WithStack(err)
+	}
+
+	deleted, err := r.client.Vote.Delete().
+		Where(
+			vote.KindEQ(kind),
+			vote.HasPostWith(post.ID(postID)),
+			vote.HasUserWith(user.ID(userID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if deleted == 0 {
+		return ErrVoteNotFound
+	}
+
+	return nil
+}
+
+// UserVotes returns the user's current vote value on a post, keyed by kind
+func (r *Repository) UserVotes(ctx context.Context, postID uuid.UUID, userID uuid.UUID) (map[vote.Kind]int, error) {
+	votes, err := r.client.Vote.Query().
+		Where(
+			vote.HasPostWith(post.ID(postID)),
+			vote.HasUserWith(user.ID(userID)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	byKind := make(map[vote.Kind]int, len(votes))
+	for _, v := range votes {
+		byKind[v.Kind] = v.Value
+	}
+
+	return byKind, nil
+}
+
+// Score totals the votes on a single post
+func (r *Repository) Score(ctx context.Context, postID uuid.UUID) (Score, error) {
+	scores, err := r.Scores(ctx, []uuid.UUID{postID})
+	if err != nil {
+		return Score{}, err
+	}
+	return scores[postID], nil
+}
+
+// Scores totals the votes on many posts in a single grouped query. Posts
+// without votes are absent from the map, so their zero Score is returned
+// on lookup.
+func (r *Repository) Scores(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID]Score, error) {
+	scores := make(map[uuid.UUID]Score, len(postIDs))
+	if len(postIDs) == 0 {
+		return scores, nil
+	}
+
+	var rows []struct {
+		PostID uuid.UUID `json:"vote_post"`
+		Kind   vote.Kind `json:"kind"`
+		Sum    int       `json:"sum"`
+	}
+	err := r.client.Vote.Query().
+		Where(vote.HasPostWith(post.IDIn(postIDs...))).
+		GroupBy(vote.PostColumn, vote.FieldKind).
+		Aggregate(ent.Sum(vote.FieldValue)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, row := range rows {
+		score := scores[row.PostID]
+		switch row.Kind {
+		case vote.KindInteresting:
+			score.Interesting = row.Sum
+		case vote.KindTruthful:
+			score.Truthful = row.Sum
+		}
+		scores[row.PostID] = score
+	}
+
+	return scores, nil
+}
+
+func (r *Repository) find(ctx context.Context, postID uuid.UUID, userID uuid.UUID, kind vote.Kind) (*ent.Vote, error) {
+	v, err := r.client.Vote.Query().
+		Where(
+			vote.KindEQ(kind),
+			vote.HasPostWith(post.ID(postID)),
+			vote.HasUserWith(user.ID(userID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrVoteNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+	return v, nil
+}
+
+func validate(kind vote.Kind, value int) error {
+	if err := vote.KindValidator(kind); err != nil {
+		return errors.WithStack(err)
+	}
+	if value != 1 && value != -1 {
+		return ErrInvalidValue
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package vote_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/ent"
+	entVote "fixit/engine/ent/vote"
+	"fixit/engine/factory"
+	"fixit/engine/vote"
+)
+
+func TestRepository_CastChangeRetract(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := vote.New(client)
+
+	author := factory.User(t, client, "vote-author-*")
+	voter := factory.User(t, client, "voter-*")
+	community := factory.Community(t, client, "vote-community-*")
+	p := createPost(t, client, author, community)
+
+	// Cast one vote of each kind
+	_, err := repo.Cast(ctx, p.ID, voter.ID, entVote.KindInteresting, 1)
+	require.NoError(t, err)
+	_, err = repo.Cast(ctx, p.ID, voter.ID, entVote.KindTruthful, -1)
+	require.NoError(t, err)
+
+	score, err := repo.Score(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Equal(t, vote.Score{Interesting: 1, Truthful: -1}, score)
+
+	// A second vote of the same kind is rejected
+	_, err = repo.Cast(ctx, p.ID, voter.ID, entVote.KindInteresting, 1)
+	assert.ErrorIs(t, err, vote.ErrAlreadyVoted)
+
+	// Other constraint failures aren't mistaken for a second vote
+	_, err = repo.Cast(ctx, p.ID, uuid.Must(uuid.NewV4()), entVote.KindInteresting, 1)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, vote.ErrAlreadyVoted)
+
+	// Changing flips the existing vote
+	_, err = repo.Change(ctx, p.ID, voter.ID, entVote.KindTruthful, 1)
+	require.NoError(t, err)
+	_, err = repo.Change(ctx, p.ID, voter.ID, entVote.KindTruthful, 1)
+	assert.ErrorIs(t, err, vote.ErrUnchangedVote)
+
+	mine, err := repo.UserVotes(ctx, p.ID, voter.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[entVote.Kind]int{
+		entVote.KindInteresting: 1,
+		entVote.KindTruthful:    1,
+	}, mine)
+
+	// Retracting removes it from the score
+	require.NoError(t, repo.Retract(ctx, p.ID, voter.ID, entVote.KindInteresting))
+	assert.ErrorIs(t, repo.Retract(ctx, p.ID, voter.ID, entVote.KindInteresting), vote.ErrVoteNotFound)
+
+	score, err = repo.Score(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Equal(t, vote.Score{Interesting: 0, Truthful: 1}, score)
+}
+
+func TestRepository_VotesAreUniquePerPost(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := vote.New(client)
+
+	author := factory.User(t, client, "unique-author-*")
+	voter := factory.User(t, client, "unique-voter-*")
+	community := factory.Community(t, client, "unique-community-*")
+	first := createPost(t, client, author, community)
+	second := createPost(t, client, author, community)
+
+	// The same user can vote the same kind on different posts
+	_, err := repo.Cast(ctx, first.ID, voter.ID, entVote.KindInteresting, 1)
+	require.NoError(t, err)
+	_, err = repo.Cast(ctx, second.ID, voter.ID, entVote.KindInteresting, 1)
+	require.NoError(t, err)
+
+	scores, err := repo.Scores(ctx, []uuid.UUID{first.ID, second.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, scores[first.ID].Interesting)
+	assert.Equal(t, 1, scores[second.ID].Interesting)
+}
+
+func TestRepository_CastValidation(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	repo := vote.New(client)
+
+	author := factory.User(t, client, "validation-author-*")
+	community := factory.Community(t, client, "validation-community-*")
+	p := createPost(t, client, author, community)
+
+	_, err := repo.Cast(ctx, p.ID, author.ID, entVote.KindInteresting, 5)
+	assert.ErrorIs(t, err, vote.ErrInvalidValue)
+
+	_, err = repo.Cast(ctx, p.ID, author.ID, entVote.Kind("helpful"), 1)
+	assert.Error(t, err)
+
+	_, err = repo.Cast(ctx, uuid.Must(uuid.NewV7()), author.ID, entVote.KindInteresting, 1)
+	assert.ErrorIs(t, err, vote.ErrPostNotFound)
+}
+
+func createPost(t *testing.T, client *ent.Client, user *ent.User, community *ent.Community) *ent.Post {
+	p, err := client.Post.Create().
+		SetTitle("Post to vote on").
+		SetUser(user).
+		SetCommunity(community).
+		Save(context.Background())
+	require.NoError(t, err)
+	return p
+}
+
+func setupTestDB(t *testing.T) *ent.Client {
+	return factory.DB(t, ent.Log(t.Log))
+}
@@ -0,0 +1,151 @@
+// Package account has the pages a logged in user manages their own account
+// from
+package account
+
+import (
+	"bytes"
+	_ "embed"
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/dustin/go-humanize"
+	"github.com/gofrs/uuid/v5"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"fixit/engine/auth"
+	"fixit/web/handler"
+	"fixit/web/layouts"
+	"fixit/web/server"
+)
+
+//go:embed templates/sessions.gohtml
+var sessionsTplS string
+
+var sessionsTpl = template.Must(template.New("sessions").Funcs(template.FuncMap{
+	"humanizeTime": func(t time.Time) string {
+		return humanize.Time(t)
+	},
+}).Parse(sessionsTplS))
+
+type Handler struct {
+	sessions *auth.Sessions
+	ab       *authboss.Authboss
+	// listed is whether sessions are kept where they can be listed
+	listed bool
+}
+
+func New(sessions *auth.Sessions, ab *authboss.Authboss, listed bool) *Handler {
+	return &Handler{
+		sessions: sessions,
+		ab:       ab,
+		listed:   listed,
+	}
+}
+
+type SessionsData struct {
+	Username  string
+	Listed    bool
+	Sessions  []Session
+	CSRFToken string
+}
+
+type Session struct {
+	ID         uuid.UUID
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	Current    bool
+}
+
+func (h *Handler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/account/sessions", handler.Wrap(h.SessionsHandler)).Methods("GET")
+	router.HandleFunc("/account/sessions/end-others", h.EndOthersHandler).Methods("POST")
+	router.HandleFunc("/account/sessions/{id}/end", handler.Wrap(h.EndHandler)).Methods("POST")
+}
+
+// SessionsHandler lists the user's sessions, when they're kept in Postgres,
+// with ways to end them
+func (h *Handler) SessionsHandler(r *http.Request) (handler.Response, error) {
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
+	if !isAuthenticated {
+		return handler.RedirectTo("/auth/login"), nil
+	}
+
+	data := SessionsData{
+		Username:  user.Username,
+		Listed:    h.listed,
+		CSRFToken: server.CSRFToken(r.Context()),
+	}
+	if h.listed {
+		sessions, err := h.sessions.List(r.Context(), user.ID)
+		if err != nil {
+			return nil, err
+		}
+		current := auth.CurrentSessionID(r)
+		for _, s := range sessions {
+			data.Sessions = append(data.Sessions, Session{
+				ID:         s.ID,
+				UserAgent:  s.UserAgent,
+				IP:         s.IP,
+				CreatedAt:  s.CreatedAt,
+				LastSeenAt: s.LastSeenAt,
+				Current:    s.ID == current,
+			})
+		}
+	}
+
+	var content bytes.Buffer
+	if err := sessionsTpl.Execute(&content, data); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	page, err := layouts.WithGeneral(layouts.LayoutData{
+		Title:     "Your Sessions - FixIt",
+		Content:   template.HTML(content.String()),
+		CSRFToken: data.CSRFToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return handler.Ok(page), nil
+}
+
+// EndHandler ends one of the user's sessions
+func (h *Handler) EndHandler(r *http.Request) (handler.Response, error) {
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
+	if !isAuthenticated {
+		return handler.RedirectTo("/auth/login"), nil
+	}
+
+	sessionID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		return handler.BadInput([]byte("Invalid session ID")), nil
+	}
+
+	err = h.sessions.End(r.Context(), user.ID, sessionID)
+	if errors.Is(err, auth.ErrSessionNotFound) {
+		return handler.NotFound([]byte("Session not found")), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return handler.RedirectTo("/account/sessions"), nil
+}
+
+// EndOthersHandler logs the user out everywhere but here. It needs the
+// ResponseWriter to keep this session going.
+func (h *Handler) EndOthersHandler(w http.ResponseWriter, r *http.Request) {
+	handler.Wrap(func(r *http.Request) (handler.Response, error) {
+		user, isAuthenticated := auth.RequireAuth(h.ab, r)
+		if !isAuthenticated {
+			return handler.RedirectTo("/auth/login"), nil
+		}
+		if err := h.sessions.EndOthers(w, r, user.ID); err != nil {
+			return nil, err
+		}
+		return handler.RedirectTo("/account/sessions"), nil
+	})(w, r)
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/pkg/errors"
+)
+
+//go:embed templates/mail
+var mailFS embed.FS
+var (
+	mailHTML = htmltemplate.Must(htmltemplate.ParseFS(mailFS, "templates/mail/*.gohtml"))
+	mailText = texttemplate.Must(texttemplate.ParseFS(mailFS, "templates/mail/*.gotxt"))
+)
+
+// MailRenderer renders the emails authboss sends. Pages ending _html use
+// templates/mail/<page>.gohtml and those ending _txt use <page>.gotxt.
+type MailRenderer struct{}
+
+func NewMailRenderer() *MailRenderer {
+	return &MailRenderer{}
+}
+
+var _ authboss.Renderer = (*MailRenderer)(nil)
+
+func (r *MailRenderer) Load(names ...string) error {
+	for _, name := range names {
+		if mailHTML.Lookup(name+".gohtml") == nil && mailText.Lookup(name+".gotxt") == nil {
+			return errors.Errorf("no mail template for %s", name)
+		}
+	}
+	return nil
+}
+
+func (r *MailRenderer) Render(ctx context.Context, page string, data authboss.HTMLData) ([]byte, string, error) {
+	var buf bytes.Buffer
+	if strings.HasSuffix(page, "_txt") {
+		if err := mailText.ExecuteTemplate(&buf, page+".gotxt", data); err != nil {
+			return nil, "", errors.WithStack(err)
+		}
+		return buf.Bytes(), "text/plain", nil
+	}
+
+	if err := mailHTML.ExecuteTemplate(&buf, page+".gohtml", data); err != nil {
+		return nil, "", errors.WithStack(err)
+	}
+	return buf.Bytes(), "text/html", nil
+}
@@ -11,13 +11,23 @@ import (
 	"github.com/pkg/errors"
 
 	"fixit/web/layouts"
+	"fixit/web/server"
 )
 
 //go:embed templates/*.gohtml
 var templatesFS embed.FS
 var templates = template.Must(template.ParseFS(templatesFS, "templates/*.gohtml"))
 
-type Renderer struct{}
+type Renderer struct {
+	// Providers are offered as other ways to log in or sign up
+	Providers []Provider
+}
+
+// Provider is an OpenID Connect provider users can log in through
+type Provider struct {
+	Name  string
+	Title string
+}
 
 func NewRenderer() *Renderer {
 	return &Renderer{}
@@ -26,11 +36,24 @@ func NewRenderer() *Renderer {
 var _ authboss.Renderer = (*Renderer)(nil)
 
 func (r *Renderer) Load(names ...string) error {
+	for _, name := range names {
+		if templates.Lookup(filepath.Base(name)+".gohtml") == nil {
+			return errors.Errorf("no template for %s", name)
+		}
+	}
 	return nil
 }
 
 func (r *Renderer) Render(ctx context.Context, page string, data authboss.HTMLData) (output []byte, contentType string, err error) {
 	templateName := filepath.Base(page) + ".gohtml"
+	if data == nil {
+		data = authboss.HTMLData{}
+	}
+	if len(r.Providers) > 0 {
+		data["oidc_providers"] = r.Providers
+	}
+	csrfToken := server.CSRFToken(ctx)
+	data["csrf_token"] = csrfToken
 	
 	// Execute the content template
 	content, err := templatesExecute(templateName, data)
@@ -38,16 +61,11 @@ func (r *Renderer) Render(ctx context.Context, page string, data authboss.HTMLDa
 		return nil, "", err
 	}
 
-	// Get the page title based on template name
-	title := "Login"
-	if templateName == "register.gohtml" {
-		title = "Sign Up"
-	}
-
 	// Render with layout
 	html, err := layouts.WithGeneral(layouts.LayoutData{
-		Title:   title + " - FixIt",
-		Content: template.HTML(content),
+		Title:     pageTitle(page) + " - FixIt",
+		Content:   template.HTML(content),
+		CSRFToken: csrfToken,
 	})
 	if err != nil {
 		return nil, "", err
@@ -56,6 +74,21 @@ func (r *Renderer) Render(ctx context.Context, page string, data authboss.HTMLDa
 	return html, "text/html", nil
 }
 
+// pageTitles are the titles of pages other than login
+var pageTitles = map[string]string{
+	"register":      "Sign Up",
+	"recover_start": "Forgot Password",
+	"recover_end":   "Reset Password",
+	"locked":        "Account Locked",
+}
+
+func pageTitle(page string) string {
+	if title, ok := pageTitles[filepath.Base(page)]; ok {
+		return title
+	}
+	return "Login"
+}
+
 func templatesExecute(name string, data any) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
@@ -5,7 +5,6 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
-	"fmt"
 	"html/template"
 	"net/http"
 
@@ -16,8 +15,10 @@ import (
 
 	"fixit/engine/auth"
 	"fixit/engine/community"
+	"fixit/engine/geo"
 	handler "fixit/web/handler"
 	"fixit/web/layouts"
+	"fixit/web/server"
 )
 
 //go:embed templates/create.gohtml
@@ -33,29 +34,45 @@ type CreateData struct {
 	Latitude       string
 	Longitude      string
 	Error          string
+	CSRFToken      string `json:"-"`
+	Nonce          string `json:"-"`
 }
 
+// sessionName is the cookie keeping the create form's flashes
+const sessionName = "fixit_session"
+
 type Handler struct {
-	store *sessions.CookieStore
-	repo  *community.Repository
-	ab    *authboss.Authboss
+	store       *sessions.CookieStore
+	sessionName string
+	repo        *community.Repository
+	ab          *authboss.Authboss
 }
 
-func New(sessionKey []byte, repo *community.Repository, ab *authboss.Authboss) *Handler {
+func New(sessionKey []byte, secure bool, repo *community.Repository, ab *authboss.Authboss) *Handler {
+	store := sessions.NewCookieStore(sessionKey)
+	store.Options.HttpOnly = true
+	store.Options.Secure = secure
+	store.Options.SameSite = http.SameSiteLaxMode
 	return &Handler{
-		store: sessions.NewCookieStore(sessionKey),
-		repo:  repo,
-		ab:    ab,
+		store:       store,
+		sessionName: server.CookieName(sessionName, secure),
+		repo:        repo,
+		ab:          ab,
 	}
 }
 
 func (h *Handler) RegisterRoutes(router *mux.Router) {
 	router.HandleFunc("/community/new", handler.Wrap(h.CreateGetHandler)).Methods("GET")
 	router.HandleFunc("/api/community/create", handler.Wrap(h.CreatePostHandler)).Methods("POST")
+	router.HandleFunc("/api/community/{slug}/join", handler.Wrap(h.JoinHandler)).Methods("POST")
+	router.HandleFunc("/api/community/{slug}/leave", handler.Wrap(h.LeaveHandler)).Methods("POST")
+	router.HandleFunc("/api/community/{slug}/settings", handler.Wrap(h.SettingsPostHandler)).Methods("POST")
+	router.HandleFunc("/api/community/{slug}/role", handler.Wrap(h.RoleHandler)).Methods("POST")
+	router.HandleFunc("/c/{slug}/settings", handler.Wrap(h.SettingsGetHandler)).Methods("GET")
 }
 
 func (h *Handler) CreateGetHandler(r *http.Request) (handler.Response, error) {
-	session, err := h.store.Get(r, "fixit_session")
+	session, err := h.store.Get(r, h.sessionName)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -78,15 +95,20 @@ func (h *Handler) CreateGetHandler(r *http.Request) (handler.Response, error) {
 		}
 	}
 
-	// Don't save session here - let the handler wrapper deal with it
-	// The flashes will be cleared automatically after being read
-
-	return showCreateForm(data)
+	data.CSRFToken = server.CSRFToken(r.Context())
+	data.Nonce = layouts.Nonce(r.Context())
+	res, err := showCreateForm(data)
+	if err != nil {
+		return nil, err
+	}
+	// Save the session so the flashes we just read are cleared
+	res.Session = session
+	return res, nil
 }
 
 func (h *Handler) CreatePostHandler(r *http.Request) (handler.Response, error) {
 	// Check authentication first
-	_, isAuthenticated := auth.RequireAuth(h.ab, r)
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
 	if !isAuthenticated {
 		// For now, just redirect to login without flash message
 		// TODO: Implement flash message support
@@ -115,15 +137,23 @@ func (h *Handler) CreatePostHandler(r *http.Request) (handler.Response, error) {
 
 	// Validation
 	var validationError string
+	var point *geo.Point
 	if name == "" {
 		validationError = "Community name is required"
 	} else if title == "" {
 		validationError = "Community title is required"
+	} else if latitude != "" || longitude != "" {
+		p, err := geo.ParsePoint(latitude, longitude)
+		if err != nil {
+			validationError = "Invalid location: " + err.Error()
+		} else {
+			point = &p
+		}
 	}
 
 	if validationError != "" {
 		// Store form data and error in session
-		session, err := h.store.Get(r, "fixit_session")
+		session, err := h.store.Get(r, h.sessionName)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
@@ -136,13 +166,7 @@ func (h *Handler) CreatePostHandler(r *http.Request) (handler.Response, error) {
 		session.AddFlash(string(jsonData), "form_data")
 		session.AddFlash(validationError, "error")
 
-		return handler.RedirectTo("/community/new"), nil
-	}
-
-	// Create geography string from lat/lng
-	var geography string
-	if latitude != "" && longitude != "" {
-		geography = fmt.Sprintf("POINT(%s %s)", longitude, latitude)
+		return handler.RedirectWithSessionTo("/community/new", session, r), nil
 	}
 
 	// Create community using repository
@@ -152,13 +176,13 @@ func (h *Handler) CreatePostHandler(r *http.Request) (handler.Response, error) {
 		Title:          title,
 		Location:       location,
 		BannerImageURL: bannerImageURL,
-		Geography:      geography,
+		Point:          point,
 	}
 
-	comm, err := h.repo.Create(ctx, fields)
+	comm, err := h.repo.Create(ctx, fields, user.User)
 	if err != nil {
 		// Store form data and error in session
-		session, sessionErr := h.store.Get(r, "fixit_session")
+		session, sessionErr := h.store.Get(r, h.sessionName)
 		if sessionErr != nil {
 			return nil, errors.WithStack(sessionErr)
 		}
@@ -170,14 +194,14 @@ func (h *Handler) CreatePostHandler(r *http.Request) (handler.Response, error) {
 		session.AddFlash(string(jsonData), "form_data")
 		session.AddFlash("Failed to create community: "+err.Error(), "error")
 
-		return handler.RedirectTo("/community/new"), nil
+		return handler.RedirectWithSessionTo("/community/new", session, r), nil
 	}
 
 	// Success - redirect to the community page
 	return handler.RedirectTo("/c/" + comm.Name), nil
 }
 
-func showCreateForm(data CreateData) (handler.Response, error) {
+func showCreateForm(data CreateData) (*handler.ResponseBuffered, error) {
 	var contentBuf bytes.Buffer
 	err := createTpl.Execute(&contentBuf, data)
 	if err != nil {
@@ -185,8 +209,9 @@ func showCreateForm(data CreateData) (handler.Response, error) {
 	}
 
 	layoutData := layouts.LayoutData{
-		Title:   "Create Community",
-		Content: template.HTML(contentBuf.String()),
+		Title:     "Create Community",
+		Content:   template.HTML(contentBuf.String()),
+		CSRFToken: data.CSRFToken,
 	}
 
 	content, err := layouts.WithGeneral(layoutData)
@@ -194,5 +219,5 @@ func showCreateForm(data CreateData) (handler.Response, error) {
 		return nil, errors.WithStack(err)
 	}
 
-	return handler.Ok(content), nil
+	return &handler.ResponseBuffered{Content: content}, nil
 }
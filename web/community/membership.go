@@ -0,0 +1,221 @@
+package community
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"html/template"
+	"net/http"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"fixit/engine/auth"
+	"fixit/engine/community"
+	"fixit/engine/ent"
+	"fixit/engine/ent/membership"
+	handler "fixit/web/handler"
+	"fixit/web/layouts"
+	"fixit/web/server"
+)
+
+//go:embed templates/settings.gohtml
+var settingsTplS string
+
+var settingsTpl = template.Must(template.New("settings").Parse(settingsTplS))
+
+type SettingsData struct {
+	Community      *ent.Community
+	Title          string
+	Location       string
+	BannerImageURL string
+	Members        []MemberView
+	Roles          []membership.Role
+	Error          string
+	CSRFToken      string
+}
+
+type MemberView struct {
+	UserID   uuid.UUID
+	Username string
+	Role     membership.Role
+}
+
+// JoinHandler makes the user a member of the community
+func (h *Handler) JoinHandler(r *http.Request) (handler.Response, error) {
+	return h.membershipChange(r, h.repo.Join)
+}
+
+// LeaveHandler takes the user out of the community
+func (h *Handler) LeaveHandler(r *http.Request) (handler.Response, error) {
+	return h.membershipChange(r, h.repo.Leave)
+}
+
+func (h *Handler) membershipChange(r *http.Request, change func(ctx context.Context, communityID uuid.UUID, u *ent.User) error) (handler.Response, error) {
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
+	if !isAuthenticated {
+		return handler.RedirectTo("/auth/login"), nil
+	}
+
+	comm, err := h.repo.GetBySlug(r.Context(), mux.Vars(r)["slug"])
+	if ent.IsNotFound(err) {
+		return handler.NotFound([]byte("Community not found")), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = change(r.Context(), comm.ID, user.User)
+	switch {
+	case errors.Is(err, community.ErrLastOwner), errors.Is(err, community.ErrNotMember):
+		return handler.BadInput([]byte(err.Error())), nil
+	case err != nil:
+		return nil, err
+	}
+	return handler.RedirectTo("/c/" + comm.Name), nil
+}
+
+// SettingsGetHandler shows the community's settings and members to its
+// owners
+func (h *Handler) SettingsGetHandler(r *http.Request) (handler.Response, error) {
+	comm, res, err := h.managed(r)
+	if res != nil || err != nil {
+		return res, err
+	}
+
+	content, err := h.renderSettings(r, SettingsData{
+		Community:      comm,
+		Title:          comm.Title,
+		Location:       comm.Location,
+		BannerImageURL: comm.BannerImageURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return handler.Ok(content), nil
+}
+
+// SettingsPostHandler saves the settings form
+func (h *Handler) SettingsPostHandler(r *http.Request) (handler.Response, error) {
+	comm, res, err := h.managed(r)
+	if res != nil || err != nil {
+		return res, err
+	}
+	user, _ := auth.RequireAuth(h.ab, r)
+
+	data := SettingsData{
+		Community:      comm,
+		Title:          r.FormValue("title"),
+		Location:       r.FormValue("location"),
+		BannerImageURL: r.FormValue("banner_image_url"),
+	}
+
+	_, err = h.repo.UpdateSettings(r.Context(), comm.ID, user.User, community.SettingsFields{
+		Title:          data.Title,
+		Location:       data.Location,
+		BannerImageURL: data.BannerImageURL,
+	})
+	if ent.IsValidationError(err) {
+		data.Error = "Community title must be between 5 and 128 characters"
+		content, err := h.renderSettings(r, data)
+		if err != nil {
+			return nil, err
+		}
+		return handler.BadInput(content), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return handler.RedirectTo("/c/" + comm.Name + "/settings"), nil
+}
+
+// RoleHandler changes a member's role from the settings page
+func (h *Handler) RoleHandler(r *http.Request) (handler.Response, error) {
+	comm, res, err := h.managed(r)
+	if res != nil || err != nil {
+		return res, err
+	}
+	user, _ := auth.RequireAuth(h.ab, r)
+
+	memberID, err := uuid.FromString(r.FormValue("user_id"))
+	if err != nil {
+		return handler.BadInput([]byte("Invalid user ID")), nil
+	}
+	role := membership.Role(r.FormValue("role"))
+	if membership.RoleValidator(role) != nil {
+		return handler.BadInput([]byte("Unknown role")), nil
+	}
+
+	err = h.repo.SetRole(r.Context(), comm.ID, user.User, memberID, role)
+	if errors.Is(err, community.ErrLastOwner) || errors.Is(err, community.ErrNotMember) {
+		content, renderErr := h.renderSettings(r, SettingsData{
+			Community:      comm,
+			Title:          comm.Title,
+			Location:       comm.Location,
+			BannerImageURL: comm.BannerImageURL,
+			Error:          err.Error(),
+		})
+		if renderErr != nil {
+			return nil, renderErr
+		}
+		return handler.BadInput(content), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return handler.RedirectTo("/c/" + comm.Name + "/settings"), nil
+}
+
+// managed loads the community in the URL for one of its owners, or gives
+// the response for anyone else
+func (h *Handler) managed(r *http.Request) (*ent.Community, handler.Response, error) {
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
+	if !isAuthenticated {
+		return nil, handler.RedirectTo("/auth/login"), nil
+	}
+
+	comm, err := h.repo.GetBySlug(r.Context(), mux.Vars(r)["slug"])
+	if ent.IsNotFound(err) {
+		return nil, handler.NotFound([]byte("Community not found")), nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allowed, err := h.repo.Can(r.Context(), comm.ID, user.User, community.PermissionManage)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !allowed {
+		return nil, handler.Forbidden([]byte(community.ErrForbidden.Error())), nil
+	}
+	return comm, nil, nil
+}
+
+func (h *Handler) renderSettings(r *http.Request, data SettingsData) ([]byte, error) {
+	members, err := h.repo.Members(r.Context(), data.Community.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		data.Members = append(data.Members, MemberView{
+			UserID:   m.Edges.User.ID,
+			Username: m.Edges.User.Username,
+			Role:     m.Role,
+		})
+	}
+	data.Roles = community.Roles
+	data.CSRFToken = server.CSRFToken(r.Context())
+
+	var content bytes.Buffer
+	if err := settingsTpl.Execute(&content, data); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return layouts.WithGeneral(layouts.LayoutData{
+		Title:     "Settings - " + data.Community.Title,
+		Content:   template.HTML(content.String()),
+		CSRFToken: data.CSRFToken,
+	})
+}
@@ -7,9 +7,12 @@ import (
 	"html/template"
 	"log"
 	"log/slog"
+	"math"
 	"net/http"
 	"os"
 	"runtime/debug"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -32,6 +35,7 @@ func getTemplates() *template.Template {
 type ErrorData struct {
 	Error     string
 	ShowError bool
+	Nonce     string
 }
 
 // Handle500 renders a 500 error page
@@ -42,6 +46,7 @@ func Handle500(w http.ResponseWriter, r *http.Request, err error) {
 
 	data := ErrorData{
 		ShowError: showError,
+		Nonce:     layouts.Nonce(r.Context()),
 	}
 
 	if err != nil && showError {
@@ -73,7 +78,7 @@ func Handle500(w http.ResponseWriter, r *http.Request, err error) {
 func Handle404(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotFound)
 
-	content, err := templatesExecute("404.gohtml", nil)
+	content, err := templatesExecute("404.gohtml", ErrorData{Nonce: layouts.Nonce(r.Context())})
 	if err != nil {
 		log.Printf("Error rendering 404 page: %v", err)
 		http.Error(w, "Not Found", http.StatusNotFound)
@@ -94,6 +99,64 @@ func Handle404(w http.ResponseWriter, r *http.Request) {
 	w.Write(html)
 }
 
+// Handle403 renders the page for requests that look forged, like a form
+// posted from another site
+func Handle403(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusForbidden)
+
+	content, err := templatesExecute("403.gohtml", nil)
+	if err != nil {
+		log.Printf("Error rendering 403 page: %v", err)
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	html, layoutErr := layouts.WithGeneral(layouts.LayoutData{
+		Title:   "Forbidden",
+		Content: template.HTML(content),
+	})
+	if layoutErr != nil {
+		log.Printf("Error applying layout to 403 page: %v", layoutErr)
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(html)
+}
+
+// Handle429 renders the page for clients that have made too many attempts,
+// telling them when to try again
+func Handle429(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	w.WriteHeader(http.StatusTooManyRequests)
+
+	wait := "a minute"
+	if minutes := (seconds + 59) / 60; minutes > 1 {
+		wait = fmt.Sprintf("%d minutes", minutes)
+	}
+	content, err := templatesExecute("429.gohtml", struct{ RetryAfter string }{wait})
+	if err != nil {
+		log.Printf("Error rendering 429 page: %v", err)
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+		return
+	}
+
+	html, layoutErr := layouts.WithGeneral(layouts.LayoutData{
+		Title:   "Too Many Attempts",
+		Content: template.HTML(content),
+	})
+	if layoutErr != nil {
+		log.Printf("Error applying layout to 429 page: %v", layoutErr)
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(html)
+}
+
 // PanicRecoveryMiddleware recovers from panics and renders the 500 error page
 func PanicRecoveryMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
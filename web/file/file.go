@@ -0,0 +1,85 @@
+package file
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/gorilla/mux"
+
+	"fixit/engine/ent"
+	entFile "fixit/engine/ent/file"
+	fileEngine "fixit/engine/file"
+	"fixit/web/handler"
+)
+
+// cacheControl lets browsers and proxies keep files forever, since a
+// file's ID never points at different content
+const cacheControl = "public, max-age=31536000, immutable"
+
+// URL links to a file, or to a derived size of it when its derived files
+// were loaded
+func URL(f *ent.File, variant entFile.Variant) string {
+	return "/files/" + fileEngine.Variant(f, variant).ID.String()
+}
+
+// AttachmentURL links to an attachment's file at the given size, falling
+// back to the original when the file wasn't loaded
+func AttachmentURL(a *ent.Attachment, variant entFile.Variant) string {
+	if a.Edges.File == nil {
+		return "/files/" + a.FileID.String()
+	}
+	return URL(a.Edges.File, variant)
+}
+
+type Handler struct {
+	fileRepo *fileEngine.Repository
+}
+
+func New(fileRepo *fileEngine.Repository) *Handler {
+	return &Handler{
+		fileRepo: fileRepo,
+	}
+}
+
+func (h *Handler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/files/{id}", handler.Wrap(h.ServeHandler)).Methods("GET", "HEAD")
+}
+
+// ServeHandler serves an uploaded file with its stored content type
+func (h *Handler) ServeHandler(r *http.Request) (handler.Response, error) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		return handler.BadInput([]byte("Invalid file ID")), nil
+	}
+
+	etag := `"` + id.String() + `"`
+	header := http.Header{
+		"Cache-Control": {cacheControl},
+		"Etag":          {etag},
+	}
+	if r.Header.Get("If-None-Match") == etag {
+		return &handler.ResponseBuffered{Status: http.StatusNotModified, Header: header}, nil
+	}
+
+	f, err := h.fileRepo.Get(r.Context(), id)
+	if ent.IsNotFound(err) {
+		return &handler.ResponseBuffered{Status: http.StatusNotFound, Content: []byte("File not found")}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.fileRepo.Data(r.Context(), f)
+	if err != nil {
+		return nil, err
+	}
+
+	header.Set("Content-Type", f.ContentType)
+	header.Set("Content-Length", strconv.FormatInt(f.Size, 10))
+	header.Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": f.Filename}))
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	return &handler.ResponseBuffered{Content: data, Header: header}, nil
+}
@@ -6,6 +6,8 @@ import (
 	_ "embed"
 	"html/template"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,9 +19,10 @@ import (
 	"fixit/engine/auth"
 	"fixit/engine/community"
 	"fixit/engine/config"
-	"fixit/engine/ent"
+	"fixit/engine/geo"
 	"fixit/web/handler"
 	"fixit/web/layouts"
+	"fixit/web/server"
 )
 
 //go:embed templates/frontpage.gohtml
@@ -39,6 +42,9 @@ var templateFuncs = template.FuncMap{
 	"humanizeTime": func(t time.Time) string {
 		return humanize.Time(t)
 	},
+	"radiusOptions": func() []string {
+		return []string{"5", "10", "25", "50", "100"}
+	},
 }
 
 var frontpageTpl = template.Must(template.New("frontpage").Funcs(templateFuncs).Parse(frontpageTplS))
@@ -49,10 +55,18 @@ type Handler struct {
 }
 
 type FrontpageData struct {
-	AppName       string
-	Communities   []*ent.Community
-	IsLoggedIn    bool
-	Username      string
+	AppName     string
+	Communities []*community.FrontpageCommunity
+	IsLoggedIn  bool
+	Username    string
+	// Near is set when listing communities near the lat/lng parameters
+	Near      bool
+	Latitude  string
+	Longitude string
+	Radius    string
+	NearError string
+	CSRFToken string
+	Nonce     string
 }
 
 func New(communityRepo *community.Repository, ab *authboss.Authboss) *Handler {
@@ -72,30 +86,70 @@ func (h *Handler) FrontpageHandler(r *http.Request) (handler.Response, error) {
 		username = user.GetUsername()
 	}
 
+	query := r.URL.Query()
+	data := FrontpageData{
+		AppName:    config.AppName,
+		IsLoggedIn: isLoggedIn,
+		Username:   username,
+		Latitude:   query.Get("lat"),
+		Longitude:  query.Get("lng"),
+		Radius:     query.Get("radius"),
+		CSRFToken:  server.CSRFToken(r.Context()),
+		Nonce:      layouts.Nonce(r.Context()),
+	}
+
 	// Get location from query parameter if provided
-	location := r.URL.Query().Get("location")
 	filter := community.Filter{
-		Location: location,
+		Location: query.Get("location"),
+	}
+
+	status := http.StatusOK
+	if data.Latitude != "" || data.Longitude != "" || data.Radius != "" {
+		if err := parseNear(query, &filter); err != nil {
+			// Show every community alongside the error rather than none
+			data.NearError = err.Error()
+			status = http.StatusBadRequest
+		} else {
+			data.Near = true
+			data.Radius = strconv.FormatFloat(filter.RadiusKm, 'f', -1, 64)
+		}
+	}
+	if data.Radius == "" {
+		data.Radius = strconv.FormatFloat(community.DefaultRadiusKm, 'f', -1, 64)
 	}
 
 	communities, err := h.communityRepo.ForFrontpage(ctx, filter)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-
-	data := FrontpageData{
-		AppName:     config.AppName,
-		Communities: communities,
-		IsLoggedIn:  isLoggedIn,
-		Username:    username,
-	}
+	data.Communities = communities
 
 	content, err := renderFrontpage(data)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	return handler.Ok(content), nil
+	return &handler.ResponseBuffered{Status: status, Content: content}, nil
+}
+
+// parseNear reads the lat, lng and optional radius (in km) parameters
+func parseNear(query url.Values, filter *community.Filter) error {
+	p, err := geo.ParsePoint(query.Get("lat"), query.Get("lng"))
+	if err != nil {
+		return err
+	}
+
+	radius := community.DefaultRadiusKm
+	if raw := query.Get("radius"); raw != "" {
+		radius, err = strconv.ParseFloat(raw, 64)
+		if err != nil || !(radius > 0 && radius <= community.MaxRadiusKm) {
+			return community.ErrInvalidRadius
+		}
+	}
+
+	filter.Near = &p
+	filter.RadiusKm = radius
+	return nil
 }
 
 func renderFrontpage(data FrontpageData) ([]byte, error) {
@@ -106,8 +160,9 @@ func renderFrontpage(data FrontpageData) ([]byte, error) {
 	}
 
 	layoutData := layouts.LayoutData{
-		Title:   data.AppName + " - Community Issue Tracker",
-		Content: template.HTML(content.String()),
+		Title:     data.AppName + " - Community Issue Tracker",
+		Content:   template.HTML(content.String()),
+		CSRFToken: data.CSRFToken,
 	}
 
 	return layouts.WithGeneral(layoutData)
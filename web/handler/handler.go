@@ -34,6 +34,16 @@ func Wrap(fn Fn) func(http.ResponseWriter,
 
 		switch res := resI.(type) {
 		case *ResponseBuffered:
+			if res.Session != nil {
+				if err := res.Session.Save(request, writer); err != nil {
+					slog.Error("error saving session", "err", err)
+					errors.Handle500(writer, request, err)
+					return
+				}
+			}
+			for key, values := range res.Header {
+				writer.Header()[key] = values
+			}
 			st := res.Status
 			if st == 0 {
 				st = 200
@@ -70,6 +80,11 @@ type ResponseBuffered struct {
 	Content []byte
 	// Status defaults to 200
 	Status int
+	// Session is saved before writing when set, e.g. to clear read flashes
+	Session *sessions.Session
+	// Header is added to the response. Content-Type is sniffed from
+	// Content when not set.
+	Header http.Header
 }
 
 func (r ResponseBuffered) isResponse() {}
@@ -117,6 +132,13 @@ func NotFound(content []byte) Response {
 	}
 }
 
+func Forbidden(content []byte) Response {
+	return &ResponseBuffered{
+		Status:  403,
+		Content: content,
+	}
+}
+
 func Ok(content []byte) Response {
 	return &ResponseBuffered{
 		Status:  200,
@@ -0,0 +1,99 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/factory"
+)
+
+func TestAcceptSolution(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	community := factory.Community(t, dbClient, "accept-community-*")
+
+	author, _ := newRegisteredClient(t, "author")
+	solver, _ := newRegisteredClient(t, "solver")
+
+	issueTitle := "Broken bench " + strconv.FormatInt(time.Now().UnixNano(), 10)
+	issueID := createPostAs(t, author, map[string]string{
+		"title":     issueTitle,
+		"community": community.Name,
+	})
+
+	firstID := createPostAs(t, solver, map[string]string{
+		"title":       "Glue it back together",
+		"community":   community.Name,
+		"reply_to_id": issueID,
+		"post_type":   "solution",
+	})
+	secondID := createPostAs(t, solver, map[string]string{
+		"title":       "Replace the bench",
+		"community":   community.Name,
+		"reply_to_id": issueID,
+		"post_type":   "solution",
+	})
+
+	t.Run("Only the issue author sees the accept button", func(t *testing.T) {
+		resp, err := solver.Get(testServer.URL + "/p/" + issueID)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.NotContains(t, readResponseBody(t, resp), "/api/post/"+firstID+"/accept")
+
+		resp, err = author.Get(testServer.URL + "/p/" + issueID)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Contains(t, readResponseBody(t, resp), "/api/post/"+firstID+"/accept")
+	})
+
+	t.Run("Other users cannot accept", func(t *testing.T) {
+		resp, err := solver.Post(testServer.URL+"/api/post/"+firstID+"/accept", "", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("Only solutions that exist can be accepted", func(t *testing.T) {
+		resp, err := author.Post(testServer.URL+"/api/post/"+issueID+"/accept", "", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, err = author.Post(testServer.URL+"/api/post/00000000-0000-0000-0000-000000000000/accept", "", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Accepting marks the issue solved", func(t *testing.T) {
+		resp, err := author.Post(testServer.URL+"/api/post/"+firstID+"/accept", "", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/p/"+issueID, resp.Header.Get("Location"))
+
+		listResp, err := http.Get(testServer.URL + "/c/" + community.Name)
+		require.NoError(t, err)
+		defer listResp.Body.Close()
+		assert.Contains(t, readResponseBody(t, listResp), "✓ Solved")
+	})
+
+	t.Run("Accepting another solution moves the mark", func(t *testing.T) {
+		resp, err := author.Post(testServer.URL+"/api/post/"+secondID+"/accept", "", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+
+		issue, err := dbClient.Post.Get(context.Background(), uuidFromString(t, issueID))
+		require.NoError(t, err)
+		require.NotNil(t, issue.AcceptedSolutionID)
+		assert.Equal(t, secondID, issue.AcceptedSolutionID.String())
+	})
+}
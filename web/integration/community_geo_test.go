@@ -0,0 +1,113 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/ent/community"
+	"fixit/engine/factory"
+)
+
+func TestCreateCommunityWithLocation(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	client, _ := newRegisteredClient(t, "geo-user-")
+
+	t.Run("Malformed coordinates are rejected with a form error", func(t *testing.T) {
+		name := factory.Placeholder("geo-bad-*")
+		resp, err := postMultipartForm(client, testServer.URL+"/api/community/create", map[string]string{
+			"name":      name,
+			"title":     "Badly placed community",
+			"latitude":  "51.5558",
+			"longitude": "west a bit",
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/community/new", resp.Header.Get("Location"))
+
+		resp, err = client.Get(testServer.URL + "/community/new")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body := readResponseBody(t, resp)
+		assert.Contains(t, body, "Invalid location")
+		assert.Contains(t, body, name, "form should be refilled")
+
+		exists, err := dbClient.Community.Query().Where(community.NameEQ(name)).Exist(context.Background())
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Valid coordinates are stored as a point", func(t *testing.T) {
+		name := factory.Placeholder("geo-good-*")
+		resp, err := postMultipartForm(client, testServer.URL+"/api/community/create", map[string]string{
+			"name":      name,
+			"title":     "Well placed community",
+			"latitude":  "-47.5",
+			"longitude": "-120.25",
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/c/"+name, resp.Header.Get("Location"))
+
+		comm, err := dbClient.Community.Query().Where(community.NameEQ(name)).Only(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "POINT(-120.25 -47.5)", comm.Geography)
+	})
+}
+
+func TestFrontpageNearMe(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	near, err := dbClient.Community.Create().
+		SetName(factory.Placeholder("near-me-*")).
+		SetTitle("Community near me").
+		SetGeography("POINT(-125.01 -45.01)").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	far, err := dbClient.Community.Create().
+		SetName(factory.Placeholder("far-away-*")).
+		SetTitle("Community far away").
+		SetGeography("POINT(-125 -40)").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	t.Run("Lists nearby communities with their distance", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + "/?" + url.Values{"lat": {"-45"}, "lng": {"-125"}, "radius": {"10"}}.Encode())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		body := readResponseBody(t, resp)
+		assert.Contains(t, body, "Communities near you")
+		assert.Contains(t, body, "/c/"+near.Name+" · 1.4 km away")
+		assert.NotContains(t, body, far.Name)
+	})
+
+	t.Run("Malformed coordinates show an error", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + "/?" + url.Values{"lat": {"north"}, "lng": {"-125"}}.Encode())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, readResponseBody(t, resp), "coordinates must be a latitude")
+	})
+
+	t.Run("Out of range radius shows an error", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + "/?" + url.Values{"lat": {"-45"}, "lng": {"-125"}, "radius": {"-1"}}.Encode())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, readResponseBody(t, resp), "radius must be")
+	})
+}
@@ -0,0 +1,103 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/ent/user"
+	"fixit/engine/factory"
+)
+
+func TestEmailConfirmation(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+	ctx := context.Background()
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Jar: jar,
+	}
+
+	username := factory.Placeholder("confirm-user-*")
+	email := username + "@test.com"
+	password := "ValidPassword123!"
+
+	logIn := func() *http.Response {
+		resp, err := client.PostForm(testServer.URL+"/auth/login", url.Values{
+			"email":    {email},
+			"password": {password},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+	loginPage := func() string {
+		resp, err := client.Get(testServer.URL + "/auth/login")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return readResponseBody(t, resp)
+	}
+
+	t.Run("Registering asks for confirmation instead of logging in", func(t *testing.T) {
+		resp, err := client.PostForm(testServer.URL+"/auth/register", url.Values{
+			"username":         {username},
+			"email":            {email},
+			"password":         {password},
+			"confirm_password": {password},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/auth/login", resp.Header.Get("Location"))
+		assert.Contains(t, loginPage(), "Please verify your account, an e-mail has been sent to you.")
+
+		registered, err := dbClient.User.Query().Where(user.EmailEQ(email)).Only(ctx)
+		require.NoError(t, err)
+		assert.False(t, registered.Confirmed)
+		assert.NotEmpty(t, registered.ConfirmSelector)
+		assert.NotEmpty(t, registered.ConfirmVerifier)
+		assert.Contains(t, lastEmailTo(t, email), "Please confirm your email address")
+	})
+
+	t.Run("Unconfirmed users can't log in", func(t *testing.T) {
+		resp := logIn()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/auth/login", resp.Header.Get("Location"))
+		assert.Contains(t, loginPage(), "Your account has not been confirmed, please check your e-mail.")
+	})
+
+	t.Run("Invalid tokens are rejected", func(t *testing.T) {
+		resp, err := client.Get(testServer.URL + "/auth/confirm?cnf=not-a-token")
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Contains(t, loginPage(), "Your confirmation token is invalid.")
+	})
+
+	t.Run("Following the emailed link confirms the account", func(t *testing.T) {
+		resp, err := client.Get(testServer.URL + linkInEmail(t, email, "/auth/confirm"))
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Contains(t, loginPage(), "You have successfully confirmed your account.")
+
+		confirmed, err := dbClient.User.Query().Where(user.EmailEQ(email)).Only(ctx)
+		require.NoError(t, err)
+		assert.True(t, confirmed.Confirmed)
+		assert.Empty(t, confirmed.ConfirmSelector)
+
+		resp = logIn()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/", resp.Header.Get("Location"))
+	})
+}
@@ -0,0 +1,153 @@
+package integration
+
+import (
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/factory"
+	"fixit/web/server"
+)
+
+var (
+	csrfMetaTag   = regexp.MustCompile(`<meta name="csrf-token" content="([^"]+)">`)
+	csrfFormField = regexp.MustCompile(`name="csrf_token" value="([^"]+)"`)
+)
+
+// rawTransport sends requests as they are, without csrfTransport's token
+var rawTransport http.RoundTripper
+
+// csrfTransport posts to the app like a browser would after loading a page,
+// with the token from the page and the cookie holding its secret. Tests use
+// it through http.DefaultTransport, so they needn't fetch tokens themselves.
+type csrfTransport struct {
+	next http.RoundTripper
+}
+
+func (t *csrfTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	app, err := url.Parse(testServer.URL)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if req.Method == http.MethodGet || req.Method == http.MethodHead ||
+		req.URL.Host != app.Host || req.Header.Get(server.CSRFHeader) != "" {
+		return t.next.RoundTrip(req)
+	}
+
+	page, err := http.NewRequestWithContext(req.Context(), http.MethodGet, testServer.URL+"/search", nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, cookie := range req.Cookies() {
+		page.AddCookie(cookie)
+	}
+	resp, err := t.next.RoundTrip(page)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	match := csrfMetaTag.FindSubmatch(body)
+	if match == nil {
+		return nil, errors.Errorf("no CSRF token on %s", page.URL)
+	}
+
+	// The page may have set the secret's cookie, which the jar never sees
+	cookies := map[string]*http.Cookie{}
+	for _, cookie := range req.Cookies() {
+		cookies[cookie.Name] = cookie
+	}
+	for _, cookie := range resp.Cookies() {
+		cookies[cookie.Name] = cookie
+	}
+	req = req.Clone(req.Context())
+	req.Header.Del("Cookie")
+	for _, cookie := range cookies {
+		req.AddCookie(&http.Cookie{Name: cookie.Name, Value: cookie.Value})
+	}
+	req.Header.Set(server.CSRFHeader, string(match[1]))
+	return t.next.RoundTrip(req)
+}
+
+func TestCSRF(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+	u := factory.User(t, dbClient, "csrf-user-*")
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	browser := &http.Client{
+		Transport: rawTransport,
+		Jar:       jar,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	loginPage := func() string {
+		resp, err := browser.Get(testServer.URL + "/auth/login")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return readResponseBody(t, resp)
+	}
+	post := func(path string, form url.Values, header http.Header) *http.Response {
+		req, err := http.NewRequest("POST", testServer.URL+path, strings.NewReader(form.Encode()))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		for key := range header {
+			req.Header.Set(key, header.Get(key))
+		}
+		resp, err := browser.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+	login := url.Values{"email": {u.Email}, "password": {factory.Password}}
+
+	t.Run("Forms without the token are refused", func(t *testing.T) {
+		resp := post("/auth/login", login, nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp = post("/api/community/create", url.Values{"name": {"forged"}, "title": {"Forged"}}, nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("Forms from another site are refused", func(t *testing.T) {
+		match := csrfFormField.FindStringSubmatch(loginPage())
+		require.NotNil(t, match)
+		form := url.Values{"csrf_token": {match[1]}}
+		for key, values := range login {
+			form[key] = values
+		}
+
+		resp := post("/auth/login", form, http.Header{"Origin": {"https://evil.example"}})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		// Test: The same form from this site works
+		resp = post("/auth/login", form, nil)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/", resp.Header.Get("Location"))
+	})
+
+	t.Run("Scripts can send the token in a header", func(t *testing.T) {
+		resp, err := browser.Get(testServer.URL + "/account/sessions")
+		require.NoError(t, err)
+		match := csrfMetaTag.FindStringSubmatch(readResponseBody(t, resp))
+		resp.Body.Close()
+		require.NotNil(t, match)
+
+		resp = post("/auth/logout", nil, http.Header{server.CSRFHeader: {match[1]}})
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/auth/login", resp.Header.Get("Location"))
+	})
+}
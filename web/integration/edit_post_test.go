@@ -0,0 +1,107 @@
+package integration
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditAndDeletePost(t *testing.T) {
+	author, _ := newRegisteredClient(t, "edit-author-")
+	other, _ := newRegisteredClient(t, "edit-other-")
+
+	issueID := createPostAs(t, author, map[string]string{
+		"title":     "Broken bench in the park",
+		"body":      "The slats are split.",
+		"community": "swindon",
+	})
+	solutionID := createPostAs(t, other, map[string]string{
+		"title":       "I've told the parks team",
+		"community":   "swindon",
+		"reply_to_id": issueID,
+		"post_type":   "solution",
+	})
+
+	get := func(t *testing.T, client *http.Client, path string) (int, string) {
+		resp, err := client.Get(testServer.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode, readResponseBody(t, resp)
+	}
+	post := func(t *testing.T, client *http.Client, path string, form url.Values) (int, string) {
+		resp, err := client.PostForm(testServer.URL+path, form)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode, readResponseBody(t, resp)
+	}
+
+	t.Run("Only the author can edit", func(t *testing.T) {
+		status, _ := get(t, other, "/p/"+issueID+"/edit")
+		assert.Equal(t, http.StatusForbidden, status)
+		status, _ = post(t, other, "/api/post/"+issueID+"/edit", url.Values{"title": {"Someone else's bench"}})
+		assert.Equal(t, http.StatusForbidden, status)
+
+		status, body := get(t, other, "/p/"+issueID)
+		assert.Equal(t, http.StatusOK, status)
+		assert.NotContains(t, body, "/p/"+issueID+"/edit")
+	})
+
+	t.Run("Edits show in the history", func(t *testing.T) {
+		status, body := get(t, author, "/p/"+issueID+"/edit")
+		require.Equal(t, http.StatusOK, status)
+		assert.Contains(t, body, "The slats are split.")
+		assert.Regexp(t, `<script nonce="[^"]+" src="/static/preview.js">`, body, "the preview script needs the page's nonce")
+
+		status, body = post(t, author, "/api/post/"+issueID+"/edit", url.Values{"title": {"Bad"}})
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Contains(t, body, "Title must be between 5 and 128 characters")
+
+		status, _ = post(t, author, "/api/post/"+issueID+"/edit", url.Values{
+			"title": {"Broken bench in the park"},
+			"body":  {"The slats are split and there's a nail sticking out."},
+		})
+		require.Equal(t, http.StatusFound, status)
+
+		_, body = get(t, author, "/p/"+issueID)
+		assert.Contains(t, body, "there&#39;s a nail sticking out")
+		assert.Contains(t, body, "/p/"+issueID+"/history")
+
+		status, body = get(t, other, "/p/"+issueID+"/history")
+		require.Equal(t, http.StatusOK, status)
+		assert.Contains(t, body, `<meta name="csrf-token"`)
+		assert.Contains(t, body, "diff-delete\">- The slats are split.</span>")
+		assert.Contains(t, body, "diff-insert\">+ The slats are split and there&#39;s a nail sticking out.</span>")
+	})
+
+	t.Run("Deleted posts leave their replies readable", func(t *testing.T) {
+		status, _ := post(t, other, "/api/post/"+issueID+"/delete", nil)
+		assert.Equal(t, http.StatusForbidden, status)
+
+		status, _ = post(t, author, "/api/post/"+issueID+"/delete", nil)
+		require.Equal(t, http.StatusFound, status)
+
+		status, body := get(t, other, "/p/"+issueID)
+		require.Equal(t, http.StatusOK, status)
+		assert.Contains(t, body, "This post was deleted")
+		assert.NotContains(t, body, "Broken bench in the park")
+		assert.NotContains(t, body, "nail sticking out")
+		assert.Contains(t, body, "I&#39;ve told the parks team")
+		assert.NotContains(t, body, "post_type=chat")
+
+		status, _ = get(t, other, "/p/"+issueID+"/history")
+		assert.Equal(t, http.StatusNotFound, status)
+		status, _ = post(t, author, "/api/post/"+issueID+"/edit", url.Values{"title": {"Bench is back"}})
+		assert.Equal(t, http.StatusNotFound, status)
+		status, _ = post(t, author, "/api/post/"+issueID+"/delete", nil)
+		assert.Equal(t, http.StatusNotFound, status)
+
+		_, body = get(t, other, "/c/swindon")
+		assert.NotContains(t, body, "Broken bench in the park")
+
+		status, _ = get(t, other, "/p/"+solutionID)
+		assert.Equal(t, http.StatusOK, status)
+	})
+}
@@ -0,0 +1,180 @@
+package integration
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// fakeOIDCClaims are who the fake provider says the next user to log in is
+type fakeOIDCClaims struct {
+	Subject           string `json:"sub"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Name              string `json:"name,omitempty"`
+}
+
+// fakeOIDC is an OpenID Connect provider that logs in whoever logsInAs says,
+// without asking
+type fakeOIDC struct {
+	*httptest.Server
+	clientID     string
+	clientSecret string
+	key          *rsa.PrivateKey
+
+	mu    sync.Mutex
+	next  fakeOIDCClaims
+	codes map[string]fakeOIDCCode
+}
+
+type fakeOIDCCode struct {
+	claims    fakeOIDCClaims
+	nonce     string
+	challenge string
+}
+
+func newFakeOIDC(clientID, clientSecret string) *fakeOIDC {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	f := &fakeOIDC{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		key:          key,
+		codes:        map[string]fakeOIDCCode{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", f.discovery)
+	mux.HandleFunc("GET /authorize", f.authorize)
+	mux.HandleFunc("POST /token", f.token)
+	mux.HandleFunc("GET /jwks", f.jwks)
+	f.Server = httptest.NewServer(mux)
+	return f
+}
+
+// logsInAs sets who the next authorization is for
+func (f *fakeOIDC) logsInAs(claims fakeOIDCClaims) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.next = claims
+}
+
+func (f *fakeOIDC) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]any{
+		"issuer":                                f.URL,
+		"authorization_endpoint":                f.URL + "/authorize",
+		"token_endpoint":                        f.URL + "/token",
+		"jwks_uri":                              f.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (f *fakeOIDC) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || q.Get("client_id") != f.clientID || q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "bad authorization request", http.StatusBadRequest)
+		return
+	}
+
+	code := randomString()
+	f.mu.Lock()
+	f.codes[code] = fakeOIDCCode{claims: f.next, nonce: q.Get("nonce"), challenge: q.Get("code_challenge")}
+	f.mu.Unlock()
+
+	params := redirect.Query()
+	params.Set("code", code)
+	params.Set("state", q.Get("state"))
+	redirect.RawQuery = params.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (f *fakeOIDC) token(w http.ResponseWriter, r *http.Request) {
+	id, secret, ok := r.BasicAuth()
+	if !ok {
+		id, secret = r.PostFormValue("client_id"), r.PostFormValue("client_secret")
+	}
+	if id != f.clientID || secret != f.clientSecret {
+		http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+		return
+	}
+
+	f.mu.Lock()
+	code, ok := f.codes[r.PostFormValue("code")]
+	delete(f.codes, r.PostFormValue("code"))
+	f.mu.Unlock()
+	sum := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != code.challenge {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	claims := map[string]any{
+		"iss":   f.URL,
+		"aud":   f.clientID,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+		"nonce": code.nonce,
+	}
+	// Merge in the user's claims
+	raw, _ := json.Marshal(code.claims)
+	_ = json.Unmarshal(raw, &claims)
+
+	writeJSON(w, map[string]any{
+		"access_token": randomString(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     f.sign(claims),
+	})
+}
+
+func (f *fakeOIDC) jwks(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "fake",
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(f.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(f.key.E)).Bytes()),
+		}},
+	})
+}
+
+// sign makes an RS256 JWT of the claims
+func (f *fakeOIDC) sign(claims map[string]any) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "fake", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	sum := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, f.key, crypto.SHA256, sum[:])
+	if err != nil {
+		panic(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func randomString() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
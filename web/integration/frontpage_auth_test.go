@@ -60,6 +60,9 @@ func TestFrontpageAuthDisplay(t *testing.T) {
 		// Should redirect after successful registration
 		assert.Equal(t, http.StatusFound, registerResp.StatusCode)
 
+		// Registering sends a confirmation email instead of logging in
+		confirmAndLogIn(t, client, email, password)
+
 		// Now visit the frontpage as authenticated user
 		frontpageResp, err := client.Get(testServer.URL + "/")
 		require.NoError(t, err)
@@ -0,0 +1,189 @@
+package integration
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/http/cookiejar"
+	netmail "net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// newRegisteredClient signs up a fresh user, confirms them and returns a
+// client holding their session, which doesn't follow redirects so they can be
+// asserted on
+func newRegisteredClient(t *testing.T, usernamePrefix string) (*http.Client, string) {
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Jar: jar,
+	}
+
+	username := usernamePrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
+	password := "ValidPassword123!"
+	resp, err := client.PostForm(testServer.URL+"/auth/register", url.Values{
+		"username":         {username},
+		"email":            {username + "@test.com"},
+		"password":         {password},
+		"confirm_password": {password},
+	})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	confirmAndLogIn(t, client, username+"@test.com", password)
+	return client, username
+}
+
+// confirmAndLogIn follows the link in a newly registered user's confirmation
+// email, then logs the client in
+func confirmAndLogIn(t *testing.T, client *http.Client, email, password string) {
+	resp, err := client.Get(testServer.URL + linkInEmail(t, email, "/auth/confirm"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	resp, err = client.PostForm(testServer.URL+"/auth/login", url.Values{
+		"email":    {email},
+		"password": {password},
+	})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	require.Equal(t, "/", resp.Header.Get("Location"))
+}
+
+// linkInEmail returns the path and query of the link to path in the newest
+// email sent to the address
+func linkInEmail(t *testing.T, address, path string) string {
+	pattern := regexp.MustCompile(`https?://\S+` + regexp.QuoteMeta(path) + `\?\S+`)
+	link := pattern.FindString(lastEmailTo(t, address))
+	require.NotEmpty(t, link, "no link to %s in the email to %s", path, address)
+	u, err := url.Parse(link)
+	require.NoError(t, err)
+	return u.RequestURI()
+}
+
+// lastEmailTo returns the plain text body of the newest email sent to the
+// address, read from the .eml files written by the file mail transport
+func lastEmailTo(t *testing.T, address string) string {
+	entries, err := os.ReadDir(mailDir)
+	require.NoError(t, err)
+
+	// Files are named by the time they were sent, so the newest is last
+	for i := len(entries) - 1; i >= 0; i-- {
+		name := entries[i].Name()
+		if strings.HasPrefix(name, ".") || !strings.HasSuffix(name, ".eml") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(mailDir, name))
+		require.NoError(t, err)
+		msg, err := netmail.ReadMessage(bytes.NewReader(data))
+		require.NoError(t, err)
+		to, err := msg.Header.AddressList("To")
+		require.NoError(t, err)
+		for _, a := range to {
+			if strings.EqualFold(a.Address, address) {
+				return textBody(t, msg)
+			}
+		}
+	}
+	require.Failf(t, "no email", "nothing was sent to %s", address)
+	return ""
+}
+
+// textBody decodes the plain text part of an email
+func textBody(t *testing.T, msg *netmail.Message) string {
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		// Parts are decoded from quoted-printable as they're read
+		parts := multipart.NewReader(msg.Body, params["boundary"])
+		for {
+			part, err := parts.NextPart()
+			require.NoError(t, err, "no plain text part")
+			if strings.HasPrefix(part.Header.Get("Content-Type"), "text/plain") {
+				body, err := io.ReadAll(part)
+				require.NoError(t, err)
+				return string(body)
+			}
+		}
+	}
+
+	var body io.Reader = msg.Body
+	if strings.EqualFold(msg.Header.Get("Content-Transfer-Encoding"), "quoted-printable") {
+		body = quotedprintable.NewReader(body)
+	}
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	return string(data)
+}
+
+// createPostAs submits the create post form and returns the new post's ID
+func createPostAs(t *testing.T, client *http.Client, fields map[string]string) string {
+	resp, err := postMultipartForm(client, testServer.URL+"/api/post/create", fields)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode, readResponseBody(t, resp))
+
+	parts := strings.Split(resp.Header.Get("Location"), "posted_id=")
+	require.Len(t, parts, 2)
+	return parts[1]
+}
+
+func uuidFromString(t *testing.T, s string) uuid.UUID {
+	id, err := uuid.FromString(s)
+	require.NoError(t, err)
+	return id
+}
+
+// postMultipartWithFiles sends a multipart form with file parts, keyed by
+// field name, alongside the plain fields
+func postMultipartWithFiles(client *http.Client, url string, fields map[string]string, files map[string][]byte) (*http.Response, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	for field, value := range fields {
+		if err := w.WriteField(field, value); err != nil {
+			return nil, err
+		}
+	}
+	for field, data := range files {
+		fw, err := w.CreateFormFile(field, field+".png")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := fw.Write(data); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, &buf)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	return client.Do(req)
+}
@@ -0,0 +1,97 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/factory"
+	"fixit/engine/geo"
+)
+
+func TestIssueLocationAndMap(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	comm, err := dbClient.Community.Create().
+		SetName(factory.Placeholder("map-*")).
+		SetTitle("Mapped community").
+		SetGeography("POINT(-1.7797 51.5558)").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	client, _ := newRegisteredClient(t, "map-user-")
+
+	postID := createPostAs(t, client, map[string]string{
+		"title":     "Fly-tipping on Regent Street",
+		"community": comm.Name,
+		"latitude":  "51.5590",
+		"longitude": "-1.7850",
+		"address":   "Regent Street",
+	})
+
+	t.Run("Issue shows its address", func(t *testing.T) {
+		resp, err := client.Get(testServer.URL + "/p/" + postID)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body := readResponseBody(t, resp)
+		assert.Contains(t, body, "Regent Street")
+		assert.Contains(t, body, "View on map")
+	})
+
+	t.Run("Map lists the community's open issues as GeoJSON", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + "/c/" + comm.Name + "/map.geojson")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/geo+json", resp.Header.Get("Content-Type"))
+
+		var fc geo.FeatureCollection
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&fc))
+		assert.Equal(t, "FeatureCollection", fc.Type)
+		require.Len(t, fc.Features, 1)
+		assert.Equal(t, postID, fc.Features[0].ID)
+		assert.Equal(t, [2]float64{-1.785, 51.559}, fc.Features[0].Geometry.Coordinates)
+	})
+
+	t.Run("Points outside the community are rejected", func(t *testing.T) {
+		resp, err := postMultipartForm(client, testServer.URL+"/api/post/create", map[string]string{
+			"title":     "Pothole in Bristol",
+			"community": comm.Name,
+			"latitude":  "51.4545",
+			"longitude": "-2.5879",
+		})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, readResponseBody(t, resp), "outside the community")
+	})
+
+	t.Run("Malformed coordinates are rejected", func(t *testing.T) {
+		resp, err := postMultipartForm(client, testServer.URL+"/api/post/create", map[string]string{
+			"title":     "Pothole somewhere",
+			"community": comm.Name,
+			"latitude":  "51.5",
+		})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, readResponseBody(t, resp), "Invalid location")
+	})
+
+	t.Run("Unknown community has no map", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + "/c/no-such-community/map.geojson")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
@@ -0,0 +1,71 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/ent/post"
+	"fixit/engine/factory"
+)
+
+func TestCloseAndReopenIssue(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	community := factory.Community(t, dbClient, "status-community-*")
+
+	author, _ := newRegisteredClient(t, "status-author")
+	other, _ := newRegisteredClient(t, "status-other")
+
+	issueID := createPostAs(t, author, map[string]string{
+		"title":     "Overflowing bin",
+		"community": community.Name,
+	})
+	closeURL := testServer.URL + "/api/post/" + issueID + "/close"
+	reopenURL := testServer.URL + "/api/post/" + issueID + "/reopen"
+
+	t.Run("Other users cannot close", func(t *testing.T) {
+		resp, err := other.PostForm(closeURL, url.Values{"reason": {"Looks fine to me"}})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("A reason is required", func(t *testing.T) {
+		resp, err := author.PostForm(closeURL, url.Values{"reason": {""}})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Closing shows in the history", func(t *testing.T) {
+		resp, err := author.PostForm(closeURL, url.Values{"reason": {"Bin was emptied"}})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/p/"+issueID, resp.Header.Get("Location"))
+
+		showResp, err := author.Get(testServer.URL + "/p/" + issueID)
+		require.NoError(t, err)
+		defer showResp.Body.Close()
+		body := readResponseBody(t, showResp)
+		assert.Contains(t, body, "Bin was emptied")
+		assert.Contains(t, body, `action="/api/post/`+issueID+`/reopen"`)
+	})
+
+	t.Run("Reopening puts the issue back to open", func(t *testing.T) {
+		resp, err := author.PostForm(reopenURL, url.Values{"reason": {"Full again"}})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+
+		issue, err := dbClient.Post.Get(context.Background(), uuidFromString(t, issueID))
+		require.NoError(t, err)
+		assert.Equal(t, post.StatusOpen, issue.Status)
+	})
+}
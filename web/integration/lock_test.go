@@ -0,0 +1,71 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/factory"
+)
+
+func TestAccountLockout(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+	ctx := context.Background()
+
+	u := factory.User(t, dbClient, "locked-user-*")
+	browser := newSessionClient(t, testServer.URL)
+	logIn := func(password string) *http.Response {
+		return browser.post("/auth/login", url.Values{"email": {u.Email}, "password": {password}})
+	}
+
+	// The test config keeps authboss' default of locking after 3 failures
+	assert.Equal(t, http.StatusOK, logIn("wrong").StatusCode)
+	assert.Equal(t, http.StatusOK, logIn("wrong").StatusCode)
+	resp := logIn("wrong")
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, "/auth/locked", resp.Header.Get("Location"))
+
+	locked, err := dbClient.User.Get(ctx, u.ID)
+	require.NoError(t, err)
+	require.NotNil(t, locked.LockedUntil)
+
+	t.Run("Locked out users are told why and how to get back in", func(t *testing.T) {
+		resp, err := browser.http.Get(testServer.URL + "/auth/locked")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body := readResponseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, body, "<title>Account Locked - FixIt</title>")
+		assert.Contains(t, body, "for 12 hours")
+		assert.Contains(t, body, `href="/auth/recover"`)
+	})
+
+	t.Run("The right password doesn't work while locked", func(t *testing.T) {
+		resp := logIn(factory.Password)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/auth/locked", resp.Header.Get("Location"))
+		assert.Empty(t, browser.sessionsPage())
+	})
+
+	t.Run("Resetting the password unlocks the account", func(t *testing.T) {
+		browser.post("/auth/recover", url.Values{"email": {u.Email}})
+		link, err := url.Parse(linkInEmail(t, u.Email, "/auth/recover/end"))
+		require.NoError(t, err)
+		newPassword := "NewPassword456!"
+		resp := browser.post("/auth/recover/end", url.Values{
+			"token":            {link.Query().Get("token")},
+			"password":         {newPassword},
+			"confirm_password": {newPassword},
+		})
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+
+		resp = logIn(newPassword)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/", resp.Header.Get("Location"))
+	})
+}
@@ -1,13 +1,21 @@
 package integration
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
 
 	"fixit/engine/auth"
+	"fixit/engine/community"
 	"fixit/engine/config"
+	"fixit/engine/ent"
+	"fixit/engine/mail"
+	"fixit/engine/migration"
+	"fixit/engine/user"
 	"fixit/web/app"
 )
 
@@ -21,19 +29,53 @@ func TestMain(m *testing.M) {
 var testServer *httptest.Server
 var testConfig app.Config
 
+// fakeProvider is the OpenID Connect provider the app offers logging in with
+var fakeProvider *fakeOIDC
+
+// mailDir holds the emails the app sends, as .eml files
+var mailDir string
+
 func setup() {
+	var err error
+	mailDir, err = os.MkdirTemp("", "fixit-mail-*")
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create mail directory: %v", err))
+	}
+	fakeProvider = newFakeOIDC("fixit", "fixit-secret")
+
 	testConfig = app.Config{
 		DatabaseURL: config.GetTestDBURL(),
 		Port:        0,
 		Auth: auth.Config{
-			SessionKey:  "test-32-byte-secret-key-here!!!",
-			SendGridKey: "",
-			FromEmail:   "test@example.com",
-			FromName:    "Test FixIt",
-			RootURL:     "http://localhost:8080",
+			SessionKey: "test-32-byte-secret-key-here!!!",
+			FromEmail:  "test@example.com",
+			FromName:   "Test FixIt",
+			RootURL:    "http://localhost:8080",
+			// Tests read emails, like confirmation links, from the files
+			Mail: mail.Config{
+				Transport: mail.TransportFile,
+				Dir:       mailDir,
+				Attempts:  1,
+			},
+			OIDC: []auth.OIDCProvider{{
+				Name:         "fake",
+				Title:        "Fake ID",
+				Issuer:       fakeProvider.URL,
+				ClientID:     "fixit",
+				ClientSecret: "fixit-secret",
+			}},
 		},
 	}
 
+	// The server refuses to start with the schema behind
+	if err := migrateTestDB(); err != nil {
+		panic(fmt.Sprintf("Failed to migrate test database: %v", err))
+	}
+	// Some tests use the seeded swindon community
+	if err := seedTestDB(); err != nil {
+		panic(fmt.Sprintf("Failed to seed test database: %v", err))
+	}
+
 	testApp, err := app.New(testConfig)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to create test app: %v", err))
@@ -44,11 +86,47 @@ func setup() {
 	}
 
 	testServer = httptest.NewServer(testApp.Router())
+	// Tests post forms without loading them first, so get tokens for them
+	rawTransport = http.DefaultTransport
+	http.DefaultTransport = &csrfTransport{next: rawTransport}
 	testConfig.Auth.RootURL = testServer.URL
 }
 
+func migrateTestDB() error {
+	db, err := sql.Open("postgres", testConfig.DatabaseURL)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := migration.New(db)
+	if err != nil {
+		return err
+	}
+	_, err = migrator.Up(context.Background(), 0)
+	return err
+}
+
+func seedTestDB() error {
+	client, err := ent.Open("postgres", testConfig.DatabaseURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	passwords, err := auth.NewPasswords(testConfig.Auth.BcryptCost)
+	if err != nil {
+		return err
+	}
+	return community.NewRepository(client).Seed(context.Background(), user.New(client, passwords))
+}
+
 func teardown() {
 	if testServer != nil {
 		testServer.Close()
 	}
+	if fakeProvider != nil {
+		fakeProvider.Close()
+	}
+	os.RemoveAll(mailDir)
 }
@@ -0,0 +1,46 @@
+package integration
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdownBodies(t *testing.T) {
+	client, _ := newRegisteredClient(t, "markdown-user-")
+
+	postID := createPostAs(t, client, map[string]string{
+		"title":     "Pothole on Drove Road",
+		"body":      "It's **deep**.\nSee https://council.example/roads, @alice knows.\n\n#potholes <script>alert(1)</script>",
+		"community": "swindon",
+	})
+
+	t.Run("Bodies show as Markdown", func(t *testing.T) {
+		resp, err := client.Get(testServer.URL + "/p/" + postID)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body := readResponseBody(t, resp)
+		assert.Contains(t, body, "It&#39;s <strong>deep</strong>.<br>")
+		assert.Contains(t, body, `<a href="https://council.example/roads" rel="nofollow noopener" target="_blank">`)
+		assert.Contains(t, body, `<span class="mention">@alice</span>`)
+		assert.Contains(t, body, `<a class="tag" href="/c/swindon?q=potholes" rel="nofollow">#potholes</a>`)
+		assert.NotContains(t, body, "<script>alert(1)</script>")
+	})
+
+	t.Run("The form can preview a body", func(t *testing.T) {
+		resp, err := client.PostForm(testServer.URL+"/api/post/preview", url.Values{
+			"body":      {"_Fly-tipping_ by #bins"},
+			"community": {"swindon"},
+		})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "<p><em>Fly-tipping</em> by <a class=\"tag\" href=\"/c/swindon?q=bins\" rel=\"nofollow\">#bins</a></p>\n",
+			readResponseBody(t, resp))
+	})
+}
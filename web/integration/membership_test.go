@@ -0,0 +1,121 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/ent/user"
+	"fixit/engine/factory"
+)
+
+func TestCommunityMembership(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	owner, _ := newRegisteredClient(t, "community-owner-")
+	member, memberName := newRegisteredClient(t, "community-member-")
+
+	name := factory.Placeholder("members-*")
+	resp, err := postMultipartForm(owner, testServer.URL+"/api/community/create", map[string]string{
+		"name":  name,
+		"title": "Community with members",
+	})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	get := func(t *testing.T, client *http.Client, path string) (int, string) {
+		resp, err := client.Get(testServer.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode, readResponseBody(t, resp)
+	}
+	post := func(t *testing.T, client *http.Client, path string, form url.Values) (int, string) {
+		resp, err := client.PostForm(testServer.URL+path, form)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode, readResponseBody(t, resp)
+	}
+
+	t.Run("The creator owns the community", func(t *testing.T) {
+		_, body := get(t, owner, "/c/"+name)
+		assert.Contains(t, body, "/c/"+name+"/settings")
+
+		status, body := get(t, owner, "/c/"+name+"/settings")
+		require.Equal(t, http.StatusOK, status)
+		assert.Contains(t, body, "Community with members")
+
+		status, _ = post(t, owner, "/api/community/"+name+"/leave", nil)
+		assert.Equal(t, http.StatusBadRequest, status, "the last owner can't leave")
+	})
+
+	t.Run("Anyone logged in can join", func(t *testing.T) {
+		_, body := get(t, member, "/c/"+name)
+		assert.Contains(t, body, "/api/community/"+name+"/join")
+
+		status, _ := post(t, member, "/api/community/"+name+"/join", nil)
+		require.Equal(t, http.StatusFound, status)
+
+		_, body = get(t, member, "/c/"+name)
+		assert.Contains(t, body, "/api/community/"+name+"/leave")
+		assert.NotContains(t, body, "/c/"+name+"/settings")
+
+		status, _ = get(t, member, "/c/"+name+"/settings")
+		assert.Equal(t, http.StatusForbidden, status)
+		status, _ = post(t, member, "/api/community/"+name+"/settings", url.Values{"title": {"Taken over"}})
+		assert.Equal(t, http.StatusForbidden, status)
+	})
+
+	t.Run("Owners change settings and roles", func(t *testing.T) {
+		status, _ := post(t, owner, "/api/community/"+name+"/settings", url.Values{
+			"title":    {"Renamed community"},
+			"location": {"Swindon, UK"},
+		})
+		require.Equal(t, http.StatusFound, status)
+		_, body := get(t, member, "/c/"+name)
+		assert.Contains(t, body, "Renamed community")
+
+		memberUser, err := dbClient.User.Query().Where(user.UsernameEQ(memberName)).Only(context.Background())
+		require.NoError(t, err)
+		status, _ = post(t, owner, "/api/community/"+name+"/role", url.Values{
+			"user_id": {memberUser.ID.String()},
+			"role":    {"moderator"},
+		})
+		require.Equal(t, http.StatusFound, status)
+
+		_, body = get(t, owner, "/c/"+name+"/settings")
+		assert.Contains(t, body, `<option value="moderator" selected>`)
+	})
+
+	t.Run("Moderators look after everyone's posts", func(t *testing.T) {
+		issueID := createPostAs(t, owner, map[string]string{
+			"title":     "Bins not collected",
+			"community": name,
+		})
+
+		_, body := get(t, member, "/p/"+issueID)
+		assert.Contains(t, body, "/p/"+issueID+"/edit")
+		assert.Contains(t, body, "/api/post/"+issueID+"/close")
+
+		status, _ := post(t, member, "/api/post/"+issueID+"/edit", url.Values{"title": {"Bins not collected on Friday"}})
+		require.Equal(t, http.StatusFound, status)
+		status, _ = post(t, member, "/api/post/"+issueID+"/delete", nil)
+		require.Equal(t, http.StatusFound, status)
+
+		_, body = get(t, owner, "/p/"+issueID)
+		assert.Contains(t, body, "This post was deleted")
+	})
+
+	t.Run("Members can leave", func(t *testing.T) {
+		status, _ := post(t, member, "/api/community/"+name+"/leave", nil)
+		require.Equal(t, http.StatusFound, status)
+
+		_, body := get(t, member, "/c/"+name)
+		assert.Contains(t, body, "/api/community/"+name+"/join")
+	})
+}
@@ -45,6 +45,9 @@ func TestMultipleVerifications(t *testing.T) {
 
 	assert.Equal(t, http.StatusFound, registerResp.StatusCode)
 
+	// Registering sends a confirmation email instead of logging in
+	confirmAndLogIn(t, client, email, password)
+
 	// Step 2: Create a community
 	communityName := "test-community-" + strconv.FormatInt(timestamp, 10)
 	communityFields := map[string]string{
@@ -137,6 +140,9 @@ func TestMultipleVerifications(t *testing.T) {
 
 		assert.Equal(t, http.StatusFound, registerRespVerifier.StatusCode)
 
+		// Registering sends a confirmation email instead of logging in
+		confirmAndLogIn(t, clientVerifier, verifierEmail, password)
+
 		// Create verification
 		verificationFields := map[string]string{
 			"title":       verificationTitle,
@@ -0,0 +1,175 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/ent/identity"
+	user2 "fixit/engine/ent/user"
+	"fixit/engine/factory"
+)
+
+// logInWithProvider goes through the fake provider's login, returning where
+// the app sends the browser at the end
+func (c *sessionClient) logInWithProvider() string {
+	authorize := c.redirect(c.server + "/auth/oidc/fake")
+	callback, err := url.Parse(c.redirect(authorize))
+	require.NoError(c.t, err)
+	// The provider sends users back to the configured root URL
+	return c.redirect(c.server + callback.RequestURI())
+}
+
+func (c *sessionClient) redirect(to string) string {
+	resp, err := c.http.Get(to)
+	require.NoError(c.t, err)
+	resp.Body.Close()
+	require.Equal(c.t, http.StatusFound, resp.StatusCode, to)
+	return resp.Header.Get("Location")
+}
+
+func (c *sessionClient) loginPage() string {
+	resp, err := c.http.Get(c.server + "/auth/login")
+	require.NoError(c.t, err)
+	defer resp.Body.Close()
+	return readResponseBody(c.t, resp)
+}
+
+func TestOIDCLogin(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+	ctx := context.Background()
+
+	t.Run("Login page offers the provider", func(t *testing.T) {
+		browser := newSessionClient(t, testServer.URL)
+		assert.Contains(t, browser.loginPage(), `href="/auth/oidc/fake"`)
+	})
+
+	t.Run("New users are signed up, and logged in again later", func(t *testing.T) {
+		email := factory.Placeholder("oidc-new-*") + "@example.com"
+		subject := factory.Placeholder("sub-*")
+		fakeProvider.logsInAs(fakeOIDCClaims{
+			Subject:           subject,
+			Email:             email,
+			EmailVerified:     true,
+			PreferredUsername: "Oidc New",
+		})
+
+		browser := newSessionClient(t, testServer.URL)
+		assert.Equal(t, "/", browser.logInWithProvider())
+
+		u, err := dbClient.User.Query().Where(user2.EmailEQ(email)).Only(ctx)
+		require.NoError(t, err)
+		assert.True(t, u.Confirmed, "the provider verified the email")
+		assert.Regexp(t, `^oidc_new\d*$`, u.Username)
+		assert.Contains(t, browser.sessionsPage(), "@"+u.Username)
+
+		// Test: Logging in again uses the same account, even with a new email
+		fakeProvider.logsInAs(fakeOIDCClaims{
+			Subject:       subject,
+			Email:         "changed-" + email,
+			EmailVerified: true,
+		})
+		again := newSessionClient(t, testServer.URL)
+		assert.Equal(t, "/", again.logInWithProvider())
+		assert.Contains(t, again.sessionsPage(), "@"+u.Username)
+		count, err := dbClient.Identity.Query().Where(identity.UserIDEQ(u.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("Existing users are linked by email", func(t *testing.T) {
+		u := factory.User(t, dbClient, "oidc-existing-*")
+		fakeProvider.logsInAs(fakeOIDCClaims{
+			Subject:       factory.Placeholder("sub-*"),
+			Email:         u.Email,
+			EmailVerified: true,
+		})
+
+		browser := newSessionClient(t, testServer.URL)
+		assert.Equal(t, "/", browser.logInWithProvider())
+		assert.Contains(t, browser.sessionsPage(), "@"+u.Username)
+
+		linked, err := dbClient.Identity.Query().Where(identity.UserIDEQ(u.ID)).Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "fake", linked.Provider)
+
+		// Test: The password still works
+		passwordBrowser := newSessionClient(t, testServer.URL)
+		passwordBrowser.logIn(u.Email, false)
+	})
+
+	t.Run("Emails are linked whatever their case", func(t *testing.T) {
+		u := factory.User(t, dbClient, "oidc-case-*")
+		fakeProvider.logsInAs(fakeOIDCClaims{
+			Subject:       factory.Placeholder("sub-*"),
+			Email:         strings.ToUpper(u.Email),
+			EmailVerified: true,
+		})
+
+		browser := newSessionClient(t, testServer.URL)
+		assert.Equal(t, "/", browser.logInWithProvider())
+		assert.Contains(t, browser.sessionsPage(), "@"+u.Username)
+
+		linked, err := dbClient.Identity.Query().Where(identity.UserIDEQ(u.ID)).Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "fake", linked.Provider)
+	})
+
+	t.Run("Unconfirmed accounts lose the password they signed up with", func(t *testing.T) {
+		u := factory.User(t, dbClient, "oidc-unconfirmed-*").Update().SetConfirmed(false).SaveX(ctx)
+		fakeProvider.logsInAs(fakeOIDCClaims{
+			Subject:       factory.Placeholder("sub-*"),
+			Email:         u.Email,
+			EmailVerified: true,
+		})
+
+		browser := newSessionClient(t, testServer.URL)
+		assert.Equal(t, "/", browser.logInWithProvider())
+		assert.Contains(t, browser.sessionsPage(), "@"+u.Username)
+
+		linked, err := dbClient.User.Get(ctx, u.ID)
+		require.NoError(t, err)
+		assert.True(t, linked.Confirmed)
+		assert.NotEqual(t, u.Password, linked.Password, "whoever signed up can't log in with their password")
+		assert.Greater(t, linked.SessionVersion, u.SessionVersion)
+	})
+
+	t.Run("Unverified emails aren't linked", func(t *testing.T) {
+		u := factory.User(t, dbClient, "oidc-unverified-*")
+		fakeProvider.logsInAs(fakeOIDCClaims{
+			Subject: factory.Placeholder("sub-*"),
+			Email:   u.Email,
+		})
+
+		browser := newSessionClient(t, testServer.URL)
+		assert.Equal(t, "/auth/login", browser.logInWithProvider())
+		assert.Contains(t, browser.loginPage(), "verified email address")
+		assert.Empty(t, browser.sessionsPage())
+
+		count, err := dbClient.Identity.Query().Where(identity.UserIDEQ(u.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("Callbacks from another browser's login are refused", func(t *testing.T) {
+		fakeProvider.logsInAs(fakeOIDCClaims{
+			Subject:       factory.Placeholder("sub-*"),
+			Email:         factory.Placeholder("oidc-forged-*") + "@example.com",
+			EmailVerified: true,
+		})
+		attacker := newSessionClient(t, testServer.URL)
+		authorize := attacker.redirect(testServer.URL + "/auth/oidc/fake")
+		callback, err := url.Parse(attacker.redirect(authorize))
+		require.NoError(t, err)
+
+		victim := newSessionClient(t, testServer.URL)
+		assert.Equal(t, "/auth/login", victim.redirect(testServer.URL+callback.RequestURI()))
+		assert.Empty(t, victim.sessionsPage())
+	})
+}
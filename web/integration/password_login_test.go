@@ -0,0 +1,62 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"fixit/engine/factory"
+)
+
+func TestPasswordLogin(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	login := func(email, password string) *http.Response {
+		client := &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+		resp, err := client.PostForm(testServer.URL+"/auth/login", url.Values{
+			"email":    {email},
+			"password": {password},
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	t.Run("Seeded users can log in", func(t *testing.T) {
+		resp := login("alice@example.com", "password123")
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/", resp.Header.Get("Location"))
+	})
+
+	t.Run("Wrong passwords are rejected", func(t *testing.T) {
+		resp := login("alice@example.com", "password124")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Hashes made with another cost are updated on login", func(t *testing.T) {
+		user := factory.User(t, dbClient, "rehash-user-*")
+		cost, err := bcrypt.Cost([]byte(user.Password))
+		require.NoError(t, err)
+		require.Equal(t, bcrypt.MinCost, cost)
+
+		resp := login(user.Email, factory.Password)
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+
+		reloaded, err := dbClient.User.Get(context.Background(), user.ID)
+		require.NoError(t, err)
+		cost, err = bcrypt.Cost([]byte(reloaded.Password))
+		require.NoError(t, err)
+		assert.Equal(t, bcrypt.DefaultCost, cost)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(reloaded.Password), []byte(factory.Password)))
+	})
+}
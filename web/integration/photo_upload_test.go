@@ -0,0 +1,161 @@
+package integration
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/factory"
+)
+
+func TestPhotoUpload(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	comm := factory.Community(t, dbClient, "photo-community-*")
+	client, _ := newRegisteredClient(t, "photo-user-")
+
+	var img bytes.Buffer
+	require.NoError(t, png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 2000, 1000))))
+
+	resp, err := postMultipartWithFiles(client, testServer.URL+"/api/post/create", map[string]string{
+		"title":     "Broken bench with photos",
+		"community": comm.Name,
+		"caption_0": "The bench from the path",
+		"caption_1": "Close up of the break",
+	}, map[string][]byte{
+		"photo_0": img.Bytes(),
+		"photo_1": img.Bytes(),
+	})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	postID := strings.Split(resp.Header.Get("Location"), "posted_id=")[1]
+
+	resp, err = client.Get(testServer.URL + "/p/" + postID)
+	require.NoError(t, err)
+	body := readResponseBody(t, resp)
+	resp.Body.Close()
+
+	assert.Contains(t, body, "The bench from the path")
+	assert.Contains(t, body, "Close up of the break")
+	fileURLs := regexp.MustCompile(`/files/[0-9a-f-]{36}`).FindAllString(body, -1)
+	require.NotEmpty(t, fileURLs)
+	// Each photo links to the original and shows the detail size
+	originalURL := regexp.MustCompile(`href="(/files/[0-9a-f-]{36})"`).FindStringSubmatch(body)[1]
+	detailURL := regexp.MustCompile(`<img src="(/files/[0-9a-f-]{36})"`).FindStringSubmatch(body)[1]
+	assert.NotEqual(t, originalURL, detailURL)
+
+	t.Run("Files are served with their content type and cache headers", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + originalURL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "nosniff", resp.Header.Get("X-Content-Type-Options"))
+		assert.Contains(t, resp.Header.Get("Cache-Control"), "immutable")
+		served, err := png.DecodeConfig(strings.NewReader(readResponseBody(t, resp)))
+		require.NoError(t, err)
+		assert.Equal(t, 2000, served.Width)
+
+		req, err := http.NewRequest("GET", testServer.URL+originalURL, nil)
+		require.NoError(t, err)
+		req.Header.Set("If-None-Match", resp.Header.Get("ETag"))
+		cached, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer cached.Body.Close()
+		assert.Equal(t, http.StatusNotModified, cached.StatusCode)
+	})
+
+	t.Run("Posts show the detail size", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + detailURL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		served, err := png.DecodeConfig(strings.NewReader(readResponseBody(t, resp)))
+		require.NoError(t, err)
+		assert.Equal(t, 1600, served.Width)
+		assert.Equal(t, 800, served.Height)
+	})
+
+	t.Run("Community list shows the first photo as a thumbnail", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + "/c/" + comm.Name)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		listBody := readResponseBody(t, resp)
+		assert.Contains(t, listBody, `alt="The bench from the path"`)
+		thumbnailURL := regexp.MustCompile(`<img src="(/files/[0-9a-f-]{36})" alt="The bench from the path"`).FindStringSubmatch(listBody)[1]
+
+		resp, err = http.Get(testServer.URL + thumbnailURL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		served, err := png.DecodeConfig(strings.NewReader(readResponseBody(t, resp)))
+		require.NoError(t, err)
+		assert.Equal(t, 480, served.Width)
+	})
+
+	t.Run("Photo metadata is stripped", func(t *testing.T) {
+		var photo bytes.Buffer
+		require.NoError(t, jpeg.Encode(&photo, image.NewRGBA(image.Rect(0, 0, 8, 8)), nil))
+		exif := []byte("Exif\x00\x00MM\x00\x2a\x00\x00\x00\x08\x00\x00GPS 51.5,-1.78")
+		segment := append([]byte{0xFF, 0xE1, 0, byte(len(exif) + 2)}, exif...)
+		withEXIF := append(append([]byte{0xFF, 0xD8}, segment...), photo.Bytes()[2:]...)
+
+		resp, err := postMultipartWithFiles(client, testServer.URL+"/api/post/create", map[string]string{
+			"title":     "Bench photographed on a phone",
+			"community": comm.Name,
+		}, map[string][]byte{
+			"photo_0": withEXIF,
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		postID := strings.Split(resp.Header.Get("Location"), "posted_id=")[1]
+
+		resp, err = client.Get(testServer.URL + "/p/" + postID)
+		require.NoError(t, err)
+		body := readResponseBody(t, resp)
+		resp.Body.Close()
+		originalURL := regexp.MustCompile(`href="(/files/[0-9a-f-]{36})"`).FindStringSubmatch(body)[1]
+
+		resp, err = http.Get(testServer.URL + originalURL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		served := readResponseBody(t, resp)
+		assert.Equal(t, "image/jpeg", resp.Header.Get("Content-Type"))
+		assert.NotContains(t, served, "GPS")
+		assert.NotContains(t, served, "Exif")
+	})
+
+	t.Run("Files that aren't images are rejected", func(t *testing.T) {
+		resp, err := postMultipartWithFiles(client, testServer.URL+"/api/post/create", map[string]string{
+			"title":     "Bench with a bad photo",
+			"community": comm.Name,
+		}, map[string][]byte{
+			"photo_0": []byte("<html>not a photo</html>"),
+		})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, readResponseBody(t, resp), "only JPEG, PNG, GIF and WebP images")
+	})
+
+	t.Run("Unknown files are not found", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + "/files/00000000-0000-0000-0000-000000000000")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -44,10 +44,13 @@ func TestPostReplyFunctionality(t *testing.T) {
 	require.NoError(t, err)
 	defer registerResp.Body.Close()
 
-	// Should redirect to home page after successful registration
+	// Should redirect to login until the email address is confirmed
 	assert.Equal(t, http.StatusFound, registerResp.StatusCode)
 	location := registerResp.Header.Get("Location")
-	assert.Equal(t, "/", location)
+	assert.Equal(t, "/auth/login", location)
+
+	// Registering sends a confirmation email instead of logging in
+	confirmAndLogIn(t, client, email, password)
 
 	// Step 2: Create a community first
 	communityName := "test-community-" + strconv.FormatInt(timestamp, 10)
@@ -140,6 +143,9 @@ func TestPostReplyFunctionality(t *testing.T) {
 
 	assert.Equal(t, http.StatusFound, registerResp3.StatusCode)
 
+	// Registering sends a confirmation email instead of logging in
+	confirmAndLogIn(t, client3, email3, password)
+
 	verificationTitle := "Verification for solution"
 	verificationFields := map[string]string{
 		"title":       verificationTitle,
@@ -256,6 +262,9 @@ func TestPostReplyGetHandler(t *testing.T) {
 
 	assert.Equal(t, http.StatusFound, registerResp.StatusCode)
 
+	// Registering sends a confirmation email instead of logging in
+	confirmAndLogIn(t, client, email, password)
+
 	// Step 2: Create a community first
 	communityName := "test-community-" + strconv.FormatInt(timestamp, 10)
 	communityFields := map[string]string{
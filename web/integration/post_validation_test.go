@@ -44,9 +44,12 @@ func TestUserCanSolveOwnIssueButNotVerifyOwnSolution(t *testing.T) {
 	require.NoError(t, err)
 	defer registerResp.Body.Close()
 
-	// Should redirect to home page after successful registration
+	// Should redirect to login until the email address is confirmed
 	assert.Equal(t, http.StatusFound, registerResp.StatusCode)
 
+	// Registering sends a confirmation email instead of logging in
+	confirmAndLogIn(t, client, email, password)
+
 	// Step 2: Create a community first
 	communityName := "test-community-" + strconv.FormatInt(timestamp, 10)
 	communityFields := map[string]string{
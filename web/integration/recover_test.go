@@ -0,0 +1,132 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/ent/user"
+	"fixit/engine/factory"
+)
+
+func TestPasswordRecovery(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+	ctx := context.Background()
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Jar: jar,
+	}
+
+	u := factory.User(t, dbClient, "recover-user-*")
+	newPassword := "NewPassword456!"
+
+	get := func(path string) (int, string) {
+		resp, err := client.Get(testServer.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode, readResponseBody(t, resp)
+	}
+	post := func(path string, form url.Values) *http.Response {
+		resp, err := client.PostForm(testServer.URL+path, form)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+	logIn := func(password string) *http.Response {
+		return post("/auth/login", url.Values{"email": {u.Email}, "password": {password}})
+	}
+	// askForReset returns the token from the link in the reset email
+	askForReset := func() string {
+		resp := post("/auth/recover", url.Values{"email": {u.Email}})
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		link, err := url.Parse(linkInEmail(t, u.Email, "/auth/recover/end"))
+		require.NoError(t, err)
+		return link.Query().Get("token")
+	}
+	reset := func(token, password, confirm string) *http.Response {
+		return post("/auth/recover/end", url.Values{
+			"token":            {token},
+			"password":         {password},
+			"confirm_password": {confirm},
+		})
+	}
+
+	t.Run("Login links to the forgot password page", func(t *testing.T) {
+		_, body := get("/auth/login")
+		assert.Contains(t, body, `href="/auth/recover"`)
+
+		status, body := get("/auth/recover")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Contains(t, body, "<title>Forgot Password - FixIt</title>")
+	})
+
+	t.Run("Unknown addresses get the same response", func(t *testing.T) {
+		resp := post("/auth/recover", url.Values{"email": {"nobody-" + u.Email}})
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/auth/login", resp.Header.Get("Location"))
+		_, body := get("/auth/login")
+		assert.Contains(t, body, "An email has been sent to you with further instructions on how to reset your password.")
+	})
+
+	t.Run("The emailed link resets the password", func(t *testing.T) {
+		token := askForReset()
+		require.NotEmpty(t, token)
+
+		status, body := get("/auth/recover/end?" + url.Values{"token": {token}}.Encode())
+		assert.Equal(t, http.StatusOK, status)
+		assert.Contains(t, body, "<title>Reset Password - FixIt</title>")
+		assert.Contains(t, body, `name="token"`)
+
+		// Test: The passwords must match
+		resp := reset(token, newPassword, newPassword+"?")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = reset(token, newPassword, newPassword)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/auth/login", resp.Header.Get("Location"))
+		_, body = get("/auth/login")
+		assert.Contains(t, body, "Successfully updated password")
+
+		assert.Equal(t, http.StatusOK, logIn(factory.Password).StatusCode, "the old password no longer works")
+		resp = logIn(newPassword)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/", resp.Header.Get("Location"))
+
+		// Test: A link only works once
+		resp = reset(token, "AnotherPassword789!", "AnotherPassword789!")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Expired links are rejected", func(t *testing.T) {
+		token := askForReset()
+		err := dbClient.User.Update().
+			Where(user.IDEQ(u.ID)).
+			SetRecoverExpiry(time.Now().Add(-time.Minute)).
+			Exec(ctx)
+		require.NoError(t, err)
+
+		resp, err := client.PostForm(testServer.URL+"/auth/recover/end", url.Values{
+			"token":            {token},
+			"password":         {"AnotherPassword789!"},
+			"confirm_password": {"AnotherPassword789!"},
+		})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, readResponseBody(t, resp), "recovery token is invalid")
+
+		assert.Equal(t, http.StatusFound, logIn(newPassword).StatusCode, "the password is unchanged")
+	})
+}
@@ -0,0 +1,67 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/factory"
+)
+
+func TestSearch(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	user := factory.User(t, dbClient, "search-user-*")
+	community := factory.Community(t, dbClient, "search-community-*")
+	elsewhere := factory.Community(t, dbClient, "search-elsewhere-*")
+
+	_, err := dbClient.Post.Create().
+		SetTitle("Quibbleflax bin overflowing").
+		SetBody("Nobody has collected the bins for weeks").
+		SetUser(user).
+		SetCommunity(community).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	_, err = dbClient.Post.Create().
+		SetTitle("Another quibbleflax sighting").
+		SetUser(user).
+		SetCommunity(elsewhere).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	t.Run("Search across communities highlights matches", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + "/search?" + url.Values{"q": {"quibbleflax"}}.Encode())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		body := readResponseBody(t, resp)
+		assert.Contains(t, body, "<mark>Quibbleflax</mark> bin overflowing")
+		assert.Contains(t, body, "Another <mark>quibbleflax</mark> sighting")
+	})
+
+	t.Run("Community search only shows that community", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + "/c/" + community.Name + "?" + url.Values{"q": {"quibbleflax"}}.Encode())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		body := readResponseBody(t, resp)
+		assert.Contains(t, body, "bin overflowing")
+		assert.NotContains(t, body, "sighting")
+	})
+
+	t.Run("Unknown filters are rejected", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + "/search?" + url.Values{"q": {"quibbleflax"}, "role": {"rant"}}.Encode())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
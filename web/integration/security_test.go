@@ -0,0 +1,54 @@
+package integration
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/auth"
+	"fixit/web/app"
+)
+
+var scriptTag = regexp.MustCompile(`<script([^>]*)>`)
+
+func TestSecurityHeaders(t *testing.T) {
+	t.Run("Pages only run their own scripts", func(t *testing.T) {
+		resp, err := http.Get(testServer.URL + "/")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, "DENY", resp.Header.Get("X-Frame-Options"))
+		assert.Equal(t, "strict-origin-when-cross-origin", resp.Header.Get("Referrer-Policy"))
+		// The test server runs over http
+		assert.Empty(t, resp.Header.Get("Strict-Transport-Security"))
+
+		csp := resp.Header.Get("Content-Security-Policy")
+		nonce := regexp.MustCompile(`'nonce-([^']+)'`).FindStringSubmatch(csp)
+		require.NotNil(t, nonce, csp)
+
+		// Test: Inline scripts carry the nonce, and there are no inline handlers
+		body := readResponseBody(t, resp)
+		for _, tag := range scriptTag.FindAllStringSubmatch(body, -1) {
+			if regexp.MustCompile(`\ssrc=`).MatchString(tag[1]) {
+				continue
+			}
+			assert.Contains(t, tag[1], `nonce="`+nonce[1]+`"`)
+		}
+		assert.NotContains(t, body, "onclick=")
+	})
+
+	t.Run("The default session key is refused outside development", func(t *testing.T) {
+		cfg := testConfig
+		cfg.Auth.SessionKey = auth.DefaultSessionKey
+
+		_, err := app.New(cfg)
+		assert.ErrorIs(t, err, app.ErrDefaultSessionKey)
+
+		cfg.Env = app.EnvDevelopment
+		_, err = app.New(cfg)
+		assert.NoError(t, err)
+	})
+}
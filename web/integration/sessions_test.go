@@ -0,0 +1,254 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/securecookie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/auth"
+	entSession "fixit/engine/ent/session"
+	"fixit/engine/factory"
+	"fixit/web/app"
+)
+
+// sessionClient is a browser logged in, or not, to one server
+type sessionClient struct {
+	t      *testing.T
+	server string
+	http   *http.Client
+}
+
+func newSessionClient(t *testing.T, server string) *sessionClient {
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	return &sessionClient{
+		t:      t,
+		server: server,
+		http: &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+			Jar: jar,
+		},
+	}
+}
+
+func (c *sessionClient) logIn(email string, remember bool) {
+	form := url.Values{"email": {email}, "password": {factory.Password}}
+	if remember {
+		form.Set("rm", "true")
+	}
+	resp := c.post("/auth/login", form)
+	require.Equal(c.t, http.StatusFound, resp.StatusCode)
+	require.Equal(c.t, "/", resp.Header.Get("Location"))
+}
+
+func (c *sessionClient) post(path string, form url.Values) *http.Response {
+	resp, err := c.http.PostForm(c.server+path, form)
+	require.NoError(c.t, err)
+	resp.Body.Close()
+	return resp
+}
+
+// sessionsPage returns the sessions page, or "" when it redirects to login
+func (c *sessionClient) sessionsPage() string {
+	resp, err := c.http.Get(c.server + "/account/sessions")
+	require.NoError(c.t, err)
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusFound {
+		require.Equal(c.t, "/auth/login", resp.Header.Get("Location"))
+		return ""
+	}
+	require.Equal(c.t, http.StatusOK, resp.StatusCode)
+	return readResponseBody(c.t, resp)
+}
+
+func (c *sessionClient) cookie(name string) *http.Cookie {
+	u, err := url.Parse(c.server)
+	require.NoError(c.t, err)
+	for _, cookie := range c.http.Jar.Cookies(u) {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	return nil
+}
+
+func TestRememberMe(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+	u := factory.User(t, dbClient, "remember-user-*")
+
+	t.Run("Without remember me there's no cookie", func(t *testing.T) {
+		browser := newSessionClient(t, testServer.URL)
+		browser.logIn(u.Email, false)
+		assert.Nil(t, browser.cookie("rm"))
+	})
+
+	t.Run("Remembered browsers are logged in after the session ends", func(t *testing.T) {
+		browser := newSessionClient(t, testServer.URL)
+		browser.logIn(u.Email, true)
+		remembered := browser.cookie("rm")
+		require.NotNil(t, remembered)
+
+		// A browser restart drops the session but keeps the remember cookie
+		restarted := newSessionClient(t, testServer.URL)
+		serverURL, _ := url.Parse(testServer.URL)
+		restarted.http.Jar.SetCookies(serverURL, []*http.Cookie{remembered})
+		assert.Contains(t, restarted.sessionsPage(), "@"+u.Username)
+		assert.NotEqual(t, remembered.Value, restarted.cookie("rm").Value, "the token is replaced once used")
+
+		// Test: A token only works once
+		copied := newSessionClient(t, testServer.URL)
+		copied.http.Jar.SetCookies(serverURL, []*http.Cookie{remembered})
+		assert.Empty(t, copied.sessionsPage())
+
+		// Test: Logging out forgets the token
+		current := restarted.cookie("rm")
+		resp := restarted.post("/auth/logout", nil)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/auth/login", resp.Header.Get("Location"))
+		assert.Empty(t, restarted.sessionsPage())
+
+		copied = newSessionClient(t, testServer.URL)
+		copied.http.Jar.SetCookies(serverURL, []*http.Cookie{current})
+		assert.Empty(t, copied.sessionsPage())
+	})
+}
+
+func TestPasswordResetEndsSessions(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+	u := factory.User(t, dbClient, "reset-sessions-*")
+
+	loggedIn := newSessionClient(t, testServer.URL)
+	loggedIn.logIn(u.Email, false)
+	remembered := newSessionClient(t, testServer.URL)
+	remembered.logIn(u.Email, true)
+
+	resetter := newSessionClient(t, testServer.URL)
+	resetter.post("/auth/recover", url.Values{"email": {u.Email}})
+	link, err := url.Parse(linkInEmail(t, u.Email, "/auth/recover/end"))
+	require.NoError(t, err)
+	newPassword := "NewPassword456!"
+	resp := resetter.post("/auth/recover/end", url.Values{
+		"token":            {link.Query().Get("token")},
+		"password":         {newPassword},
+		"confirm_password": {newPassword},
+	})
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	assert.Empty(t, loggedIn.sessionsPage())
+	assert.Empty(t, remembered.sessionsPage())
+}
+
+func TestEndingSessions(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	t.Run("Cookie sessions can all be ended but can't be listed", func(t *testing.T) {
+		u := factory.User(t, dbClient, "end-cookie-*")
+		here := newSessionClient(t, testServer.URL)
+		here.logIn(u.Email, false)
+		elsewhere := newSessionClient(t, testServer.URL)
+		elsewhere.logIn(u.Email, false)
+
+		page := here.sessionsPage()
+		assert.Contains(t, page, "<title>Your Sessions - FixIt</title>")
+		assert.Contains(t, page, "can't be listed")
+
+		resp := here.post("/account/sessions/end-others", nil)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.NotEmpty(t, here.sessionsPage(), "this session carries on")
+		assert.Empty(t, elsewhere.sessionsPage())
+	})
+
+	t.Run("Postgres sessions are listed and ended one by one", func(t *testing.T) {
+		cfg := testConfig
+		cfg.Auth.SessionStore = auth.SessionStorePostgres
+		pgApp, err := app.New(cfg)
+		require.NoError(t, err)
+		require.NoError(t, pgApp.Initialize())
+		server := httptest.NewServer(pgApp.Router())
+		defer server.Close()
+
+		u := factory.User(t, dbClient, "end-postgres-*")
+		here := newSessionClient(t, server.URL)
+		here.logIn(u.Email, false)
+		elsewhere := newSessionClient(t, server.URL)
+		elsewhere.logIn(u.Email, false)
+
+		page := here.sessionsPage()
+		assert.Equal(t, 1, strings.Count(page, "This session"))
+		ends := regexp.MustCompile(`/account/sessions/([0-9a-f-]{36})/end`).FindAllStringSubmatch(page, -1)
+		require.Len(t, ends, 1, "only the other session can be ended from here")
+
+		// Test: Ending a session logs it out
+		resp := here.post("/account/sessions/"+ends[0][1]+"/end", nil)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Empty(t, elsewhere.sessionsPage())
+		assert.NotEmpty(t, here.sessionsPage())
+
+		// Test: Other users' sessions can't be ended
+		other := newSessionClient(t, server.URL)
+		other.logIn(factory.User(t, dbClient, "end-postgres-other-*").Email, false)
+		elsewhere.logIn(u.Email, false)
+		ends = regexp.MustCompile(`/account/sessions/([0-9a-f-]{36})/end`).FindAllStringSubmatch(here.sessionsPage(), -1)
+		require.Len(t, ends, 1)
+		resp = other.post("/account/sessions/"+ends[0][1]+"/end", nil)
+		assert.NotEqual(t, http.StatusFound, resp.StatusCode)
+		assert.NotEmpty(t, elsewhere.sessionsPage())
+
+		// Test: Ending the others keeps this one
+		here.post("/account/sessions/end-others", nil)
+		assert.Empty(t, elsewhere.sessionsPage())
+		assert.NotEmpty(t, here.sessionsPage())
+		assert.NotEmpty(t, other.sessionsPage(), "other users aren't affected")
+	})
+}
+
+func TestLoginStartsNewSession(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	cfg := testConfig
+	cfg.Auth.SessionStore = auth.SessionStorePostgres
+	pgApp, err := app.New(cfg)
+	require.NoError(t, err)
+	require.NoError(t, pgApp.Initialize())
+	server := httptest.NewServer(pgApp.Router())
+	defer server.Close()
+
+	// An attacker starts a session and plants its cookie in the browser
+	planted, err := dbClient.Session.Create().
+		SetValues(map[string]string{}).
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		Save(context.Background())
+	require.NoError(t, err)
+	value, err := securecookie.New([]byte(cfg.Auth.SessionKey), nil).Encode("authboss-session", planted.ID)
+	require.NoError(t, err)
+	browser := newSessionClient(t, server.URL)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	browser.http.Jar.SetCookies(serverURL, []*http.Cookie{{Name: "authboss-session", Value: value, Path: "/"}})
+
+	u := factory.User(t, dbClient, "fixation-*")
+	browser.logIn(u.Email, false)
+
+	// Test: The planted session is gone and the browser has a new one
+	assert.NotEqual(t, value, browser.cookie("authboss-session").Value)
+	exists, err := dbClient.Session.Query().Where(entSession.ID(planted.ID)).Exist(context.Background())
+	require.NoError(t, err)
+	assert.False(t, exists)
+	assert.NotEmpty(t, browser.sessionsPage())
+}
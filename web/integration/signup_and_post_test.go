@@ -46,10 +46,13 @@ func TestSignupAndCreatePost(t *testing.T) {
 	require.NoError(t, err)
 	defer registerResp.Body.Close()
 
-	// Should redirect to home page after successful registration
+	// Should redirect to login until the email address is confirmed
 	assert.Equal(t, http.StatusFound, registerResp.StatusCode)
 	location := registerResp.Header.Get("Location")
-	assert.Equal(t, "/", location)
+	assert.Equal(t, "/auth/login", location)
+
+	// Registering sends a confirmation email instead of logging in
+	confirmAndLogIn(t, client, email, password)
 
 	// Step 2: Create a community first (we need a community to post to)
 	communityName := "test-community-" + strconv.FormatInt(timestamp, 10)
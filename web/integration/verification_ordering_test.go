@@ -46,6 +46,9 @@ func TestVerificationOrderingDescending(t *testing.T) {
 
 	assert.Equal(t, http.StatusFound, registerResp.StatusCode)
 
+	// Registering sends a confirmation email instead of logging in
+	confirmAndLogIn(t, client, email, password)
+
 	// Step 2: Create a community
 	communityName := factory.Placeholder("test-community-*")
 	communityFields := map[string]string{
@@ -141,6 +144,9 @@ func TestVerificationOrderingDescending(t *testing.T) {
 
 		assert.Equal(t, http.StatusFound, registerRespVerifier.StatusCode)
 
+		// Registering sends a confirmation email instead of logging in
+		confirmAndLogIn(t, clientVerifier, verifierEmail, password)
+
 		// Create verification
 		verificationFields := map[string]string{
 			"title":       verificationTitle,
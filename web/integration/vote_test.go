@@ -0,0 +1,104 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/engine/ent/post"
+	"fixit/engine/ent/vote"
+	"fixit/engine/factory"
+)
+
+func TestVoteOnPost(t *testing.T) {
+	dbClient := createDBClient(t)
+	defer dbClient.Close()
+
+	author := factory.User(t, dbClient, "vote-author-*")
+	community := factory.Community(t, dbClient, "vote-community-*")
+	issue, err := dbClient.Post.Create().
+		SetTitle("Pothole worth voting on").
+		SetUser(author).
+		SetCommunity(community).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	postURL := testServer.URL + "/p/" + issue.ID.String()
+	voteURL := testServer.URL + "/api/post/" + issue.ID.String() + "/vote"
+
+	t.Run("Anonymous users are sent to login", func(t *testing.T) {
+		client := &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+
+		resp, err := client.PostForm(voteURL, url.Values{"kind": {"interesting"}, "value": {"1"}})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/auth/login", resp.Header.Get("Location"))
+	})
+
+	client, _ := newRegisteredClient(t, "voter")
+
+	t.Run("Casting a vote redirects back and shows it as selected", func(t *testing.T) {
+		resp, err := client.PostForm(voteURL, url.Values{
+			"kind":      {"interesting"},
+			"value":     {"1"},
+			"return_to": {"/c/" + community.Name},
+		})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/c/"+community.Name, resp.Header.Get("Location"))
+
+		showResp, err := client.Get(postURL)
+		require.NoError(t, err)
+		defer showResp.Body.Close()
+
+		body := readResponseBody(t, showResp)
+		assert.Contains(t, body, `action="/api/post/`+issue.ID.String()+`/vote/retract"`)
+	})
+
+	t.Run("Off-site return_to is ignored", func(t *testing.T) {
+		resp, err := client.PostForm(voteURL, url.Values{
+			"kind":      {"truthful"},
+			"value":     {"-1"},
+			"return_to": {"//evil.example.com"},
+		})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/p/"+issue.ID.String(), resp.Header.Get("Location"))
+	})
+
+	t.Run("Invalid votes are rejected", func(t *testing.T) {
+		resp, err := client.PostForm(voteURL, url.Values{"kind": {"interesting"}, "value": {"10"}})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Retracting clears the vote", func(t *testing.T) {
+		resp, err := client.PostForm(voteURL+"/retract", url.Values{"kind": {"interesting"}})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+
+		remaining, err := dbClient.Vote.Query().
+			Where(vote.KindEQ(vote.KindInteresting), vote.HasPostWith(post.ID(issue.ID))).
+			Count(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 0, remaining)
+	})
+}
@@ -2,8 +2,10 @@ package layouts
 
 import (
 	"bytes"
-	_ "embed"
+	"context"
+	"embed"
 	"html/template"
+	"net/http"
 
 	"github.com/pkg/errors"
 )
@@ -13,9 +15,19 @@ var genTplS string
 
 var genTpl = template.Must(template.New("general").Parse(genTplS))
 
+// staticFS holds the site's stylesheets, kept out of pages so the
+// Content-Security-Policy needn't allow them inline, and scripts more than
+// one page shares. static/tailwind.css is built by the Tailwind CLI.
+//
+//go:generate tailwindcss --config tailwind.config.js --input input.css --output static/tailwind.css --minify
+//go:embed static
+var staticFS embed.FS
+
 type LayoutData struct {
 	Title   string
 	Content template.HTML
+	// CSRFToken is for scripts that post, as forms include their own
+	CSRFToken string
 }
 
 func WithGeneral(dat LayoutData) ([]byte, error) {
@@ -26,3 +38,22 @@ func WithGeneral(dat LayoutData) ([]byte, error) {
 	}
 	return out.Bytes(), nil
 }
+
+// Static serves the files pages load from /static/
+func Static() http.Handler {
+	return http.FileServer(http.FS(staticFS))
+}
+
+type nonceContextKey struct{}
+
+// WithNonce carries the nonce the Content-Security-Policy lets a page's
+// inline scripts run with
+func WithNonce(ctx context.Context, nonce string) context.Context {
+	return context.WithValue(ctx, nonceContextKey{}, nonce)
+}
+
+// Nonce is the request's nonce for inline scripts, set by WithNonce
+func Nonce(ctx context.Context) string {
+	nonce, _ := ctx.Value(nonceContextKey{}).(string)
+	return nonce
+}
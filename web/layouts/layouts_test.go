@@ -0,0 +1,31 @@
+package layouts_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/web/layouts"
+)
+
+func TestStatic(t *testing.T) {
+	rec := httptest.NewRecorder()
+	layouts.Static().ServeHTTP(rec, httptest.NewRequest("GET", "/static/site.css", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/css")
+	assert.Contains(t, rec.Body.String(), ".polaroid-image")
+
+	rec = httptest.NewRecorder()
+	layouts.Static().ServeHTTP(rec, httptest.NewRequest("GET", "/static/preview.js", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "/api/post/preview")
+
+	page, err := layouts.WithGeneral(layouts.LayoutData{Title: "Page"})
+	require.NoError(t, err)
+	assert.Contains(t, string(page), `href="/static/tailwind.css"`)
+	assert.Contains(t, string(page), `href="/static/site.css"`)
+	assert.NotContains(t, string(page), "<style", "inline styles need the Content-Security-Policy to allow them")
+}
@@ -0,0 +1,41 @@
+// The Markdown preview on the create and edit forms follows the body while
+// it's open
+(function() {
+    const body = document.getElementById('body');
+    const preview = document.getElementById('preview');
+    let previewTimer;
+    function updatePreview() {
+        const params = new URLSearchParams({
+            body: body.value,
+            community: document.querySelector('input[name="community"]').value,
+        });
+        fetch('/api/post/preview', {
+            method: 'POST',
+            headers: {'X-CSRF-Token': document.querySelector('meta[name="csrf-token"]').content},
+            body: params,
+        })
+            .then(function(response) {
+                return response.ok ? response.text() : Promise.reject(response.status);
+            })
+            .then(function(html) {
+                preview.innerHTML = html;
+            })
+            .catch(function() {
+                preview.textContent = "Couldn't show a preview";
+            });
+    }
+    document.getElementById('preview-toggle').addEventListener('click', function() {
+        preview.classList.toggle('hidden');
+        this.textContent = preview.classList.contains('hidden') ? 'Preview' : 'Hide preview';
+        if (!preview.classList.contains('hidden')) {
+            updatePreview();
+        }
+    });
+    body.addEventListener('input', function() {
+        if (preview.classList.contains('hidden')) {
+            return;
+        }
+        clearTimeout(previewTimer);
+        previewTimer = setTimeout(updatePreview, 300);
+    });
+})();
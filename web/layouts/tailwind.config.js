@@ -0,0 +1,6 @@
+// Tailwind builds static/tailwind.css from the classes the templates and
+// static scripts use. Run `go generate ./web/layouts` after changing them.
+module.exports = {
+  content: ['../**/*.gohtml', './static/*.js'],
+  plugins: [require('@tailwindcss/typography')],
+};
@@ -4,18 +4,30 @@ import (
 	"bytes"
 	"context"
 	"embed"
+	"encoding/json"
 	"html/template"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
+	"github.com/aarondl/authboss/v3"
 	"github.com/dustin/go-humanize"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 
+	"fixit/engine/auth"
 	"fixit/engine/community"
 	"fixit/engine/ent"
+	entFile "fixit/engine/ent/file"
+	entMembership "fixit/engine/ent/membership"
+	"fixit/engine/geo"
+	searchEngine "fixit/engine/search"
+	webfile "fixit/web/file"
 	"fixit/web/handler"
 	"fixit/web/layouts"
+	"fixit/web/search"
+	"fixit/web/server"
 )
 
 //go:embed templates/*.gohtml
@@ -25,6 +37,9 @@ var templateFuncs = template.FuncMap{
 	"humanizeTime": func(t time.Time) string {
 		return humanize.Time(t)
 	},
+	"thumbnailURL": func(a *ent.Attachment) string {
+		return webfile.AttachmentURL(a, entFile.VariantList)
+	},
 }
 
 func getTemplates() *template.Template {
@@ -38,7 +53,9 @@ func getTemplates() *template.Template {
 }
 
 type Handler struct {
-	repo *community.Repository
+	repo   *community.Repository
+	search *searchEngine.Repository
+	ab     *authboss.Authboss
 }
 
 type Post struct {
@@ -51,23 +68,95 @@ type Post struct {
 	Priority string
 }
 
-func New(client *ent.Client) *Handler {
+func New(client *ent.Client, ab *authboss.Authboss) *Handler {
 	repo := community.NewRepository(client)
 	return &Handler{
-		repo: repo,
+		repo:   repo,
+		search: searchEngine.New(client),
+		ab:     ab,
 	}
 }
 
 func (h *Handler) RegisterRoutes(router *mux.Router) {
 	router.HandleFunc("/c/{slug}", handler.Wrap(h.handleList)).Methods("GET")
+	router.HandleFunc("/c/{slug}/map.geojson", handler.Wrap(h.handleMap)).Methods("GET")
+}
+
+type listData struct {
+	Community *ent.Community
+	// Center is where the map starts when there are no issues to show
+	Center      *geo.Point
+	Posts       []community.PostListItem
+	Sort        community.Sort
+	SortOptions []sortOption
+	NextURL     string
+	PrevURL     string
+	ReturnTo    string
+	// Query is set when searching, and Results replaces the list
+	Query   string
+	Results template.HTML
+	// Membership is the viewer's place in the community, for the header
+	Membership membership
+	CSRFToken  string
+	// Nonce lets the page's script run under the content security policy
+	Nonce string
+}
+
+// membership is whether the viewer can join or leave the community, and
+// whether they can manage it
+type membership struct {
+	LoggedIn  bool
+	Role      entMembership.Role
+	CanManage bool
+}
+
+// viewerMembership finds the viewer's role in the community
+func (h *Handler) viewerMembership(req *http.Request, comm *ent.Community) (membership, error) {
+	viewer, isAuthenticated := auth.RequireAuth(h.ab, req)
+	if !isAuthenticated {
+		return membership{}, nil
+	}
+	role, err := h.repo.Role(req.Context(), comm.ID, viewer.User)
+	if err != nil {
+		return membership{}, err
+	}
+	return membership{
+		LoggedIn:  true,
+		Role:      role,
+		CanManage: community.Allows(role, community.PermissionManage),
+	}, nil
+}
+
+type sortOption struct {
+	Sort  community.Sort
+	Label string
+}
+
+// sortOptions are the sorts offered above the list, with their labels
+var sortOptions = []sortOption{
+	{community.SortNewest, "Newest"},
+	{community.SortVotes, "Most votes"},
+	{community.SortActivity, "Recent activity"},
+	{community.SortUnsolved, "Unsolved first"},
 }
 
 func (h *Handler) handleList(req *http.Request) (handler.Response, error) {
 	ctx := context.Background()
-	filter := &community.Filter{}
 
 	vars := mux.Vars(req)
 	communitySlug := vars["slug"]
+	query := req.URL.Query()
+
+	sort, err := community.ParseSort(query.Get("sort"))
+	if err != nil {
+		return handler.BadInput([]byte(err.Error())), nil
+	}
+
+	filter := &community.Filter{
+		Sort:   sort,
+		After:  query.Get("after"),
+		Before: query.Get("before"),
+	}
 
 	// Get community data
 	comm, err := h.repo.GetBySlug(ctx, communitySlug)
@@ -75,17 +164,106 @@ func (h *Handler) handleList(req *http.Request) (handler.Response, error) {
 		return nil, err
 	}
 
-	postItems, err := h.repo.ListPosts(ctx, communitySlug, filter)
+	member, err := h.viewerMembership(req, comm)
+	if err != nil {
+		return nil, err
+	}
+
+	// Searching replaces the list with results from this community
+	if strings.TrimSpace(query.Get("q")) != "" {
+		return h.handleSearch(req, comm, member)
+	}
+
+	page, err := h.repo.ListPosts(ctx, communitySlug, filter)
+	if errors.Is(err, community.ErrInvalidCursor) {
+		return handler.BadInput([]byte(err.Error())), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var center *geo.Point
+	if p, ok := community.Point(comm); ok {
+		center = &p
+	}
+
+	data := listData{
+		Community:   comm,
+		Center:      center,
+		Posts:       page.Items,
+		Sort:        sort,
+		SortOptions: sortOptions,
+		NextURL:     pageURL(comm.Name, sort, "after", page.NextCursor),
+		PrevURL:     pageURL(comm.Name, sort, "before", page.PrevCursor),
+		ReturnTo:    req.URL.RequestURI(),
+		Membership:  member,
+		CSRFToken:   server.CSRFToken(req.Context()),
+		Nonce:       layouts.Nonce(req.Context()),
+	}
+
+	content, err := templatesExecute("list.gohtml", data)
+	if err != nil {
+		return nil, err
+	}
+
+	html, err := layouts.WithGeneral(layouts.LayoutData{
+		Title:     comm.Title,
+		Content:   template.HTML(content),
+		CSRFToken: data.CSRFToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.Ok(html), nil
+}
+
+// handleMap serves the community's open issues with a location as GeoJSON
+func (h *Handler) handleMap(req *http.Request) (handler.Response, error) {
+	fc, err := h.repo.IssueMap(req.Context(), mux.Vars(req)["slug"])
+	if ent.IsNotFound(err) {
+		return handler.NotFound([]byte("Community not found")), nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	data := struct {
-		Community *ent.Community
-		Posts     []community.PostListItem
-	}{
-		Community: comm,
-		Posts:     postItems,
+	content, err := json.Marshal(fc)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &handler.ResponseBuffered{
+		Content: content,
+		Header:  http.Header{"Content-Type": {"application/geo+json"}},
+	}, nil
+}
+
+func (h *Handler) handleSearch(req *http.Request, comm *ent.Community, member membership) (handler.Response, error) {
+	query := req.URL.Query()
+	filter, err := search.ParseFilter(query)
+	if err != nil {
+		return handler.BadInput([]byte(err.Error())), nil
+	}
+	filter.CommunityID = &comm.ID
+
+	results, err := h.search.Search(req.Context(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resultsHTML, err := search.RenderResults(results, "/c/"+comm.Name, query)
+	if err != nil {
+		return nil, err
+	}
+
+	data := listData{
+		Community:  comm,
+		Query:      filter.Query,
+		Results:    resultsHTML,
+		Membership: member,
+		CSRFToken:  server.CSRFToken(req.Context()),
+		Nonce:      layouts.Nonce(req.Context()),
 	}
 
 	content, err := templatesExecute("list.gohtml", data)
@@ -94,8 +272,9 @@ func (h *Handler) handleList(req *http.Request) (handler.Response, error) {
 	}
 
 	html, err := layouts.WithGeneral(layouts.LayoutData{
-		Title:   comm.Title,
-		Content: template.HTML(content),
+		Title:     "Search: " + filter.Query + " - " + comm.Title,
+		Content:   template.HTML(content),
+		CSRFToken: data.CSRFToken,
 	})
 	if err != nil {
 		return nil, err
@@ -104,6 +283,19 @@ func (h *Handler) handleList(req *http.Request) (handler.Response, error) {
 	return handler.Ok(html), nil
 }
 
+// pageURL links to the page either side of a cursor, or is empty when
+// there's no page in that direction
+func pageURL(slug string, sort community.Sort, direction string, cursor string) string {
+	if cursor == "" {
+		return ""
+	}
+	params := url.Values{direction: {cursor}}
+	if sort != community.SortNewest {
+		params.Set("sort", string(sort))
+	}
+	return "/c/" + slug + "?" + params.Encode()
+}
+
 func templatesExecute(name string, data any) ([]byte, error) {
 	var buf bytes.Buffer
 	templates := getTemplates()
@@ -0,0 +1,232 @@
+package post
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"html/template"
+	"net/http"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"fixit/engine/auth"
+	"fixit/engine/diff"
+	"fixit/engine/ent"
+	postEngine "fixit/engine/post"
+	"fixit/web/handler"
+	"fixit/web/layouts"
+	"fixit/web/server"
+)
+
+//go:embed templates/edit.gohtml
+var editTplS string
+
+//go:embed templates/history.gohtml
+var historyTplS string
+
+var editTpl = template.Must(template.New("edit").Funcs(templateFuncs).Parse(editTplS))
+var historyTpl = template.Must(template.New("history").Funcs(templateFuncs).Parse(historyTplS))
+
+type EditPostData struct {
+	ID    uuid.UUID
+	Title string
+	Body  string
+	// Community is the name the preview links #tags to
+	Community string
+	Error     string
+	CSRFToken string
+	Nonce     string
+}
+
+type HistoryData struct {
+	ID        uuid.UUID
+	Title     string
+	Edits     []EditView
+	CSRFToken string
+}
+
+type EditView struct {
+	postEngine.Edit
+	BodyChanged bool
+}
+
+// EditPostGetHandler shows the form for changing a post's title and body
+func (h *Handler) EditPostGetHandler(r *http.Request) (handler.Response, error) {
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
+	if !isAuthenticated {
+		return handler.RedirectTo("/auth/login"), nil
+	}
+
+	postID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		return handler.BadInput([]byte("Invalid post ID")), nil
+	}
+
+	p, err := h.postRepo.Get(r.Context(), postID)
+	if err != nil {
+		return changeErrorResponse(err)
+	}
+	if p.DeletedAt != nil {
+		return changeErrorResponse(postEngine.ErrDeleted)
+	}
+	allowed, err := h.postRepo.MayChange(r.Context(), p, user.User)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return changeErrorResponse(postEngine.ErrNotAuthor)
+	}
+
+	content, err := renderEditPost(EditPostData{
+		ID:        p.ID,
+		Title:     p.Title,
+		Body:      p.Body,
+		Community: p.Edges.Community.Name,
+		CSRFToken: server.CSRFToken(r.Context()),
+		Nonce:     layouts.Nonce(r.Context()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return handler.Ok(content), nil
+}
+
+// EditPostPostHandler saves the changes from the edit form
+func (h *Handler) EditPostPostHandler(r *http.Request) (handler.Response, error) {
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
+	if !isAuthenticated {
+		return handler.RedirectTo("/auth/login"), nil
+	}
+
+	postID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		return handler.BadInput([]byte("Invalid post ID")), nil
+	}
+
+	data := EditPostData{
+		ID:        postID,
+		Title:     r.FormValue("title"),
+		Body:      r.FormValue("body"),
+		Community: r.FormValue("community"),
+		CSRFToken: server.CSRFToken(r.Context()),
+		Nonce:     layouts.Nonce(r.Context()),
+	}
+
+	_, err = h.postRepo.Edit(r.Context(), postID, user.User, postEngine.EditFields{
+		Title: data.Title,
+		Body:  data.Body,
+	})
+	switch {
+	case ent.IsValidationError(err):
+		data.Error = "Title must be between 5 and 128 characters"
+	case errors.Is(err, postEngine.ErrEditConflict):
+		data.Error = "The post was changed while you were editing it. Check it again before saving."
+	case err != nil:
+		return changeErrorResponse(err)
+	default:
+		return handler.RedirectTo(fmt.Sprintf("/p/%s", postID)), nil
+	}
+
+	content, err := renderEditPost(data)
+	if err != nil {
+		return nil, err
+	}
+	return handler.BadInput(content), nil
+}
+
+// DeletePostHandler deletes a post, leaving a tombstone in its place
+func (h *Handler) DeletePostHandler(r *http.Request) (handler.Response, error) {
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
+	if !isAuthenticated {
+		return handler.RedirectTo("/auth/login"), nil
+	}
+
+	postID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		return handler.BadInput([]byte("Invalid post ID")), nil
+	}
+
+	if err := h.postRepo.SoftDelete(r.Context(), postID, user.User); err != nil {
+		return changeErrorResponse(err)
+	}
+	return handler.RedirectTo(fmt.Sprintf("/p/%s", postID)), nil
+}
+
+// HistoryHandler shows each edit made to a post
+func (h *Handler) HistoryHandler(r *http.Request) (handler.Response, error) {
+	postID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		return handler.BadInput([]byte("Invalid post ID")), nil
+	}
+
+	p, err := h.postRepo.Get(r.Context(), postID)
+	if errors.Is(err, postEngine.ErrPostNotFound) {
+		return handler.NotFound([]byte("Post not found")), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// What a deleted post said isn't shown, before or after edits
+	if p.DeletedAt != nil {
+		return handler.NotFound([]byte("Post not found")), nil
+	}
+
+	edits, err := h.postRepo.History(r.Context(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	data := HistoryData{
+		ID:        p.ID,
+		Title:     p.Title,
+		CSRFToken: server.CSRFToken(r.Context()),
+	}
+	for _, edit := range edits {
+		data.Edits = append(data.Edits, EditView{
+			Edit:        edit,
+			BodyChanged: diff.Changed(edit.Body),
+		})
+	}
+
+	var content bytes.Buffer
+	if err := historyTpl.Execute(&content, data); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	page, err := layouts.WithGeneral(layouts.LayoutData{
+		Title:     "History of " + p.Title,
+		Content:   template.HTML(content.String()),
+		CSRFToken: data.CSRFToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return handler.Ok(page), nil
+}
+
+// changeErrorResponse is the response for an edit or delete that can't be
+// made
+func changeErrorResponse(err error) (handler.Response, error) {
+	switch {
+	case errors.Is(err, postEngine.ErrPostNotFound),
+		errors.Is(err, postEngine.ErrDeleted):
+		return handler.NotFound([]byte(err.Error())), nil
+	case errors.Is(err, postEngine.ErrNotAuthor):
+		return handler.Forbidden([]byte(err.Error())), nil
+	}
+	return nil, err
+}
+
+func renderEditPost(data EditPostData) ([]byte, error) {
+	var content bytes.Buffer
+	if err := editTpl.Execute(&content, data); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return layouts.WithGeneral(layouts.LayoutData{
+		Title:     "Edit Post",
+		Content:   template.HTML(content.String()),
+		CSRFToken: data.CSRFToken,
+	})
+}
@@ -6,6 +6,7 @@ import (
 	_ "embed"
 	"fmt"
 	"html/template"
+	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -19,10 +20,17 @@ import (
 	"fixit/engine/auth"
 	"fixit/engine/community"
 	"fixit/engine/ent"
+	entFile "fixit/engine/ent/file"
 	"fixit/engine/ent/post"
+	entVote "fixit/engine/ent/vote"
+	fileEngine "fixit/engine/file"
+	"fixit/engine/geo"
 	postEngine "fixit/engine/post"
+	voteEngine "fixit/engine/vote"
+	webfile "fixit/web/file"
 	"fixit/web/handler"
 	"fixit/web/layouts"
+	"fixit/web/server"
 )
 
 //go:embed templates/create.gohtml
@@ -63,26 +71,87 @@ type CreatePostData struct {
 	Body        string
 	Tags        string
 	ImageURL    string
+	Latitude    string
+	Longitude   string
+	Address     string
+	Photos      []PhotoSlot
 	Error       string
 	CommunityID string
 	ReplyToID   string
 	PostType    string
 	PageTitle   string
+	CSRFToken   string
+	Nonce       string
 }
 
 type ShowPostData struct {
-	ID                  uuid.UUID
-	Title               string
-	Body                string
+	ID    uuid.UUID
+	Title string
+	// Body is rendered from the Markdown the author wrote
+	Body                template.HTML
 	ImageURL            string
+	Address             string
+	Location            *geo.Point
+	Photos              []PhotoView
 	User                *ent.User
 	Community           *ent.Community
 	CreatedAt           time.Time
 	Tags                []string
 	Role                string
 	HasAcceptedSolution bool
-	Solutions           []*PostReply
-	ChatMessages        []*PostReply
+	// Solved is true for accepted solutions and closed issues alike
+	Solved bool
+	// CanModerate is true when the viewer may accept solutions and close
+	// or reopen the issue
+	CanModerate   bool
+	Status        string
+	StatusHistory []*StatusChangeView
+	Solutions     []*PostReply
+	ChatMessages  []*PostReply
+	Votes         []VoteTally
+	// Deleted posts show as a tombstone above their replies
+	Deleted bool
+	// CanEdit is true when the viewer may edit or delete the post
+	CanEdit bool
+	// Edited is true when the post has history to show
+	Edited    bool
+	CSRFToken string
+	Nonce     string
+}
+
+// VoteTally is the score of one kind of vote on a post, along with the
+// viewer's own vote so the template can show it as selected
+type VoteTally struct {
+	Kind  string
+	Label string
+	Score int
+	// Mine is the viewer's current vote: 1, -1 or 0 for none
+	Mine int
+}
+
+// photoSlots is how many photo inputs the create form offers
+const photoSlots = 4
+
+// PhotoSlot is one photo input on the create form, keeping its caption
+// when the form is shown again
+type PhotoSlot struct {
+	Index   int
+	Caption string
+}
+
+type PhotoView struct {
+	// URL is the detail size, FullURL the original upload
+	URL     string
+	FullURL string
+	Caption string
+}
+
+type StatusChangeView struct {
+	From      string
+	To        string
+	Reason    string
+	User      *ent.User
+	CreatedAt time.Time
 }
 
 type PostReply struct {
@@ -91,6 +160,7 @@ type PostReply struct {
 	User              *ent.User
 	CreatedAt         time.Time
 	Role              string
+	Deleted           bool
 	IsAccepted        bool
 	HasVerifications  bool
 	VerificationCount int
@@ -109,15 +179,24 @@ func (h *Handler) CreatePostPostHandler(r *http.Request) (handler.Response, erro
 	communitySlug := r.FormValue("community")
 	replyToID := r.FormValue("reply_to_id")
 	postType := r.FormValue("post_type")
+	latitude := r.FormValue("latitude")
+	longitude := r.FormValue("longitude")
+	address := strings.TrimSpace(r.FormValue("address"))
 
 	data := CreatePostData{
 		Title:       title,
 		Body:        body,
 		Tags:        tags,
 		ImageURL:    imageURL,
+		Latitude:    latitude,
+		Longitude:   longitude,
+		Address:     address,
+		Photos:      newPhotoSlots(r),
 		CommunityID: communitySlug,
 		ReplyToID:   replyToID,
 		PostType:    postType,
+		CSRFToken:   server.CSRFToken(r.Context()),
+		Nonce:       layouts.Nonce(r.Context()),
 	}
 
 	if title == "" {
@@ -145,6 +224,15 @@ func (h *Handler) CreatePostPostHandler(r *http.Request) (handler.Response, erro
 		return handler.RedirectTo("/auth/login"), nil
 	}
 
+	if !user.Confirmed {
+		data.Error = postEngine.ErrUnconfirmed.Error()
+		content, err := renderCreatePost(data)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return handler.Forbidden(content), nil
+	}
+
 	ctx := r.Context()
 
 	// Get community by slug to get its ID
@@ -195,6 +283,25 @@ func (h *Handler) CreatePostPostHandler(r *http.Request) (handler.Response, erro
 		replyToUUID = &parsedUUID
 	}
 
+	var point *geo.Point
+	if latitude != "" || longitude != "" {
+		p, err := geo.ParsePoint(latitude, longitude)
+		if err != nil {
+			data.Error = "Invalid location: " + err.Error()
+			content, renderErr := renderCreatePost(data)
+			if renderErr != nil {
+				return nil, errors.WithStack(renderErr)
+			}
+			return handler.BadInput(content), nil
+		}
+		point = &p
+	}
+
+	photos, err := readPhotos(r)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create post using repository
 	fields := postEngine.PostCreateFields{
 		Title:       title,
@@ -204,6 +311,9 @@ func (h *Handler) CreatePostPostHandler(r *http.Request) (handler.Response, erro
 		CommunityID: comm.ID,
 		ReplyTo:     replyToUUID,
 		ImageURL:    imageURL,
+		Point:       point,
+		Address:     address,
+		Photos:      photos,
 	}
 
 	createdPost, err := h.postRepo.Create(ctx, fields, user.User)
@@ -221,6 +331,50 @@ func (h *Handler) CreatePostPostHandler(r *http.Request) (handler.Response, erro
 	return handler.RedirectTo(redirectURL), nil
 }
 
+func newPhotoSlots(r *http.Request) []PhotoSlot {
+	slots := make([]PhotoSlot, photoSlots)
+	for i := range slots {
+		slots[i] = PhotoSlot{Index: i}
+		if r != nil {
+			slots[i].Caption = r.FormValue(fmt.Sprintf("caption_%d", i))
+		}
+	}
+	return slots
+}
+
+// readPhotos reads the uploaded photo_N files, each with its caption_N,
+// skipping inputs left empty
+func readPhotos(r *http.Request) ([]postEngine.Photo, error) {
+	var photos []postEngine.Photo
+	for i := range photoSlots {
+		f, header, err := r.FormFile(fmt.Sprintf("photo_%d", i))
+		if errors.Is(err, http.ErrMissingFile) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		data, err := io.ReadAll(io.LimitReader(f, fileEngine.MaxSize+1))
+		f.Close()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if len(data) == 0 {
+			continue
+		}
+
+		photos = append(photos, postEngine.Photo{
+			Upload: fileEngine.Upload{
+				Filename: header.Filename,
+				Data:     data,
+			},
+			Caption: r.FormValue(fmt.Sprintf("caption_%d", i)),
+		})
+	}
+	return photos, nil
+}
+
 func renderCreatePost(data CreatePostData) ([]byte, error) {
 	var content bytes.Buffer
 	err := createTpl.Execute(&content, data)
@@ -229,8 +383,9 @@ func renderCreatePost(data CreatePostData) ([]byte, error) {
 	}
 
 	layoutData := layouts.LayoutData{
-		Title:   "Create Post",
-		Content: template.HTML(content.String()),
+		Title:     "Create Post",
+		Content:   template.HTML(content.String()),
+		CSRFToken: data.CSRFToken,
 	}
 
 	return layouts.WithGeneral(layoutData)
@@ -239,13 +394,15 @@ func renderCreatePost(data CreatePostData) ([]byte, error) {
 type Handler struct {
 	postRepo      *postEngine.Repository
 	communityRepo *community.Repository
+	voteRepo      *voteEngine.Repository
 	ab            *authboss.Authboss
 }
 
-func New(postRepo *postEngine.Repository, communityRepo *community.Repository, ab *authboss.Authboss) *Handler {
+func New(postRepo *postEngine.Repository, communityRepo *community.Repository, voteRepo *voteEngine.Repository, ab *authboss.Authboss) *Handler {
 	return &Handler{
 		postRepo:      postRepo,
 		communityRepo: communityRepo,
+		voteRepo:      voteRepo,
 		ab:            ab,
 	}
 }
@@ -268,10 +425,13 @@ func (h *Handler) CreatePostGetHandler(r *http.Request) (handler.Response, error
 
 	data := CreatePostData{
 		PageTitle:   title,
+		Photos:      newPhotoSlots(nil),
 		Title:       "",
 		CommunityID: communityID,
 		ReplyToID:   replyToID,
 		PostType:    postType,
+		CSRFToken:   server.CSRFToken(r.Context()),
+		Nonce:       layouts.Nonce(r.Context()),
 	}
 
 	content, err := renderCreatePost(data)
@@ -290,10 +450,19 @@ func (h *Handler) ShowPostHandler(r *http.Request) (handler.Response, error) {
 		return handler.BadInput([]byte("Invalid post ID")), nil
 	}
 
-	postEntity, err := h.postRepo.GetByIDWithReplies(context.Background(), postID)
+	postEntity, err := h.postRepo.GetByIDWithReplies(r.Context(), postID)
+	if ent.IsNotFound(err) {
+		return handler.NotFound([]byte("Post not found")), nil
+	}
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	deleted := postEntity.DeletedAt != nil
+
+	body, err := h.postRepo.BodyHTML(r.Context(), postEntity)
+	if err != nil {
+		return nil, err
+	}
 
 	// Process replies into solutions and chat messages
 	var solutions []*PostReply
@@ -307,6 +476,7 @@ func (h *Handler) ShowPostHandler(r *http.Request) (handler.Response, error) {
 			User:      reply.Edges.User,
 			CreatedAt: reply.CreatedAt,
 			Role:      string(reply.Role),
+			Deleted:   reply.DeletedAt != nil,
 		}
 
 		switch reply.Role {
@@ -321,6 +491,7 @@ func (h *Handler) ShowPostHandler(r *http.Request) (handler.Response, error) {
 						User:      verification.Edges.User,
 						CreatedAt: verification.CreatedAt,
 						Role:      string(verification.Role),
+						Deleted:   verification.DeletedAt != nil,
 					}
 					verifications = append(verifications, verificationData)
 				}
@@ -329,7 +500,7 @@ func (h *Handler) ShowPostHandler(r *http.Request) (handler.Response, error) {
 			replyData.Verifications = verifications
 			replyData.VerificationCount = len(verifications)
 			replyData.HasVerifications = len(verifications) > 0
-			replyData.IsAccepted = false // TODO: implement acceptance logic
+			replyData.IsAccepted = postEntity.AcceptedSolutionID != nil && *postEntity.AcceptedSolutionID == reply.ID
 
 			if replyData.IsAccepted {
 				hasAcceptedSolution = true
@@ -340,19 +511,91 @@ func (h *Handler) ShowPostHandler(r *http.Request) (handler.Response, error) {
 		}
 	}
 
+	votes, err := h.voteTallies(r, postEntity.ID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var history []*StatusChangeView
+	if postEntity.Role == post.RoleIssue {
+		changes, err := h.postRepo.StatusHistory(r.Context(), postEntity.ID)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, change := range changes {
+			history = append(history, &StatusChangeView{
+				From:      string(change.FromStatus),
+				To:        string(change.ToStatus),
+				Reason:    change.Reason,
+				User:      change.Edges.User,
+				CreatedAt: change.CreatedAt,
+			})
+		}
+	}
+
+	var location *geo.Point
+	if postEntity.Geography != "" {
+		if p, err := geo.ParseWKT(postEntity.Geography); err == nil {
+			location = &p
+		}
+	}
+
+	var photos []PhotoView
+	for _, a := range postEntity.Edges.Attachments {
+		photos = append(photos, PhotoView{
+			URL:     webfile.AttachmentURL(a, entFile.VariantDetail),
+			FullURL: webfile.AttachmentURL(a, entFile.VariantOriginal),
+			Caption: a.Caption,
+		})
+	}
+
+	viewer, isAuthenticated := auth.RequireAuth(h.ab, r)
+	var canEdit bool
+	if isAuthenticated && !deleted {
+		canEdit, err = h.postRepo.MayChange(r.Context(), postEntity, viewer.User)
+		if err != nil {
+			return nil, err
+		}
+	}
+	canModerate := canEdit && postEntity.Role == post.RoleIssue
+
 	data := ShowPostData{
 		ID:                  postEntity.ID,
 		Title:               postEntity.Title,
-		Body:                postEntity.Body,
+		Body:                template.HTML(body),
 		ImageURL:            postEntity.ImageURL,
+		Address:             postEntity.Address,
+		Location:            location,
+		Photos:              photos,
 		User:                postEntity.Edges.User,
 		Community:           postEntity.Edges.Community,
 		CreatedAt:           postEntity.CreatedAt,
 		Tags:                postEntity.Tags,
 		Role:                string(postEntity.Role),
 		HasAcceptedSolution: hasAcceptedSolution,
+		Solved:              community.IsSolved(postEntity),
+		CanModerate:         canModerate,
+		Status:              string(postEntity.Status),
+		StatusHistory:       history,
 		Solutions:           solutions,
 		ChatMessages:        chatMessages,
+		Votes:               votes,
+		Deleted:             deleted,
+		CanEdit:             canEdit,
+		Edited:              len(postEntity.Edges.Revisions) > 0,
+		CSRFToken:           server.CSRFToken(r.Context()),
+		Nonce:               layouts.Nonce(r.Context()),
+	}
+	if deleted {
+		// Only the thread is left of a deleted post
+		data.Title = "Deleted post"
+		data.Body = ""
+		data.ImageURL = ""
+		data.Address = ""
+		data.Location = nil
+		data.Photos = nil
+		data.Tags = nil
+		data.Votes = nil
 	}
 
 	content, err := renderShowPost(data)
@@ -362,6 +605,127 @@ func (h *Handler) ShowPostHandler(r *http.Request) (handler.Response, error) {
 	return handler.Ok(content), nil
 }
 
+// maxPreview is the most Markdown the preview renders at once
+const maxPreview = 64 << 10
+
+// PreviewHandler renders the body being written on the create form, as it
+// will show once posted
+func (h *Handler) PreviewHandler(r *http.Request) (handler.Response, error) {
+	body := r.PostFormValue("body")
+	if len(body) > maxPreview {
+		return handler.BadInput([]byte("Body is too long to preview")), nil
+	}
+
+	html, err := postEngine.RenderBody(body, r.PostFormValue("community"))
+	if err != nil {
+		return nil, err
+	}
+	return &handler.ResponseBuffered{
+		Content: []byte(html),
+		Header:  http.Header{"Content-Type": {"text/html; charset=utf-8"}},
+	}, nil
+}
+
+// AcceptSolutionHandler marks the solution as the accepted fix for its issue
+func (h *Handler) AcceptSolutionHandler(r *http.Request) (handler.Response, error) {
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
+	if !isAuthenticated {
+		return handler.RedirectTo("/auth/login"), nil
+	}
+
+	solutionID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		return handler.BadInput([]byte("Invalid post ID")), nil
+	}
+
+	issue, err := h.postRepo.AcceptSolution(r.Context(), solutionID, user.User)
+	switch {
+	case errors.Is(err, postEngine.ErrSolutionNotFound),
+		errors.Is(err, postEngine.ErrDeleted):
+		return handler.NotFound([]byte(err.Error())), nil
+	case errors.Is(err, postEngine.ErrNotIssueAuthor):
+		return handler.Forbidden([]byte(err.Error())), nil
+	case errors.Is(err, postEngine.ErrNotSolution),
+		errors.Is(err, postEngine.ErrNoParentIssue):
+		return handler.BadInput([]byte("Failed to accept solution: " + err.Error())), nil
+	case err != nil:
+		return nil, err
+	}
+
+	return handler.RedirectTo(fmt.Sprintf("/p/%s", issue.ID)), nil
+}
+
+// CloseIssueHandler closes an issue with the reason given in the form
+func (h *Handler) CloseIssueHandler(r *http.Request) (handler.Response, error) {
+	return h.changeStatus(r, h.postRepo.Close)
+}
+
+// ReopenIssueHandler reopens a closed issue with the reason given in the form
+func (h *Handler) ReopenIssueHandler(r *http.Request) (handler.Response, error) {
+	return h.changeStatus(r, h.postRepo.Reopen)
+}
+
+type statusChangeFn func(ctx context.Context, issueID uuid.UUID, user *ent.User, reason string) (*ent.Post, error)
+
+func (h *Handler) changeStatus(r *http.Request, change statusChangeFn) (handler.Response, error) {
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
+	if !isAuthenticated {
+		return handler.RedirectTo("/auth/login"), nil
+	}
+
+	issueID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		return handler.BadInput([]byte("Invalid post ID")), nil
+	}
+
+	_, err = change(r.Context(), issueID, user.User, r.FormValue("reason"))
+	switch {
+	case errors.Is(err, postEngine.ErrNotIssueAuthor):
+		return handler.Forbidden([]byte(err.Error())), nil
+	case errors.Is(err, postEngine.ErrReasonRequired),
+		errors.Is(err, postEngine.ErrInvalidTransition),
+		errors.Is(err, postEngine.ErrNotIssue),
+		errors.Is(err, postEngine.ErrStatusConflict):
+		return handler.BadInput([]byte(err.Error())), nil
+	case err != nil:
+		return nil, errors.WithStack(err)
+	}
+
+	return handler.RedirectTo(fmt.Sprintf("/p/%s", issueID)), nil
+}
+
+func (h *Handler) voteTallies(r *http.Request, postID uuid.UUID) ([]VoteTally, error) {
+	ctx := r.Context()
+
+	score, err := h.voteRepo.Score(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	var mine map[entVote.Kind]int
+	if user, isAuthenticated := auth.RequireAuth(h.ab, r); isAuthenticated {
+		mine, err = h.voteRepo.UserVotes(ctx, postID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return []VoteTally{
+		{
+			Kind:  string(entVote.KindInteresting),
+			Label: "Interesting",
+			Score: score.Interesting,
+			Mine:  mine[entVote.KindInteresting],
+		},
+		{
+			Kind:  string(entVote.KindTruthful),
+			Label: "Truthful",
+			Score: score.Truthful,
+			Mine:  mine[entVote.KindTruthful],
+		},
+	}, nil
+}
+
 func renderShowPost(data ShowPostData) ([]byte, error) {
 	var content bytes.Buffer
 	err := showTpl.Execute(&content, data)
@@ -370,8 +734,9 @@ func renderShowPost(data ShowPostData) ([]byte, error) {
 	}
 
 	layoutData := layouts.LayoutData{
-		Title:   data.Title,
-		Content: template.HTML(content.String()),
+		Title:     data.Title,
+		Content:   template.HTML(content.String()),
+		CSRFToken: data.CSRFToken,
 	}
 
 	return layouts.WithGeneral(layoutData)
@@ -380,5 +745,13 @@ func renderShowPost(data ShowPostData) ([]byte, error) {
 func (h *Handler) RegisterRoutes(router *mux.Router) {
 	router.HandleFunc("/c/{slug}/post", handler.Wrap(h.CreatePostGetHandler)).Methods("GET")
 	router.HandleFunc("/api/post/create", handler.Wrap(h.CreatePostPostHandler)).Methods("POST")
+	router.HandleFunc("/api/post/preview", handler.Wrap(h.PreviewHandler)).Methods("POST")
+	router.HandleFunc("/api/post/{id}/accept", handler.Wrap(h.AcceptSolutionHandler)).Methods("POST")
+	router.HandleFunc("/api/post/{id}/close", handler.Wrap(h.CloseIssueHandler)).Methods("POST")
+	router.HandleFunc("/api/post/{id}/reopen", handler.Wrap(h.ReopenIssueHandler)).Methods("POST")
+	router.HandleFunc("/api/post/{id}/edit", handler.Wrap(h.EditPostPostHandler)).Methods("POST")
+	router.HandleFunc("/api/post/{id}/delete", handler.Wrap(h.DeletePostHandler)).Methods("POST")
 	router.HandleFunc("/p/{id}", handler.Wrap(h.ShowPostHandler)).Methods("GET")
+	router.HandleFunc("/p/{id}/edit", handler.Wrap(h.EditPostGetHandler)).Methods("GET")
+	router.HandleFunc("/p/{id}/history", handler.Wrap(h.HistoryHandler)).Methods("GET")
 }
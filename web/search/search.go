@@ -0,0 +1,193 @@
+package search
+
+import (
+	"bytes"
+	_ "embed"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"fixit/engine/community"
+	"fixit/engine/ent"
+	"fixit/engine/ent/post"
+	searchEngine "fixit/engine/search"
+	"fixit/web/handler"
+	"fixit/web/layouts"
+	"fixit/web/server"
+)
+
+//go:embed templates/search.gohtml
+var searchTplS string
+
+//go:embed templates/results.gohtml
+var resultsTplS string
+
+var templateFuncs = template.FuncMap{
+	"humanizeTime": func(t time.Time) string {
+		return humanize.Time(t)
+	},
+}
+
+var resultsTpl = template.Must(template.New("results").Funcs(templateFuncs).Parse(resultsTplS))
+var searchTpl = template.Must(template.New("search").Funcs(templateFuncs).Parse(searchTplS))
+
+type Handler struct {
+	searchRepo    *searchEngine.Repository
+	communityRepo *community.Repository
+}
+
+type SearchData struct {
+	Query     string
+	Community string
+	Role      string
+	Solved    string
+	Results   template.HTML
+}
+
+func New(searchRepo *searchEngine.Repository, communityRepo *community.Repository) *Handler {
+	return &Handler{
+		searchRepo:    searchRepo,
+		communityRepo: communityRepo,
+	}
+}
+
+func (h *Handler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/search", handler.Wrap(h.SearchHandler)).Methods("GET")
+}
+
+// SearchHandler searches posts across every community, or within one when
+// the community parameter is given
+func (h *Handler) SearchHandler(r *http.Request) (handler.Response, error) {
+	query := r.URL.Query()
+	data := SearchData{
+		Query:     query.Get("q"),
+		Community: query.Get("community"),
+		Role:      query.Get("role"),
+		Solved:    query.Get("solved"),
+	}
+
+	filter, err := ParseFilter(query)
+	if err != nil {
+		return handler.BadInput([]byte(err.Error())), nil
+	}
+
+	if data.Community != "" {
+		comm, err := h.communityRepo.GetBySlug(r.Context(), data.Community)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return handler.NotFound([]byte("Community not found")), nil
+			}
+			return nil, err
+		}
+		filter.CommunityID = &comm.ID
+	}
+
+	if filter.Query != "" {
+		results, err := h.searchRepo.Search(r.Context(), filter)
+		if err != nil {
+			return nil, err
+		}
+		data.Results, err = RenderResults(results, "/search", query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var content bytes.Buffer
+	if err := searchTpl.Execute(&content, data); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	title := "Search"
+	if data.Query != "" {
+		title = "Search: " + data.Query
+	}
+	html, err := layouts.WithGeneral(layouts.LayoutData{
+		Title:     title,
+		Content:   template.HTML(content.String()),
+		CSRFToken: server.CSRFToken(r.Context()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.Ok(html), nil
+}
+
+// ParseFilter reads the query, role, solved state and page of a search
+// from URL parameters
+func ParseFilter(query url.Values) (searchEngine.Filter, error) {
+	filter := searchEngine.Filter{
+		Query: strings.TrimSpace(query.Get("q")),
+	}
+
+	if role := query.Get("role"); role != "" {
+		r := post.Role(role)
+		if err := post.RoleValidator(r); err != nil {
+			return filter, errors.Errorf("unknown role %q", role)
+		}
+		filter.Role = &r
+	}
+
+	switch query.Get("solved") {
+	case "":
+	case "yes":
+		solved := true
+		filter.Solved = &solved
+	case "no":
+		solved := false
+		filter.Solved = &solved
+	default:
+		return filter, errors.New("solved must be yes or no")
+	}
+
+	if page := query.Get("page"); page != "" {
+		n, err := strconv.Atoi(page)
+		if err != nil || n < 1 {
+			return filter, errors.New("invalid page")
+		}
+		filter.Page = n
+	}
+
+	return filter, nil
+}
+
+type resultsData struct {
+	*searchEngine.Results
+	PrevURL string
+	NextURL string
+}
+
+// RenderResults renders search results with links to the pages either side,
+// keeping the rest of the query
+func RenderResults(results *searchEngine.Results, path string, query url.Values) (template.HTML, error) {
+	data := resultsData{Results: results}
+	if results.Page > 1 {
+		data.PrevURL = pageURL(path, query, results.Page-1)
+	}
+	if results.HasMore {
+		data.NextURL = pageURL(path, query, results.Page+1)
+	}
+
+	var out bytes.Buffer
+	if err := resultsTpl.Execute(&out, data); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return template.HTML(out.String()), nil
+}
+
+func pageURL(path string, query url.Values, page int) string {
+	params := url.Values{}
+	for k, v := range query {
+		params[k] = v
+	}
+	params.Set("page", strconv.Itoa(page))
+	return path + "?" + params.Encode()
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// clientIP makes RemoteAddr the address the proxy in front says the client
+// has, so everything after it, the rate limiter and stored sessions
+// included, sees the client rather than the proxy
+func clientIP(header string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if header == "" {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if ip := strings.TrimSpace(r.Header.Get(header)); ip != "" {
+				r.RemoteAddr = net.JoinHostPort(ip, "0")
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// remoteIP is the address the request came from, without the port
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errors2 "fixit/web/errors"
+)
+
+const (
+	// CSRFField is the form field forms post their token in
+	CSRFField = "csrf_token"
+	// CSRFHeader carries the token for requests made from scripts, like
+	// calls to a JSON API
+	CSRFHeader = "X-CSRF-Token"
+
+	csrfCookie       = "csrf"
+	csrfSecretLength = 32
+	// csrfCookieMaxAge outlasts sessions, so forms left open keep working
+	csrfCookieMaxAge = 365 * 24 * 60 * 60
+)
+
+type csrfContextKey struct{}
+
+// csrf refuses state changing requests that don't carry the token for the
+// browser's secret, kept in a cookie, or that a browser says came from
+// another site. Tokens are masked afresh for each page, so they can't be
+// recovered from compressed responses.
+type csrf struct {
+	origin string
+	secure bool
+	cookie string
+}
+
+func newCSRF(cfg Config) *csrf {
+	origin, secure := cfg.origin()
+	return &csrf{
+		origin: origin,
+		secure: secure,
+		cookie: CookieName(csrfCookie, secure),
+	}
+}
+
+func (c *csrf) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secret, ok := c.secret(r)
+		if !ok {
+			secret = make([]byte, csrfSecretLength)
+			if _, err := rand.Read(secret); err != nil {
+				errors2.Handle500(w, r, err)
+				return
+			}
+			http.SetCookie(w, &http.Cookie{
+				Name:     c.cookie,
+				Value:    base64.RawURLEncoding.EncodeToString(secret),
+				Path:     "/",
+				MaxAge:   csrfCookieMaxAge,
+				HttpOnly: true,
+				Secure:   c.secure,
+				SameSite: http.SameSiteLaxMode,
+			})
+		}
+		r = r.WithContext(context.WithValue(r.Context(), csrfContextKey{}, secret))
+
+		if !isSafeMethod(r.Method) {
+			// Without a secret there's nothing the token could match
+			if !ok || !c.sameOrigin(r) || !validCSRFToken(secret, requestCSRFToken(r)) {
+				errors2.Handle403(w, r)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (c *csrf) secret(r *http.Request) ([]byte, bool) {
+	cookie, err := r.Cookie(c.cookie)
+	if err != nil {
+		return nil, false
+	}
+	secret, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil || len(secret) != csrfSecretLength {
+		return nil, false
+	}
+	return secret, true
+}
+
+// sameOrigin checks what browsers say about where a request came from.
+// Clients that say nothing, like older browsers and scripts, are left to the
+// token.
+func (c *csrf) sameOrigin(r *http.Request) bool {
+	switch r.Header.Get("Sec-Fetch-Site") {
+	case "", "same-origin", "none":
+	default:
+		return false
+	}
+
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if origin == c.origin {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && u.Host != "" && u.Host == r.Host
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+func requestCSRFToken(r *http.Request) string {
+	if token := r.Header.Get(CSRFHeader); token != "" {
+		return token
+	}
+	return r.PostFormValue(CSRFField)
+}
+
+// CSRFToken is the token pages include in their forms, and in the csrf-token
+// meta tag for scripts
+func CSRFToken(ctx context.Context) string {
+	secret, ok := ctx.Value(csrfContextKey{}).([]byte)
+	if !ok {
+		return ""
+	}
+	pad := make([]byte, csrfSecretLength)
+	if _, err := rand.Read(pad); err != nil {
+		return ""
+	}
+	masked := make([]byte, 0, 2*csrfSecretLength)
+	masked = append(masked, pad...)
+	for i, b := range secret {
+		masked = append(masked, b^pad[i])
+	}
+	return base64.RawURLEncoding.EncodeToString(masked)
+}
+
+func validCSRFToken(secret []byte, token string) bool {
+	masked, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(token))
+	if err != nil || len(masked) != 2*csrfSecretLength {
+		return false
+	}
+	unmasked := make([]byte, csrfSecretLength)
+	for i := range unmasked {
+		unmasked[i] = masked[i] ^ masked[csrfSecretLength+i]
+	}
+	return subtle.ConstantTimeCompare(unmasked, secret) == 1
+}
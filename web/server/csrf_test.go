@@ -0,0 +1,85 @@
+package server_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/web/server"
+)
+
+func TestCSRF(t *testing.T) {
+	srv := server.New(server.Config{RootURL: "https://fixit.example"})
+	srv.Router().HandleFunc("/form", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, server.CSRFToken(r.Context()))
+	}).Methods("GET")
+	srv.Router().HandleFunc("/form", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "posted")
+	}).Methods("POST")
+
+	// A browser's first visit gets its secret and a token for it
+	rec := httptest.NewRecorder()
+	srv.Router().ServeHTTP(rec, httptest.NewRequest("GET", "/form", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	cookie := cookies[0]
+	assert.True(t, cookie.Secure, "the site is https")
+	assert.Equal(t, "__Host-csrf", cookie.Name)
+	assert.True(t, cookie.HttpOnly)
+	token := rec.Body.String()
+	require.NotEmpty(t, token)
+
+	post := func(cookie *http.Cookie, token string, header http.Header) *httptest.ResponseRecorder {
+		form := url.Values{}
+		if token != "" {
+			form.Set(server.CSRFField, token)
+		}
+		req := httptest.NewRequest("POST", "/form", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		for key, values := range header {
+			req.Header.Set(key, values[0])
+		}
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		rec := httptest.NewRecorder()
+		srv.Router().ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Test: The token works in a form or a header
+	assert.Equal(t, "posted", post(cookie, token, nil).Body.String())
+	assert.Equal(t, "posted", post(cookie, "", http.Header{server.CSRFHeader: {token}}).Body.String())
+
+	// Test: Tokens differ for each page but all work
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/form", nil)
+	req.AddCookie(cookie)
+	srv.Router().ServeHTTP(rec, req)
+	assert.Empty(t, rec.Result().Cookies(), "the secret is kept")
+	assert.NotEqual(t, token, rec.Body.String())
+	assert.Equal(t, http.StatusOK, post(cookie, rec.Body.String(), nil).Code)
+
+	// Test: Posts without the right token are refused
+	forbidden := post(cookie, "", nil)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Contains(t, forbidden.Body.String(), "Forbidden")
+	assert.Equal(t, http.StatusForbidden, post(nil, token, nil).Code)
+	assert.Equal(t, http.StatusForbidden, post(cookie, token[:len(token)-2], nil).Code)
+	other := &http.Cookie{Name: cookie.Name, Value: strings.Repeat("A", len(cookie.Value))}
+	assert.Equal(t, http.StatusForbidden, post(other, token, nil).Code)
+
+	// Test: Browsers saying the post came from another site are refused
+	assert.Equal(t, http.StatusOK, post(cookie, token, http.Header{"Origin": {"https://fixit.example"}}).Code)
+	assert.Equal(t, http.StatusForbidden, post(cookie, token, http.Header{"Origin": {"https://evil.example"}}).Code)
+	assert.Equal(t, http.StatusForbidden, post(cookie, token, http.Header{"Origin": {"null"}}).Code)
+	assert.Equal(t, http.StatusOK, post(cookie, token, http.Header{"Sec-Fetch-Site": {"same-origin"}}).Code)
+	assert.Equal(t, http.StatusForbidden, post(cookie, token, http.Header{"Sec-Fetch-Site": {"cross-site"}}).Code)
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	errors2 "fixit/web/errors"
+)
+
+// RateLimitConfig limits attempts at things like logging in, both from one
+// IP address and at one account
+type RateLimitConfig struct {
+	// PerIP and PerAccount are how many attempts are allowed in each Window.
+	// Zero turns the limit off.
+	PerIP      int           `env:"RATE_LIMIT_PER_IP" envDefault:"30"`
+	PerAccount int           `env:"RATE_LIMIT_PER_ACCOUNT" envDefault:"10"`
+	Window     time.Duration `env:"RATE_LIMIT_WINDOW" envDefault:"10m"`
+}
+
+// RateLimiter counts attempts in memory, so each server limits separately.
+// Addresses are the client's, as Config.ClientIPHeader says.
+type RateLimiter struct {
+	perIP      *limiter
+	perAccount *limiter
+}
+
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		perIP:      newLimiter(cfg.PerIP, cfg.Window),
+		perAccount: newLimiter(cfg.PerAccount, cfg.Window),
+	}
+}
+
+// Middleware limits form posts to the paths. The account is the posted
+// email, so guessing one user's password from many addresses is limited too.
+func (l *RateLimiter) Middleware(paths ...string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || !slices.Contains(paths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			now := time.Now()
+			if ok, retryAfter := l.perIP.allow(remoteIP(r), now); !ok {
+				errors2.Handle429(w, r, retryAfter)
+				return
+			}
+			account := strings.ToLower(strings.TrimSpace(r.PostFormValue("email")))
+			if account != "" {
+				if ok, retryAfter := l.perAccount.allow(account, now); !ok {
+					errors2.Handle429(w, r, retryAfter)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// limiter allows limit attempts per key in each fixed window, which starts at
+// the key's first attempt
+type limiter struct {
+	limit  int
+	window time.Duration
+
+	mu      sync.Mutex
+	windows map[string]*window
+	swept   time.Time
+}
+
+type window struct {
+	start time.Time
+	count int
+}
+
+func newLimiter(limit int, length time.Duration) *limiter {
+	return &limiter{
+		limit:   limit,
+		window:  length,
+		windows: map[string]*window{},
+	}
+}
+
+// allow counts an attempt, saying how long until the next is allowed when
+// this one isn't
+func (l *limiter) allow(key string, now time.Time) (bool, time.Duration) {
+	if l.limit <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Forget finished windows now and then, so the map doesn't grow forever
+	if now.Sub(l.swept) > l.window {
+		for k, w := range l.windows {
+			if now.Sub(w.start) >= l.window {
+				delete(l.windows, k)
+			}
+		}
+		l.swept = now
+	}
+
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) >= l.window {
+		w = &window{start: now}
+		l.windows[key] = w
+	}
+	if w.count >= l.limit {
+		return false, w.start.Add(l.window).Sub(now)
+	}
+	w.count++
+	return true, 0
+}
@@ -0,0 +1,66 @@
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"fixit/web/server"
+)
+
+func TestRateLimiter(t *testing.T) {
+	limiter := server.NewRateLimiter(server.RateLimitConfig{
+		PerIP:      3,
+		PerAccount: 2,
+		Window:     200 * time.Millisecond,
+	})
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	handler := limiter.Middleware("/auth/login")(ok)
+
+	attempt := func(method, path, ip, email string) *httptest.ResponseRecorder {
+		form := url.Values{"email": {email}}
+		req := httptest.NewRequest(method, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.RemoteAddr = ip + ":1234"
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Test: One account is limited across addresses
+	assert.Equal(t, http.StatusOK, attempt("POST", "/auth/login", "10.0.0.1", "a@example.com").Code)
+	assert.Equal(t, http.StatusOK, attempt("POST", "/auth/login", "10.0.0.2", "A@example.com").Code)
+	limited := attempt("POST", "/auth/login", "10.0.0.3", "a@example.com")
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Equal(t, "1", limited.Header().Get("Retry-After"))
+	assert.Contains(t, limited.Body.String(), "Too Many Attempts")
+
+	// Test: One address is limited across accounts
+	assert.Equal(t, http.StatusOK, attempt("POST", "/auth/login", "10.0.0.4", "b@example.com").Code)
+	assert.Equal(t, http.StatusOK, attempt("POST", "/auth/login", "10.0.0.4", "c@example.com").Code)
+	assert.Equal(t, http.StatusOK, attempt("POST", "/auth/login", "10.0.0.4", "d@example.com").Code)
+	assert.Equal(t, http.StatusTooManyRequests, attempt("POST", "/auth/login", "10.0.0.4", "e@example.com").Code)
+
+	// Test: Only posts to the paths count
+	assert.Equal(t, http.StatusOK, attempt("GET", "/auth/login", "10.0.0.4", "").Code)
+	assert.Equal(t, http.StatusOK, attempt("POST", "/auth/register", "10.0.0.4", "a@example.com").Code)
+
+	// Test: Attempts are allowed again once the window has passed
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, http.StatusOK, attempt("POST", "/auth/login", "10.0.0.4", "a@example.com").Code)
+}
+
+func TestRateLimiter_Off(t *testing.T) {
+	limiter := server.NewRateLimiter(server.RateLimitConfig{})
+	handler := limiter.Middleware("/auth/login")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	for range 100 {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("POST", "/auth/login", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+}
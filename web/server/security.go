@@ -0,0 +1,116 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errors2 "fixit/web/errors"
+	"fixit/web/layouts"
+)
+
+// hostCookiePrefix makes browsers only accept a cookie when it's Secure, for
+// the whole site and set by the site itself, so other subdomains can't
+// overwrite it
+const hostCookiePrefix = "__Host-"
+
+// hstsMaxAge is two years, as HSTS preload lists ask for
+const hstsMaxAge = "63072000"
+
+// Config is how the server protects the site
+type Config struct {
+	// RootURL is the site's public URL. Requests from other origins are
+	// refused, and over https cookies are Secure and HSTS is sent.
+	RootURL string
+	// Development leaves out HSTS, which would stick to localhost
+	Development bool
+	// ClientIPHeader is set by the proxy in front, like Fly-Client-IP, to the
+	// client's address. Without it the connection's address is used.
+	ClientIPHeader string
+}
+
+func (c Config) origin() (string, bool) {
+	u, err := url.Parse(c.RootURL)
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+	return u.Scheme + "://" + u.Host, strings.EqualFold(u.Scheme, "https")
+}
+
+// Secure is whether the site is served over https, so its cookies can be
+// kept to it
+func (c Config) Secure() bool {
+	_, secure := c.origin()
+	return secure
+}
+
+// CookiePrefix starts the name of each of the site's cookies, __Host- when
+// it's secure
+func (c Config) CookiePrefix() string {
+	return cookiePrefix(c.Secure())
+}
+
+// CookieName is the name to give a cookie of the site's, with the __Host-
+// prefix when it's secure
+func CookieName(name string, secure bool) string {
+	return cookiePrefix(secure) + name
+}
+
+func cookiePrefix(secure bool) string {
+	if secure {
+		return hostCookiePrefix
+	}
+	return ""
+}
+
+// securityHeaders limits what browsers let pages do. Inline scripts and
+// styles only apply with the nonce made for each request, which templates
+// get from layouts.Nonce.
+type securityHeaders struct {
+	hsts bool
+}
+
+func newSecurityHeaders(cfg Config) *securityHeaders {
+	return &securityHeaders{hsts: cfg.Secure() && !cfg.Development}
+}
+
+func (s *securityHeaders) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			errors2.Handle500(w, r, err)
+			return
+		}
+		nonce := base64.StdEncoding.EncodeToString(b)
+
+		h := w.Header()
+		h.Set("Content-Security-Policy", contentSecurityPolicy(nonce))
+		h.Set("X-Frame-Options", "DENY")
+		h.Set("X-Content-Type-Options", "nosniff")
+		h.Set("Referrer-Policy", "strict-origin-when-cross-origin")
+		if s.hsts {
+			h.Set("Strict-Transport-Security", "max-age="+hstsMaxAge+"; includeSubDomains")
+		}
+		next.ServeHTTP(w, r.WithContext(layouts.WithNonce(r.Context(), nonce)))
+	})
+}
+
+func contentSecurityPolicy(nonce string) string {
+	return strings.Join([]string{
+		"default-src 'self'",
+		// Leaflet loads from its CDN
+		"script-src 'self' 'nonce-" + nonce + "' https://unpkg.com",
+		// The site's styles, Tailwind's included, are in /static
+		"style-src 'self' 'nonce-" + nonce + "' https://unpkg.com",
+		// Posts and communities link images from anywhere, and maps show
+		// OpenStreetMap tiles
+		"img-src 'self' data: https:",
+		"connect-src 'self'",
+		"object-src 'none'",
+		"base-uri 'self'",
+		"form-action 'self'",
+		"frame-ancestors 'none'",
+	}, "; ")
+}
@@ -0,0 +1,89 @@
+package server_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fixit/web/layouts"
+	"fixit/web/server"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	get := func(cfg server.Config) *httptest.ResponseRecorder {
+		srv := server.New(cfg)
+		srv.Router().HandleFunc("/page", func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, layouts.Nonce(r.Context()))
+		}).Methods("GET")
+		rec := httptest.NewRecorder()
+		srv.Router().ServeHTTP(rec, httptest.NewRequest("GET", "/page", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec
+	}
+
+	rec := get(server.Config{RootURL: "https://fixit.example"})
+	assert.Equal(t, "DENY", rec.Header().Get("X-Frame-Options"))
+	assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "strict-origin-when-cross-origin", rec.Header().Get("Referrer-Policy"))
+	assert.Equal(t, "max-age=63072000; includeSubDomains", rec.Header().Get("Strict-Transport-Security"))
+
+	// Test: Pages' scripts get the nonce the policy allows
+	nonce := rec.Body.String()
+	require.NotEmpty(t, nonce)
+	csp := rec.Header().Get("Content-Security-Policy")
+	assert.Contains(t, csp, "script-src 'self' 'nonce-"+nonce+"'")
+	assert.Contains(t, csp, "frame-ancestors 'none'")
+	assert.NotContains(t, csp, "'unsafe-eval'")
+	// Test: Styles need the nonce too, so inline ones can't be injected
+	assert.Contains(t, csp, "style-src 'self' 'nonce-"+nonce+"'")
+	assert.NotContains(t, csp, "'unsafe-inline'")
+
+	// Test: Each request gets its own nonce
+	assert.NotEqual(t, nonce, get(server.Config{RootURL: "https://fixit.example"}).Body.String())
+
+	// Test: HSTS is only sent over https outside development
+	assert.Empty(t, get(server.Config{RootURL: "http://localhost:8080"}).Header().Get("Strict-Transport-Security"))
+	assert.Empty(t, get(server.Config{RootURL: "https://fixit.example", Development: true}).Header().Get("Strict-Transport-Security"))
+}
+
+func TestConfig_Secure(t *testing.T) {
+	assert.True(t, server.Config{RootURL: "https://fixit.example"}.Secure())
+	assert.False(t, server.Config{RootURL: "http://localhost:8080"}.Secure())
+	assert.True(t, server.Config{RootURL: "HTTPS://fixit.example"}.Secure())
+	// Test: Without a host there's no origin for the server to send HSTS for
+	assert.False(t, server.Config{RootURL: "https://"}.Secure())
+
+	// Test: Cookies are kept to the site when it's secure
+	assert.Equal(t, "__Host-", server.Config{RootURL: "https://fixit.example"}.CookiePrefix())
+	assert.Empty(t, server.Config{RootURL: "http://localhost:8080"}.CookiePrefix())
+}
+
+func TestClientIP(t *testing.T) {
+	remoteAddr := func(cfg server.Config, header string) string {
+		srv := server.New(cfg)
+		srv.Router().HandleFunc("/ip", func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, r.RemoteAddr)
+		}).Methods("GET")
+		req := httptest.NewRequest("GET", "/ip", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		if header != "" {
+			req.Header.Set("Fly-Client-IP", header)
+		}
+		rec := httptest.NewRecorder()
+		srv.Router().ServeHTTP(rec, req)
+		return rec.Body.String()
+	}
+
+	// Test: The proxy's header names the client
+	cfg := server.Config{ClientIPHeader: "Fly-Client-IP"}
+	assert.Equal(t, "203.0.113.7:0", remoteAddr(cfg, "203.0.113.7"))
+	assert.Equal(t, "[2001:db8::1]:0", remoteAddr(cfg, "2001:db8::1"))
+	assert.Equal(t, "10.0.0.1:1234", remoteAddr(cfg, ""))
+
+	// Test: Without a configured header it's ignored, as clients could set it
+	assert.Equal(t, "10.0.0.1:1234", remoteAddr(server.Config{}, "203.0.113.7"))
+}
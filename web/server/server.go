@@ -2,16 +2,19 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"log/slog"
 	"net/http"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/felixge/httpsnoop"
 	"github.com/gorilla/mux"
 	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"fixit/engine/ent"
-	"fixit/engine/ent/migrate"
+	"fixit/engine/migration"
 	errors2 "fixit/web/errors"
 )
 
@@ -24,11 +27,12 @@ type Server struct {
 	client *ent.Client
 }
 
-func New() *Server {
+func New(cfg Config) *Server {
 	r := mux.NewRouter()
 
 	// Add panic recovery middleware first
 	r.Use(errors2.PanicRecoveryMiddleware)
+	r.Use(clientIP(cfg.ClientIPHeader))
 
 	// Add logging middleware
 	r.Use(func(wrapped http.Handler) http.Handler {
@@ -45,25 +49,35 @@ func New() *Server {
 		})
 	})
 
+	r.Use(newSecurityHeaders(cfg).Middleware)
+	// Refuse forged form posts before anything acts on them
+	r.Use(newCSRF(cfg).Middleware)
+
 	return &Server{
 		router: r,
 	}
 }
 
+// InitDB connects to the database, refusing to continue if it has
+// migrations to apply. Migrations are applied with `fixit migrate up`, not
+// on boot, so several machines starting at once don't race.
 func (s *Server) InitDB(databaseURL string) error {
-	client, err := ent.Open("postgres", databaseURL)
+	db, err := sql.Open("postgres", databaseURL)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
-	s.client = client
-
-	ctx := context.Background()
-	if err := client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true)); err != nil {
-		return errors.WithStack(err)
+	migrator, err := migration.New(db)
+	if err != nil {
+		db.Close()
+		return err
+	}
+	if err := migrator.Check(context.Background()); err != nil {
+		db.Close()
+		return err
 	}
 
-	slog.Info("Database migration completed successfully")
+	s.client = ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
 	return nil
 }
 
@@ -0,0 +1,111 @@
+package vote
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aarondl/authboss/v3"
+	"github.com/gofrs/uuid/v5"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"fixit/engine/auth"
+	entVote "fixit/engine/ent/vote"
+	voteEngine "fixit/engine/vote"
+	"fixit/web/handler"
+)
+
+type Handler struct {
+	voteRepo *voteEngine.Repository
+	ab       *authboss.Authboss
+}
+
+func New(voteRepo *voteEngine.Repository, ab *authboss.Authboss) *Handler {
+	return &Handler{
+		voteRepo: voteRepo,
+		ab:       ab,
+	}
+}
+
+func (h *Handler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/api/post/{id}/vote", handler.Wrap(h.VoteHandler)).Methods("POST")
+	router.HandleFunc("/api/post/{id}/vote/retract", handler.Wrap(h.RetractHandler)).Methods("POST")
+}
+
+// VoteHandler casts a vote, or changes the user's existing vote of that kind
+func (h *Handler) VoteHandler(r *http.Request) (handler.Response, error) {
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
+	if !isAuthenticated {
+		return handler.RedirectTo("/auth/login"), nil
+	}
+
+	postID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		return handler.BadInput([]byte("Invalid post ID")), nil
+	}
+
+	kind := entVote.Kind(r.FormValue("kind"))
+	if err := entVote.KindValidator(kind); err != nil {
+		return handler.BadInput([]byte(err.Error())), nil
+	}
+
+	value, err := strconv.Atoi(r.FormValue("value"))
+	if err != nil {
+		return handler.BadInput([]byte("Invalid vote value")), nil
+	}
+
+	ctx := r.Context()
+
+	_, err = h.voteRepo.Cast(ctx, postID, user.ID, kind, value)
+	if errors.Is(err, voteEngine.ErrAlreadyVoted) {
+		_, err = h.voteRepo.Change(ctx, postID, user.ID, kind, value)
+	}
+
+	switch {
+	case err == nil, errors.Is(err, voteEngine.ErrUnchangedVote):
+		return handler.RedirectTo(returnTo(r, postID)), nil
+	case errors.Is(err, voteEngine.ErrPostNotFound):
+		return handler.NotFound([]byte("Post not found")), nil
+	case errors.Is(err, voteEngine.ErrInvalidValue):
+		return handler.BadInput([]byte(err.Error())), nil
+	default:
+		return nil, errors.WithStack(err)
+	}
+}
+
+// RetractHandler removes the user's vote of the given kind
+func (h *Handler) RetractHandler(r *http.Request) (handler.Response, error) {
+	user, isAuthenticated := auth.RequireAuth(h.ab, r)
+	if !isAuthenticated {
+		return handler.RedirectTo("/auth/login"), nil
+	}
+
+	postID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		return handler.BadInput([]byte("Invalid post ID")), nil
+	}
+
+	kind := entVote.Kind(r.FormValue("kind"))
+	if err := entVote.KindValidator(kind); err != nil {
+		return handler.BadInput([]byte(err.Error())), nil
+	}
+
+	err = h.voteRepo.Retract(r.Context(), postID, user.ID, kind)
+	if err != nil && !errors.Is(err, voteEngine.ErrVoteNotFound) {
+		return nil, errors.WithStack(err)
+	}
+
+	return handler.RedirectTo(returnTo(r, postID)), nil
+}
+
+// returnTo sends the user back to the page they voted from, as long as it's
+// a local path, falling back to the post itself
+func returnTo(r *http.Request, postID uuid.UUID) string {
+	to := r.FormValue("return_to")
+	if strings.HasPrefix(to, "/") && !strings.HasPrefix(to, "//") && !strings.HasPrefix(to, "/\\") {
+		return to
+	}
+	return fmt.Sprintf("/p/%s", postID)
+}